@@ -0,0 +1,238 @@
+package main
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// check regenerates the packages described by names into a temporary
+// directory, and writes a unified diff of any differences from the packages
+// beneath the output directory to w. The template's derived methods, if any,
+// are compared with its methods file in the same way, and the generated files
+// of any other package beneath the output directory, which the configuration
+// no longer produces, are diffed as deletions. check reports whether any
+// differences were found. Neither the template nor the output directory is
+// modified.
+func (g generator) check(names []typeNames, methods *generatedFile, w io.Writer) (bool, error) {
+	tmp, err := ioutil.TempDir("", "generate")
+	if err != nil {
+		return false, err
+	}
+	defer os.RemoveAll(tmp)
+
+	stale := false
+	if methods != nil {
+		fileName := filepath.Join(g.sourcePath, methods.name)
+		committed, err := ioutil.ReadFile(fileName)
+		if err != nil && !os.IsNotExist(err) {
+			return false, err
+		}
+		stale, err = diffFile(w, g.label(fileName), string(committed), string(methods.source))
+		if err != nil {
+			return false, err
+		}
+	}
+	for _, t := range names {
+		files, err := g.generate(t)
+		if err != nil {
+			return false, err
+		}
+		generatedPath := filepath.Join(tmp, t.PackageName)
+		if err := writePackage(generatedPath, files); err != nil {
+			return false, err
+		}
+
+		committedPath := filepath.Join(g.outputPath, t.PackageName)
+		differs, err := diffPackage(w, g.label(committedPath), committedPath, generatedPath)
+		if err != nil {
+			return false, err
+		}
+		stale = stale || differs
+	}
+
+	leftovers, err := g.leftoverPackages(names)
+	if err != nil {
+		return false, err
+	}
+	for _, packagePath := range leftovers {
+		committed, err := readPackage(packagePath)
+		if err != nil {
+			return false, err
+		}
+		for name, contents := range committed {
+			if !strings.HasPrefix(contents, generatedNotice) {
+				delete(committed, name)
+			}
+		}
+		if _, err := diffFiles(w, g.label(packagePath), committed, nil); err != nil {
+			return false, err
+		}
+		stale = true
+	}
+	return stale, nil
+}
+
+// leftoverPackages returns the directories immediately beneath the output
+// directory, other than the template and those of the packages described by
+// names, that hold generated files, such as the packages of a type or
+// dimension since removed from the configuration.
+func (g generator) leftoverPackages(names []typeNames) ([]string, error) {
+	expected := map[string]bool{filepath.Clean(g.sourcePath): true}
+	for _, t := range names {
+		expected[filepath.Join(g.outputPath, t.PackageName)] = true
+	}
+	infos, err := ioutil.ReadDir(g.outputPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := []string{}
+	for _, info := range infos {
+		packagePath := filepath.Join(g.outputPath, info.Name())
+		if !info.IsDir() || expected[packagePath] {
+			continue
+		}
+		files, err := readPackage(packagePath)
+		if err != nil {
+			return nil, err
+		}
+		for _, contents := range files {
+			if strings.HasPrefix(contents, generatedNotice) {
+				result = append(result, packagePath)
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// diffPackage writes a unified diff between the files of the directories
+// committedPath and generatedPath to w, and reports whether they differ. Files
+// are labeled by their name, prefixed by label. A directory that does not
+// exist is treated as empty.
+func diffPackage(w io.Writer, label, committedPath, generatedPath string) (bool, error) {
+	committed, err := readPackage(committedPath)
+	if err != nil {
+		return false, err
+	}
+	generated, err := readPackage(generatedPath)
+	if err != nil {
+		return false, err
+	}
+	return diffFiles(w, label, committed, generated)
+}
+
+// diffFiles writes a unified diff between the committed and generated files,
+// keyed by name, to w, and reports whether they differ. Files are labeled by
+// their name, prefixed by label. A file missing from either is treated as
+// empty.
+func diffFiles(w io.Writer, label string, committed, generated map[string]string) (bool, error) {
+	names := []string{}
+	for name := range committed {
+		names = append(names, name)
+	}
+	for name := range generated {
+		if _, ok := committed[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	differs := false
+	for _, name := range names {
+		d, err := diffFile(w, label+"/"+name, committed[name], generated[name])
+		if err != nil {
+			return false, err
+		}
+		differs = differs || d
+	}
+	return differs, nil
+}
+
+// diffFile writes a unified diff between the committed and generated contents
+// of the file labeled label to w, and reports whether they differ.
+func diffFile(w io.Writer, label, committed, generated string) (bool, error) {
+	if committed == generated {
+		return false, nil
+	}
+	diff := difflib.UnifiedDiff{
+		A:        splitLines(committed),
+		B:        splitLines(generated),
+		FromFile: "a/" + label,
+		ToFile:   "b/" + label,
+		Context:  3,
+	}
+	return true, difflib.WriteUnifiedDiff(w, diff)
+}
+
+// splitLines splits s into lines for a diff, each ending in a newline. Unlike
+// difflib.SplitLines, it does not count the empty remainder after a final
+// newline as a line, so an empty s has no lines and the hunk of a file that has
+// been added or removed is headed by a range of 0,0.
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	last := len(lines) - 1
+	if lines[last] == "" {
+		return lines[:last]
+	}
+	lines[last] += "\n"
+	return lines
+}
+
+// label returns the path of fileName relative to the module root, for use in
+// a diff.
+func (g generator) label(fileName string) string {
+	rel, err := filepath.Rel(g.mod.Root, fileName)
+	if err != nil {
+		return filepath.ToSlash(fileName)
+	}
+	return filepath.ToSlash(rel)
+}
+
+// readPackage returns the contents of each file in dir, keyed by name.
+func readPackage(dir string) (map[string]string, error) {
+	result := map[string]string{}
+	infos, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range infos {
+		if info.IsDir() {
+			continue
+		}
+		contents, err := ioutil.ReadFile(filepath.Join(dir, info.Name()))
+		if err != nil {
+			return nil, err
+		}
+		result[info.Name()] = string(contents)
+	}
+	return result, nil
+}
+
+// writePackage replaces the contents of the directory packagePath with files.
+func writePackage(packagePath string, files []generatedFile) error {
+	if err := os.RemoveAll(packagePath); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(packagePath, 0755); err != nil {
+		return err
+	}
+	for _, f := range files {
+		if err := ioutil.WriteFile(filepath.Join(packagePath, f.name), f.source, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheck(t *testing.T) {
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+	mod, err := findModule(wd)
+	assert.NoError(t, err)
+	output, err := ioutil.TempDir("", "output")
+	assert.NoError(t, err)
+	defer os.RemoveAll(output)
+
+	g := generator{
+		mod:        mod,
+		importer:   newImporter(),
+		sourcePath: filepath.Join(wd, "testdata/template"),
+		outputPath: output,
+	}
+	names := []typeNames{{PackageName: "stringslice", PrimitiveType: "string", SliceType: "StringSlice", ZeroValue: `""`}}
+
+	var diff bytes.Buffer
+	stale, err := g.check(names, nil, &diff)
+	assert.NoError(t, err)
+	assert.True(t, stale, "a missing package is stale")
+	assert.Contains(t, diff.String(), "+++ b/"+filepath.ToSlash(mustRel(t, mod.Root, output))+"/stringslice/types.go\n")
+	assert.Regexp(t, `(?m)^\+\+\+ b/.*/stringslice/types\.go\n@@ -0,0 \+1,\d+ @@\n`, diff.String())
+	_, err = os.Stat(filepath.Join(output, "stringslice"))
+	assert.True(t, os.IsNotExist(err), "check must not write the output directory")
+
+	files, err := g.generate(names[0])
+	assert.NoError(t, err)
+	packagePath := filepath.Join(output, "stringslice")
+	assert.NoError(t, writePackage(packagePath, files))
+	diff.Reset()
+	stale, err = g.check(names, nil, &diff)
+	assert.NoError(t, err)
+	assert.False(t, stale)
+	assert.Empty(t, diff.String())
+
+	typesFile := filepath.Join(packagePath, "types.go")
+	contents, err := ioutil.ReadFile(typesFile)
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(typesFile, append(contents, "\n// edited\n"...), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(packagePath, "extra.go"), []byte("package stringslice\n"), 0644))
+	diff.Reset()
+	stale, err = g.check(names, nil, &diff)
+	assert.NoError(t, err)
+	assert.True(t, stale)
+	assert.Contains(t, diff.String(), "-// edited\n")
+	assert.Contains(t, diff.String(), "-package stringslice\n")
+}
+
+func TestCheckReportsLeftoverPackages(t *testing.T) {
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+	mod, err := findModule(wd)
+	assert.NoError(t, err)
+	output, err := ioutil.TempDir("", "output")
+	assert.NoError(t, err)
+	defer os.RemoveAll(output)
+
+	g := generator{
+		mod:        mod,
+		importer:   newImporter(),
+		sourcePath: filepath.Join(wd, "testdata/template"),
+		outputPath: output,
+	}
+	names := []typeNames{{PackageName: "stringslice", PrimitiveType: "string", SliceType: "StringSlice", ZeroValue: `""`}}
+	files, err := g.generate(names[0])
+	assert.NoError(t, err)
+	assert.NoError(t, writePackage(filepath.Join(output, "stringslice"), files))
+	assert.NoError(t, writePackage(filepath.Join(output, "intslice"), files))
+	assert.NoError(t, writePackage(filepath.Join(output, "handwritten"), []generatedFile{{name: "a.go", source: []byte("package handwritten\n")}}))
+
+	var diff bytes.Buffer
+	stale, err := g.check(names, nil, &diff)
+	assert.NoError(t, err)
+	assert.True(t, stale, "a package the configuration no longer produces is stale")
+	assert.Contains(t, diff.String(), "--- a/"+filepath.ToSlash(mustRel(t, mod.Root, output))+"/intslice/types.go\n")
+	assert.Regexp(t, `(?m)^\+\+\+ b/.*/intslice/types\.go\n@@ -1,\d+ \+0,0 @@\n`, diff.String())
+	assert.NotContains(t, diff.String(), "/stringslice/")
+	assert.NotContains(t, diff.String(), "handwritten")
+
+	assert.NoError(t, os.RemoveAll(filepath.Join(output, "intslice")))
+	diff.Reset()
+	stale, err = g.check(names, nil, &diff)
+	assert.NoError(t, err)
+	assert.False(t, stale, "a package without generated files is not ours to report")
+	assert.Empty(t, diff.String())
+}
+
+func mustRel(t *testing.T, base, target string) string {
+	rel, err := filepath.Rel(base, target)
+	assert.NoError(t, err)
+	return rel
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// config describes a single run of the generator. Source and Output are
+// resolved relative to the module root (the nearest directory, at or above
+// the working directory, that contains a go.mod file) unless they are
+// absolute.
+type config struct {
+	// Source is the directory of the package that serves as the template for
+	// each generated package.
+	Source string `json:"source" yaml:"source"`
+
+	// Output is the directory beneath which a directory is created for each
+	// generated package.
+	Output string `json:"output" yaml:"output"`
+
+	// Types lists the element types for which packages are generated.
+	Types []string `json:"types" yaml:"types"`
+
+	// Type names a type declared in another package, as its import path and
+	// name joined by a dot (github.com/acme/billing.Invoice). If set, packages
+	// are generated for that type alone, and Types is ignored.
+	Type string `json:"type" yaml:"type"`
+
+	// Pointer, if set, makes a pointer to Type the element type.
+	Pointer bool `json:"pointer" yaml:"pointer"`
+
+	// Depth is the highest dimension of slice for which packages are
+	// generated. A depth of three, for example, generates intslice, intslice2
+	// and intslice3 for int.
+	Depth int `json:"depth" yaml:"depth"`
+
+	// Check, if set, compares freshly generated packages with those beneath
+	// Output rather than replacing them. It may only be set by flag.
+	Check bool `json:"-" yaml:"-"`
+
+	// Tests lists the test files of the template package that are generated
+	// alongside each package. Each must belong to the template's external test
+	// package, and may not depend upon the template's element type.
+	Tests []string `json:"tests" yaml:"tests"`
+}
+
+var defaultConfig = config{
+	Source: "pkg/slices/generic",
+	Output: "pkg/slices",
+	Types:  primitiveTypeNames(),
+	Depth:  2,
+	Tests:  []string{"const_test.go", "methods_test.go", "spec_test.go", "functions_test.go"},
+}
+
+// primitiveTypeNames returns the names of each of the predefined primitive
+// types.
+func primitiveTypeNames() []string {
+	names := []string{}
+	for _, p := range primitiveTypes {
+		names = append(names, p.TypeName)
+	}
+	return names
+}
+
+// module describes the module in which the generator is run.
+type module struct {
+	Root string // absolute path of the directory containing go.mod
+	Path string // module path declared in go.mod
+}
+
+// loadConfig builds a config from the supplied command line arguments.
+// Values are taken from the defaults, then from the file named by the -config
+// flag (if any), and finally from any explicitly set flags.
+func loadConfig(args []string) (config, error) {
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	configFile := flags.String("config", "", "path to a JSON or YAML configuration file")
+	source := flags.String("source", "", "template package directory, relative to the module root (default "+defaultConfig.Source+")")
+	output := flags.String("output", "", "root directory for generated packages, relative to the module root (default "+defaultConfig.Output+")")
+	types := flags.String("types", "", "comma separated list of element types (default all primitive types)")
+	namedType := flags.String("type", "", "a type from another package, as importpath.TypeName, to generate in place of -types")
+	pointer := flags.Bool("pointer", false, "with -type, make a pointer to the type the element type")
+	depth := flags.Int("depth", 0, "highest dimension of slice for which packages are generated (default 2)")
+	check := flags.Bool("check", false, "report differences from the generated packages as a unified diff, without writing them")
+	if err := flags.Parse(args); err != nil {
+		return config{}, err
+	}
+
+	cfg := defaultConfig
+	if *configFile != "" {
+		fileCfg, err := readConfigFile(*configFile)
+		if err != nil {
+			return config{}, err
+		}
+		cfg = mergeConfig(cfg, fileCfg)
+	}
+
+	flagCfg := config{Source: *source, Output: *output, Type: *namedType, Pointer: *pointer, Depth: *depth}
+	if *types != "" {
+		for _, t := range strings.Split(*types, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				flagCfg.Types = append(flagCfg.Types, t)
+			}
+		}
+	}
+	cfg = mergeConfig(cfg, flagCfg)
+	cfg.Check = *check
+
+	if len(cfg.Types) == 0 && cfg.Type == "" {
+		return config{}, errors.New("no types were specified")
+	}
+	if cfg.Pointer && cfg.Type == "" {
+		return config{}, errors.New("pointer may only be used with type")
+	}
+	if cfg.Depth < 1 {
+		return config{}, fmt.Errorf("invalid depth %v: at least one dimension must be generated", cfg.Depth)
+	}
+	return cfg, nil
+}
+
+// readConfigFile reads a config from a JSON or YAML file. The format is
+// determined by the file's extension.
+func readConfigFile(fileName string) (config, error) {
+	var cfg config
+	contents, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return cfg, err
+	}
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".json":
+		err = json.Unmarshal(contents, &cfg)
+	case ".yaml", ".yml":
+		err = yaml.UnmarshalStrict(contents, &cfg)
+	default:
+		err = fmt.Errorf("unrecognized config file extension %q (expected .json, .yaml, or .yml)", filepath.Ext(fileName))
+	}
+	if err != nil {
+		return cfg, fmt.Errorf("%v: %v", fileName, err)
+	}
+	return cfg, nil
+}
+
+// mergeConfig returns base, with any non-zero values from override applied.
+func mergeConfig(base, override config) config {
+	if override.Source != "" {
+		base.Source = override.Source
+	}
+	if override.Output != "" {
+		base.Output = override.Output
+	}
+	if len(override.Types) > 0 {
+		base.Types = override.Types
+	}
+	if len(override.Tests) > 0 {
+		base.Tests = override.Tests
+	}
+	if override.Type != "" {
+		base.Type = override.Type
+	}
+	if override.Pointer {
+		base.Pointer = true
+	}
+	if override.Depth != 0 {
+		base.Depth = override.Depth
+	}
+	return base
+}
+
+// findModule locates the module containing dir by walking up the directory
+// tree until a go.mod file is found.
+func findModule(dir string) (module, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return module{}, err
+	}
+	for {
+		goMod := filepath.Join(dir, "go.mod")
+		if _, err := os.Stat(goMod); err == nil {
+			path, err := readModulePath(goMod)
+			if err != nil {
+				return module{}, err
+			}
+			return module{Root: dir, Path: path}, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return module{}, errors.New("unable to find go.mod in the working directory or any of its parents")
+		}
+		dir = parent
+	}
+}
+
+// readModulePath returns the module path declared in the supplied go.mod file.
+func readModulePath(goMod string) (string, error) {
+	f, err := os.Open(goMod)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], `"`), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("%v: no module directive found", goMod)
+}
+
+// resolve returns path as an absolute path, treating relative paths as
+// relative to the module root.
+func (m module) resolve(path string) string {
+	if filepath.IsAbs(path) {
+		return filepath.Clean(path)
+	}
+	return filepath.Join(m.Root, filepath.FromSlash(path))
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadConfigDefaults(t *testing.T) {
+	cfg, err := loadConfig(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultConfig, cfg)
+}
+
+func TestLoadConfigFlagsOverrideFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "generate")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	fileNames := map[string]string{
+		"generate.json": `{"output": "out", "types": ["int", "string"]}`,
+		"generate.yaml": "output: out\ntypes:\n  - int\n  - string\n",
+	}
+	for name, contents := range fileNames {
+		fileName := filepath.Join(dir, name)
+		assert.NoError(t, ioutil.WriteFile(fileName, []byte(contents), 0644))
+
+		cfg, err := loadConfig([]string{"-config", fileName})
+		assert.NoError(t, err)
+		assert.Equal(t, config{Source: defaultConfig.Source, Output: "out", Types: []string{"int", "string"}, Depth: defaultConfig.Depth, Tests: defaultConfig.Tests}, cfg)
+
+		cfg, err = loadConfig([]string{"-config", fileName, "-types", "bool, float64"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"bool", "float64"}, cfg.Types)
+	}
+}
+
+func TestLoadConfigRejectsUnknownFormats(t *testing.T) {
+	dir, err := ioutil.TempDir("", "generate")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	fileName := filepath.Join(dir, "generate.toml")
+	assert.NoError(t, ioutil.WriteFile(fileName, []byte(""), 0644))
+	_, err = loadConfig([]string{"-config", fileName})
+	assert.Error(t, err)
+}
+
+func TestFindModule(t *testing.T) {
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+	mod, err := findModule(filepath.Join(wd, "..", "pkg", "slices", "generic"))
+	assert.NoError(t, err)
+	assert.Equal(t, "github.com/ideoterra/transforms", mod.Path)
+	assert.Equal(t, filepath.Dir(wd), mod.Root)
+	assert.Equal(t, filepath.Join(mod.Root, "pkg", "slices"), mod.resolve("pkg/slices"))
+	assert.Equal(t, "/tmp", mod.resolve("/tmp"))
+}
+
+func TestLoadConfigNamedType(t *testing.T) {
+	cfg, err := loadConfig([]string{"-type", "github.com/acme/billing.Invoice", "-pointer"})
+	assert.NoError(t, err)
+	assert.Equal(t, "github.com/acme/billing.Invoice", cfg.Type)
+	assert.True(t, cfg.Pointer)
+
+	_, err = loadConfig([]string{"-pointer"})
+	assert.Error(t, err)
+}
+
+func TestLoadConfigDepth(t *testing.T) {
+	cfg, err := loadConfig(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, cfg.Depth)
+
+	cfg, err = loadConfig([]string{"-depth", "4"})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, cfg.Depth)
+
+	_, err = loadConfig([]string{"-depth", "-1"})
+	assert.Error(t, err)
+}
+
+func TestLoadConfigCheck(t *testing.T) {
+	cfg, err := loadConfig([]string{"-check"})
+	assert.NoError(t, err)
+	assert.True(t, cfg.Check)
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"strings"
+	"text/template"
+)
+
+// Generated packages may not import one another (each would import all of the
+// others), so a conversion returns an unnamed slice of the target element
+// type. The result may be converted to the target package's slice type where
+// that package is imported.
+var conversionTemplate = template.Must(template.New("conversion").Parse(`{{.Notice}}
+
+package {{.PackageName}}
+{{range .Conversions}}
+// As{{.SliceTypeB}} applies convertFn to each element of aa, and returns the
+// results as a []{{.PrimitiveTypeB}}.
+func As{{.SliceTypeB}}(aa []{{.PrimitiveTypeA}}, convertFn func({{.PrimitiveTypeA}}) {{.PrimitiveTypeB}}) []{{.PrimitiveTypeB}} {
+	bb := []{{.PrimitiveTypeB}}{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+{{end}}{{range .Conversions}}
+// As{{.SliceTypeB}} applies convertFn to each element of aa, and returns the
+// results as a []{{.PrimitiveTypeB}}, which may be converted to a {{.SliceTypeB}}
+// where required.
+func (aa *{{.SliceTypeA}}) As{{.SliceTypeB}}(convertFn func({{.PrimitiveTypeA}}) {{.PrimitiveTypeB}}) []{{.PrimitiveTypeB}} {
+	return As{{.SliceTypeB}}(*aa, convertFn)
+}
+{{end}}`))
+
+var conversionTestTemplate = template.Must(template.New("conversionTest").Parse(`{{.Notice}}
+
+package {{.PackageName}}_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"{{.ImportPath}}"
+)
+{{range .Conversions}}
+func TestAs{{.SliceTypeB}}(t *testing.T) {
+	convertFn := func(a {{.PrimitiveTypeA}}) {{.PrimitiveTypeB}} {
+		assert.Equal(t, {{.SampleA}}, a)
+		return {{.SampleB}}
+	}
+
+	aa := {{$.PackageName}}.{{.SliceTypeA}}{ {{.SampleA}}, {{.SampleA}} }
+	bb := aa.As{{.SliceTypeB}}(convertFn)
+	assert.Equal(t, []{{.PrimitiveTypeB}}{ {{.SampleB}}, {{.SampleB}} }, bb)
+	assert.Equal(t, {{$.PackageName}}.{{.SliceTypeA}}{ {{.SampleA}}, {{.SampleA}} }, aa)
+
+	cc := {{$.PackageName}}.As{{.SliceTypeB}}(nil, convertFn)
+	assert.Equal(t, []{{.PrimitiveTypeB}}{}, cc)
+}
+{{end}}`))
+
+// conversionSample is a conversion, together with the sample values used to
+// test it.
+type conversionSample struct {
+	conversionNames
+	SampleA string
+	SampleB string
+}
+
+// conversionFiles returns the source and test files for the conversions
+// declared in the package named by t, if any.
+func conversionFiles(t typeNames, importPath string, conversions []conversionNames) ([]generatedFile, error) {
+	data := struct {
+		Notice      string
+		PackageName string
+		ImportPath  string
+		Conversions []conversionSample
+	}{
+		Notice:      generatedNotice,
+		PackageName: t.PackageName,
+		ImportPath:  importPath,
+	}
+	fileName := ""
+	for _, c := range conversions {
+		if c.PackageName != t.PackageName {
+			continue
+		}
+		fileName = c.FileName
+		data.Conversions = append(data.Conversions, conversionSample{
+			conversionNames: c,
+			SampleA:         sampleLiteral(c.PrimitiveTypeA, c.PrimitiveTypeAZeroValue),
+			SampleB:         sampleLiteral(c.PrimitiveTypeB, c.PrimitiveTypeBZeroValue),
+		})
+	}
+	if len(data.Conversions) == 0 {
+		return nil, nil
+	}
+
+	result := []generatedFile{}
+	for _, f := range []struct {
+		name string
+		tmpl *template.Template
+	}{
+		{fileName, conversionTemplate},
+		{strings.TrimSuffix(fileName, ".go") + "_test.go", conversionTestTemplate},
+	} {
+		var buf bytes.Buffer
+		if err := f.tmpl.Execute(&buf, data); err != nil {
+			return nil, err
+		}
+		source, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, generatedFile{name: f.name, source: source})
+	}
+	return result, nil
+}
+
+// sampleLiteral returns a typed Go expression for a value of typeName, built
+// from zero. If typeName is a slice type, the expression is a slice holding
+// zero, nested as deeply as typeName requires.
+func sampleLiteral(typeName string, zero interface{}) string {
+	if depth := strings.Count(typeName, "[]"); depth > 0 {
+		return typeName + strings.Repeat("{", depth) + goLiteral(zero) + strings.Repeat("}", depth)
+	}
+	switch zero.(type) {
+	case string, bool:
+		return goLiteral(zero)
+	default:
+		return typeName + "(" + goLiteral(zero) + ")"
+	}
+}
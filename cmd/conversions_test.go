@@ -0,0 +1,62 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateConversionNames(t *testing.T) {
+	conversions := generateConversionNames(primitiveTypesFor([]string{"int", "string", "float64"}), 2)
+	assert.Len(t, conversions, 12)
+	assert.Contains(t, conversions, conversionNames{
+		FileName:                "intslice2conv.go",
+		PackageName:             "intslice2",
+		PrimitiveTypeA:          "[]int",
+		PrimitiveTypeB:          "[]string",
+		SliceTypeA:              "IntSlice2",
+		SliceTypeB:              "StringSlice2",
+		PrimitiveTypeAZeroValue: 0,
+		PrimitiveTypeBZeroValue: "",
+	})
+
+	conversions = generateConversionNames(primitiveTypesFor([]string{"int", "string"}), 3)
+	assert.Len(t, conversions, 6)
+	assert.Contains(t, conversions, conversionNames{
+		FileName:                "stringslice3conv.go",
+		PackageName:             "stringslice3",
+		PrimitiveTypeA:          "[][]string",
+		PrimitiveTypeB:          "[][]int",
+		SliceTypeA:              "StringSlice3",
+		SliceTypeB:              "IntSlice3",
+		PrimitiveTypeAZeroValue: "",
+		PrimitiveTypeBZeroValue: 0,
+	})
+}
+
+func TestConversionFiles(t *testing.T) {
+	conversions := generateConversionNames(primitiveTypesFor([]string{"int", "float64"}), 2)
+	names := generateTypeNames(primitiveTypesFor([]string{"int"})[0], 2)[0]
+	files, err := conversionFiles(names, "example.com/intslice", conversions)
+	assert.NoError(t, err)
+	if assert.Len(t, files, 2) {
+		assert.Equal(t, "intsliceconv.go", files[0].name)
+		assert.Contains(t, string(files[0].source), "func AsFloat64Slice(aa []int, convertFn func(int) float64) []float64 {")
+		assert.Contains(t, string(files[0].source), "func (aa *IntSlice) AsFloat64Slice(convertFn func(int) float64) []float64 {")
+		assert.Equal(t, "intsliceconv_test.go", files[1].name)
+		assert.Contains(t, string(files[1].source), "func TestAsFloat64Slice(t *testing.T) {")
+	}
+
+	names.PackageName = "stringslice"
+	files, err = conversionFiles(names, "example.com/stringslice", conversions)
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestSampleLiteral(t *testing.T) {
+	assert.Equal(t, "int8(0)", sampleLiteral("int8", int8(0)))
+	assert.Equal(t, `""`, sampleLiteral("string", ""))
+	assert.Equal(t, "false", sampleLiteral("bool", false))
+	assert.Equal(t, "[]float32{0}", sampleLiteral("[]float32", float32(0)))
+	assert.Equal(t, "[][][]float32{{{0}}}", sampleLiteral("[][][]float32", float32(0)))
+}
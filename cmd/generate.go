@@ -1,245 +1,382 @@
 package main
 
 import (
-	"io"
+	"fmt"
+	"go/importer"
+	"go/token"
+	"go/types"
 	"io/ioutil"
 	"log"
 	"os"
+	"path"
 	"path/filepath"
-	"regexp"
+	"strconv"
 	"strings"
-
-	"github.com/ideoterra/transforms/pkg/slices/generic"
-	"github.com/ideoterra/transforms/pkg/slices/shared"
 )
 
 type primitiveType struct {
 	TypeName  string
 	ZeroValue interface{}
+	Samples   []interface{} // distinct values, in ascending order, for generated tests
 }
 
+// numericSamples are written out as untyped constants (see goLiteral), so may
+// be used for any numeric type.
+var numericSamples = []interface{}{1, 2, 3, 4, 5}
+
 var primitiveTypes = []primitiveType{
-	primitiveType{"int", int(0)},
+	primitiveType{"bool", false, []interface{}{false, true}},
+	primitiveType{"byte", byte(0), numericSamples},
+	primitiveType{"complex64", complex64(0), numericSamples},
+	primitiveType{"complex128", complex128(0), numericSamples},
+	primitiveType{"float32", float32(0), numericSamples},
+	primitiveType{"float64", float64(0), numericSamples},
+	primitiveType{"int", int(0), numericSamples},
+	primitiveType{"int8", int8(0), numericSamples},
+	primitiveType{"int16", int16(0), numericSamples},
+	primitiveType{"int32", int32(0), numericSamples},
+	primitiveType{"int64", int64(0), numericSamples},
+	primitiveType{"rune", rune(0), numericSamples},
+	primitiveType{"string", "", []interface{}{"a", "b", "c", "d", "e"}},
+	primitiveType{"uint", uint(0), numericSamples},
+	primitiveType{"uint8", uint8(0), numericSamples},
+	primitiveType{"uint16", uint16(0), numericSamples},
+	primitiveType{"uint32", uint32(0), numericSamples},
+	primitiveType{"uint64", uint64(0), numericSamples},
 }
 
 type typeNames struct {
-	IsLastGeneration bool // tells the generator to fix up the []SliceType2 values in types.go
-	PackageName      string
-	PrimitiveType    string
-	SliceType        string
-	SliceType2       string
+	PackageName   string
+	PrimitiveType string // the element type, as a Go type expression
+	SliceType     string
+	ZeroValue     string // the zero value of the element type, as a Go expression
+	Samples       string // a slice of distinct values of the element type, as a Go expression
+	ImportPath    string // of the package declaring the element type, unless it is predeclared
+	Next          string // the slice type of the next dimension up, qualified by its package, if generated
 }
 
-const (
-	basePath    = `/Users/joe/workspace/go/src/github.com/ideoterra/transforms/pkg/slices`
-	genericPath = basePath + "/generic"
-)
+// samples returns the Samples expression, or an empty slice if there are no
+// samples.
+func (t typeNames) samples() string {
+	if t.Samples == "" {
+		return "[]" + t.PrimitiveType + "{}"
+	}
+	return t.Samples
+}
 
+// The generator derives a package for each configured type from the template
+// package (pkg/slices/generic by default). The template is parsed and
+// type-checked, and the following rewrites are applied to its syntax tree:
+//
+//   - interface{} and PrimitiveType are replaced by the element type,
+//   - SliceType is renamed (to IntSlice, for example), and SortedSliceType
+//     likewise (to SortedIntSlice),
+//   - SliceType2 is replaced by a slice of slices of the element type,
+//   - type assertions to slices of the element type become conversions,
+//   - declarations from packages beneath the template directory (such as
+//     closures, but for its reflective field closures) are copied into the
+//     generated package, and references to them are unqualified,
+//   - unused imports are removed, and the package clause is rewritten.
+//
+// A package is generated for each dimension of slice from one up to the
+// configured depth, which is two by default: intslice declares IntSlice, a
+// []int, intslice2 declares IntSlice2, a [][]int, intslice3 declares IntSlice3,
+// a [][][]int, and so on. Within each, SliceType2 is the next dimension up, so
+// Group, Partition and the Split functions of intslice2 return a [][][]int,
+// which converts to an intslice3.IntSlice3, and Flatten takes one. Generation
+// stops at the configured depth, where those functions return unnamed slices:
+//
+//	go run ./cmd -types=float64 -depth=4
+//
+// Conversions between each pair of configured types (AsFloat64Slice, for
+// example) are also generated in each dimension, along with their tests.
+//
+// Comments are updated to match. The template test files named by the
+// configuration are rewritten in the same way to test each generated package,
+// with the primitiveZero variable given the zero value of the element type, and
+// the primitiveSamples variable given sample values of it. The template's
+// specifications (see pkg/slices/generic/functions_test.go) are written in terms
+// of those samples, so the same specifications exercise every generated package.
+// Each generated package is type-checked, along with its tests, before it is
+// written, and its files are formatted with gofmt.
+//
+// The generator is typically invoked through a go:generate directive (see
+// pkg/slices/generic/doc.go), but may also be run directly from anywhere
+// within the module:
+//
+//	go run ./cmd -types=int,string
+//	go run ./cmd -config=generate.yaml
+//
+// Packages may also be generated for a type declared in another package, in
+// place of the primitive types. The element type is then qualified by that
+// package, which is imported wherever it is used:
+//
+//	go run ./cmd -type=github.com/acme/billing.Invoice -pointer
+//
+// With -check, the generator writes nothing. Packages are instead regenerated
+// into a temporary directory, and a unified diff against those beneath the
+// output directory is printed, along with the removal of any generated package
+// beneath it that the configuration no longer produces. The generator exits
+// with a non-zero status if any differ:
+//
+//	go run ./cmd -check
+//
+// Paths are resolved relative to the module root, so the working directory
+// from which the generator is run does not matter.
 func main() {
-	for _, p := range primitiveTypes {
-		typeNames := generateTypeNames(p)
+	cfg, err := loadConfig(os.Args[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
 
-		log.Println("Purging generated type directories...")
-		fileInfos, err := ioutil.ReadDir(basePath)
+	wd, err := os.Getwd()
+	if err != nil {
+		log.Fatal(err)
+	}
+	mod, err := findModule(wd)
+	if err != nil {
+		log.Fatal(err)
+	}
+	g := generator{
+		mod:        mod,
+		importer:   newImporter(),
+		sourcePath: mod.resolve(cfg.Source),
+		outputPath: mod.resolve(cfg.Output),
+		tests:      cfg.Tests,
+	}
+	names := []typeNames{}
+	if cfg.Type != "" {
+		n, err := loadNamedType(cfg.Type, cfg.Pointer, g.importer)
 		if err != nil {
 			log.Fatal(err)
 		}
-		for _, fileInfo := range fileInfos {
-			if fileInfo.Name() == "generic" || fileInfo.Name() == "shared" {
-				continue
-			}
+		names = generateNamedTypeNames(n, cfg.Depth)
+	} else {
+		primitives := primitiveTypesFor(cfg.Types)
+		g.conversions = generateConversionNames(primitives, cfg.Depth)
+		for _, p := range primitives {
+			names = append(names, generateTypeNames(p, cfg.Depth)...)
+		}
+	}
 
-			fileToRemove := filepath.Join(basePath, fileInfo.Name())
-			log.Printf("Purging %v...", fileToRemove)
-			err := os.RemoveAll(fileToRemove)
-			if err != nil {
-				log.Fatal(err)
-			}
+	methods, err := deriveMethods(g.sourcePath, g.importer)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if cfg.Check {
+		stale, err := g.check(names, methods, os.Stdout)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if stale {
+			log.Fatalf("Generated packages beneath %v are stale; regenerate them with go generate.", g.outputPath)
 		}
+		return
+	}
 
-		for _, t := range typeNames {
-			log.Println("Retrieving list of source files...")
-			fileInfos, err := ioutil.ReadDir(genericPath)
-			if err != nil {
-				log.Fatal(err)
-			}
+	if methods != nil {
+		fileName := filepath.Join(g.sourcePath, methods.name)
+		log.Printf("Deriving %v...", fileName)
+		if err := ioutil.WriteFile(fileName, methods.source, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
 
-			log.Printf("Copying source files from generic to %v...\n", t.PackageName)
-			newBaseName := filepath.Join(basePath, t.PackageName)
-			for _, fileInfo := range fileInfos {
-				if strings.Contains(fileInfo.Name(), "_test") || fileInfo.Name() == "doc.go" {
-					continue
-				}
-				oldName := filepath.Join(genericPath, fileInfo.Name())
-				newName := newBaseName + fileInfo.Name()
-				err := copyFile(oldName, newName)
-				if err != nil {
-					log.Fatal(err)
-				}
-			}
+	for _, t := range names {
+		packagePath := filepath.Join(g.outputPath, t.PackageName)
+		if packagePath == g.sourcePath {
+			log.Fatalf("Refusing to overwrite the source package %v.", g.sourcePath)
+		}
 
-			basicReplacementFiles := []string{
-				"functions.go",
-				"methods.go",
-				"types.go",
-			}
-			for _, basicFile := range basicReplacementFiles {
-				fileName := newBaseName + basicFile
-				replaceTextInFile(fileName, "PrimitiveType", t.PrimitiveType)
-				replaceTextInFile(fileName, "SliceType2", t.SliceType2)
-				replaceTextInFile(fileName, "SliceType", t.SliceType)
-				replaceTextInFile(fileName, "generic", "slicexform")
-				if strings.Contains(fileName, "types.go") && t.IsLastGeneration {
-					removeLinesContainingValue(fileName, "[]"+t.SliceType)
-				}
-				if strings.Contains(fileName, "functions.go") {
-					functionNames := getFunctionNamesForFile(fileName)
-					functionNames.Sort(func(a, b generic.PrimitiveType) bool {
-						return len(a.(string)) < len(b.(string))
-					}).Distinct(func(a, b generic.PrimitiveType) bool {
-						name1 := a.(string)
-						name2 := b.(string)
-						if strings.Contains(name1, "Fold") && strings.Contains(name2, "Fold") {
-							log.Println("break")
-						}
-						return strings.Contains(name1, name2)
-					}).ForEach(func(a generic.PrimitiveType) shared.Continue {
-						functionName := a.(string)
-						replaceTextInFile(fileName, functionName, t.SliceType+functionName)
-						return shared.ContinueYes
-					})
-				}
-			}
+		log.Printf("Generating %v from %v...", packagePath, g.sourcePath)
+		files, err := g.generate(t)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		log.Printf("Replacing %v...", packagePath)
+		if err := writePackage(packagePath, files); err != nil {
+			log.Fatal(err)
 		}
 	}
 }
 
-func generateTypeNames(p primitiveType) []typeNames {
-	result := []typeNames{}
-	oneDimensionalSliceType := typeNames{
-		IsLastGeneration: false,
-		PackageName:      p.TypeName + "slice",
-		PrimitiveType:    p.TypeName,
-		SliceType:        strings.Title(p.TypeName) + "Slice",
-		SliceType2:       strings.Title(p.TypeName) + "Slice2",
-	}
+// newImporter returns an importer that type-checks imported packages from
+// source, so that packages within the module are always current.
+func newImporter() types.Importer {
+	return importer.ForCompiler(token.NewFileSet(), "source", nil)
+}
 
-	twoDimensionalSliceType := typeNames{
-		IsLastGeneration: true,
-		PackageName:      p.TypeName + "slice2",
-		PrimitiveType:    strings.Title(p.TypeName) + "Slice",
-		SliceType:        strings.Title(p.TypeName) + "Slice2",
-		SliceType2:       "[]" + strings.Title(p.TypeName) + "Slice2",
-	}
+// generator derives packages from a template package.
+type generator struct {
+	mod         module
+	importer    types.Importer
+	sourcePath  string   // directory of the template package
+	outputPath  string   // directory beneath which packages are generated
+	tests       []string // template test files to generate alongside each package
+	conversions []conversionNames
+}
 
-	result = append(result, oneDimensionalSliceType, twoDimensionalSliceType)
-	return result
+// generate derives the package described by t from the template package, and
+// verifies that it compiles.
+func (g generator) generate(t typeNames) ([]generatedFile, error) {
+	tmpl, err := loadTemplate(g.sourcePath, g.tests, g.mod, g.importer)
+	if err != nil {
+		return nil, err
+	}
+	importPath := g.importPath(t.PackageName)
+	files, err := tmpl.generate(t, importPath)
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, docFile(t))
+	conversions, err := conversionFiles(t, importPath, g.conversions)
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, conversions...)
+	if err := typeCheck(files, importPath, g.importer); err != nil {
+		return nil, fmt.Errorf("%v: %v", t.PackageName, err)
+	}
+	return files, nil
 }
 
-type conversionNames struct {
-	FileName                string // uses SliceTypeA
-	PrimitiveTypeA          string
-	PrimitiveTypeB          string
-	SliceTypeA              string
-	SliceTypeB              string
-	PrimitiveTypeBZeroValue interface{} // for unit test generation
+// importPath returns the import path of the named generated package. Packages
+// generated outside of the module are assumed to be importable by name alone.
+func (g generator) importPath(packageName string) string {
+	rel, err := filepath.Rel(g.mod.Root, filepath.Join(g.outputPath, packageName))
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return packageName
+	}
+	return path.Join(g.mod.Path, filepath.ToSlash(rel))
 }
 
-func generateConversionNames() []conversionNames {
-	result := []conversionNames{}
-	for i, primitiveTypeA := range primitiveTypes {
-		for j, primitiveTypeB := range primitiveTypes {
-			if j == i {
-				continue
-			}
-			oneDimensionalConversion := conversionNames{
-				FileName:                primitiveTypeA.TypeName + "sliceconv.go",
-				PrimitiveTypeA:          primitiveTypeA.TypeName,
-				PrimitiveTypeB:          primitiveTypeB.TypeName,
-				PrimitiveTypeBZeroValue: primitiveTypeB.ZeroValue,
-				SliceTypeA:              strings.Title(primitiveTypeA.TypeName) + "Slice",
-				SliceTypeB:              strings.Title(primitiveTypeB.TypeName) + "Slice",
+// primitiveTypesFor returns the primitiveType definitions for the named types.
+// Types that have no predefined definition are given a nil ZeroValue.
+func primitiveTypesFor(names []string) []primitiveType {
+	result := []primitiveType{}
+	for _, name := range names {
+		p := primitiveType{TypeName: name}
+		for _, known := range primitiveTypes {
+			if known.TypeName == name {
+				p = known
+				break
 			}
-			twoDimensionalConversion := conversionNames{
-				FileName:                primitiveTypeA.TypeName + "slice2conv.go",
-				PrimitiveTypeA:          strings.Title(primitiveTypeA.TypeName) + "Slice",
-				PrimitiveTypeB:          strings.Title(primitiveTypeB.TypeName) + "Slice",
-				PrimitiveTypeBZeroValue: primitiveTypeB.ZeroValue,
-				SliceTypeA:              strings.Title(primitiveTypeA.TypeName) + "Slice2",
-				SliceTypeB:              strings.Title(primitiveTypeB.TypeName) + "Slice2",
-			}
-			result = append(result, oneDimensionalConversion, twoDimensionalConversion)
-			log.Println(oneDimensionalConversion)
-			log.Println(twoDimensionalConversion)
 		}
+		result = append(result, p)
 	}
 	return result
 }
 
-func copyFile(src, dst string) error {
-	// https://stackoverflow.com/a/21061062/3434541
-	in, err := os.Open(src)
-	if err != nil {
-		return err
+// generateTypeNames returns the names of the packages generated for p, in
+// each dimension from one to depth.
+func generateTypeNames(p primitiveType, depth int) []typeNames {
+	result := []typeNames{}
+	for d := 1; d <= depth; d++ {
+		zeroValue := "nil"
+		if d == 1 {
+			zeroValue = goLiteral(p.ZeroValue)
+		}
+		result = append(result, typeNames{
+			PackageName:   p.TypeName + "slice" + dimensionSuffix(d),
+			PrimitiveType: strings.Repeat("[]", d-1) + p.TypeName,
+			SliceType:     strings.Title(p.TypeName) + "Slice" + dimensionSuffix(d),
+			ZeroValue:     zeroValue,
+			Samples:       samplesLiteral(p.TypeName, p.Samples, d-1),
+		})
 	}
-	defer in.Close()
+	return linkDimensions(result)
+}
 
-	out, err := os.Create(dst)
-	if err != nil {
-		return err
+// dimensionSuffix returns the suffix given to the names of the package and
+// slice type generated for the dimension d: none for one dimension, and d
+// itself otherwise (IntSlice, IntSlice2, IntSlice3, and so on).
+func dimensionSuffix(d int) string {
+	if d == 1 {
+		return ""
 	}
-	defer out.Close()
+	return strconv.Itoa(d)
+}
 
-	_, err = io.Copy(out, in)
-	if err != nil {
-		return err
+// linkDimensions sets the Next field of each of names, which are the packages
+// generated for a single element type in ascending dimension, to the slice
+// type of the package that follows it. The last has no successor.
+func linkDimensions(names []typeNames) []typeNames {
+	for i := 0; i+1 < len(names); i++ {
+		names[i].Next = names[i+1].PackageName + "." + names[i+1].SliceType
 	}
-	return out.Close()
+	return names
 }
 
-func replaceTextInFile(fileName, old, new string) {
-	input, err := ioutil.ReadFile(fileName)
-	if err != nil {
-		log.Fatalln(err)
-	}
-	oldContent := string(input)
-	newContent := strings.Replace(oldContent, old, new, -1)
-	err = ioutil.WriteFile(fileName, []byte(newContent), 0644)
-	if err != nil {
-		log.Fatalln(err)
+// goLiteral returns the Go source for the value v, which must be nil or of a
+// predeclared type. The literal is untyped, so may be assigned to any variable
+// of v's type.
+func goLiteral(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return strconv.Quote(v)
+	default:
+		// Numbers and booleans print as valid Go; complex numbers print as
+		// (r+ii), which is also valid Go.
+		return fmt.Sprint(v)
 	}
 }
-func getFunctionNamesForFile(fileName string) generic.SliceType {
-	functionNames := generic.SliceType{}
-	const funcRegex = `func ([A-Z]\w*)\(`
-	re := regexp.MustCompile(funcRegex)
-	input, err := ioutil.ReadFile(fileName)
-	if err != nil {
-		log.Fatalln(err)
+
+// samplesLiteral returns the Go source for a slice of the samples of typeName,
+// or "" if there are none. Each sample is wrapped in nesting slices of its own,
+// for use as the elements of a package of higher dimension.
+func samplesLiteral(typeName string, samples []interface{}, nesting int) string {
+	if len(samples) == 0 {
+		return ""
 	}
-	contents := string(input)
-	for _, submatch := range re.FindAllStringSubmatch(contents, -1) {
-		functionNames.Append(submatch[1])
+	elements := []string{}
+	for _, sample := range samples {
+		element := strings.Repeat("{", nesting) + goLiteral(sample) + strings.Repeat("}", nesting)
+		elements = append(elements, element)
 	}
-	return functionNames
+	sliceType := strings.Repeat("[]", nesting+1) + typeName
+	return sliceType + "{" + strings.Join(elements, ", ") + "}"
 }
 
-func removeLinesContainingValue(fileName, value string) {
-	input, err := ioutil.ReadFile(fileName)
-	if err != nil {
-		log.Fatalln(err)
-	}
-
-	lines := strings.Split(string(input), "\n")
+// conversionNames describes a conversion from a slice of PrimitiveTypeA to a
+// slice of PrimitiveTypeB, declared in the package that declares SliceTypeA.
+type conversionNames struct {
+	FileName                string // uses SliceTypeA
+	PackageName             string // the package declaring SliceTypeA
+	PrimitiveTypeA          string
+	PrimitiveTypeB          string
+	SliceTypeA              string
+	SliceTypeB              string
+	PrimitiveTypeAZeroValue interface{} // for unit test generation
+	PrimitiveTypeBZeroValue interface{} // for unit test generation
+}
 
-	for i, line := range lines {
-		if strings.Contains(line, value) {
-			lines[i] = ""
+// generateConversionNames returns the conversions between every pair of the
+// supplied types, in each dimension from one to depth.
+func generateConversionNames(types []primitiveType, depth int) []conversionNames {
+	result := []conversionNames{}
+	for i, primitiveTypeA := range types {
+		for j, primitiveTypeB := range types {
+			if j == i {
+				continue
+			}
+			for d := 1; d <= depth; d++ {
+				prefix, suffix := strings.Repeat("[]", d-1), dimensionSuffix(d)
+				result = append(result, conversionNames{
+					FileName:                primitiveTypeA.TypeName + "slice" + suffix + "conv.go",
+					PackageName:             primitiveTypeA.TypeName + "slice" + suffix,
+					PrimitiveTypeA:          prefix + primitiveTypeA.TypeName,
+					PrimitiveTypeB:          prefix + primitiveTypeB.TypeName,
+					PrimitiveTypeAZeroValue: primitiveTypeA.ZeroValue,
+					PrimitiveTypeBZeroValue: primitiveTypeB.ZeroValue,
+					SliceTypeA:              strings.Title(primitiveTypeA.TypeName) + "Slice" + suffix,
+					SliceTypeB:              strings.Title(primitiveTypeB.TypeName) + "Slice" + suffix,
+				})
+			}
 		}
 	}
-	output := strings.Join(lines, "\n")
-	err = ioutil.WriteFile(fileName, []byte(output), 0644)
-	if err != nil {
-		log.Fatalln(err)
-	}
+	return result
 }
@@ -0,0 +1,270 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+const (
+	functionsFileName = "functions.go" // declares the functions of a template package
+	methodsFileName   = "methods.go"   // declares the methods derived from them
+)
+
+// A method of SliceType is derived for each exported function of the template
+// whose first parameter is the source slice, aa, of type []interface{} or
+// *[]interface{}. The method has the same name and documentation as the
+// function, and the same parameters less aa, which becomes its receiver. It
+// calls the function, following the conventions described in the template's
+// documentation:
+//
+//   - aa is passed by pointer to functions that mutate it, and by value to
+//     those that do not,
+//   - a []interface{} result is returned as a *SliceType,
+//   - other results, including a SliceType2, are returned as they are, and
+//   - a method whose function has no result returns aa, so that calls may be
+//     chained.
+//
+// Functions of any other form, such as Flatten, which takes a [][]interface{},
+// have no method.
+const methodsPreamble = generatedNotice + `
+
+package %v
+
+%v
+
+func unbox(aa []interface{}) *SliceType {
+	bb := SliceType(aa)
+	return &bb
+}
+
+func boxP(aa *SliceType) *[]interface{} {
+	return (*[]interface{})(aa)
+}
+`
+
+// deriveMethods returns the source of the methods file for the template
+// package in dir, derived from its functions file, and verifies that it
+// compiles. If the template has no functions file, no methods are derived and
+// a nil result is returned.
+func deriveMethods(dir string, importer types.Importer) (*generatedFile, error) {
+	fset := token.NewFileSet()
+	fileName := filepath.Join(dir, functionsFileName)
+	if _, err := os.Stat(fileName); os.IsNotExist(err) {
+		return nil, nil
+	}
+	functions, err := parser.ParseFile(fset, fileName, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, methodsPreamble, functions.Name.Name, importDecl(functions.Imports))
+	for _, decl := range functions.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || !fn.Name.IsExported() || fn.Type.TypeParams != nil {
+			continue
+		}
+		byPointer, ok := isSourceParam(fn.Type.Params)
+		if !ok {
+			continue
+		}
+		if err := writeMethod(&buf, fset, fn, byPointer); err != nil {
+			return nil, fmt.Errorf("%v: %v", fn.Name.Name, err)
+		}
+	}
+
+	methods, err := parser.ParseFile(fset, methodsFileName, buf.Bytes(), parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	for _, spec := range append([]*ast.ImportSpec{}, methods.Imports...) {
+		p, _ := strconv.Unquote(spec.Path.Value)
+		if !astutil.UsesImport(methods, p) {
+			astutil.DeleteImport(fset, methods, p)
+		}
+	}
+
+	buf.Reset()
+	if err := format.Node(&buf, fset, methods); err != nil {
+		return nil, err
+	}
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	result := &generatedFile{name: methodsFileName, source: source}
+	if err := checkMethods(dir, *result, importer); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// importDecl returns an import declaration for specs, with the standard
+// library packages grouped ahead of the others.
+func importDecl(specs []*ast.ImportSpec) string {
+	groups := [2][]string{}
+	for _, spec := range specs {
+		line := spec.Path.Value
+		if spec.Name != nil {
+			line = spec.Name.Name + " " + line
+		}
+		p, _ := strconv.Unquote(spec.Path.Value)
+		if strings.Contains(strings.Split(p, "/")[0], ".") {
+			groups[1] = append(groups[1], line)
+		} else {
+			groups[0] = append(groups[0], line)
+		}
+	}
+	return "import (\n" + strings.Join(groups[0], "\n") + "\n\n" + strings.Join(groups[1], "\n") + "\n)"
+}
+
+// isSourceParam reports whether the first of params is the source slice aa,
+// and whether it is passed by pointer.
+func isSourceParam(params *ast.FieldList) (byPointer, ok bool) {
+	if params == nil || len(params.List) == 0 || len(params.List[0].Names) == 0 || params.List[0].Names[0].Name != "aa" {
+		return false, false
+	}
+	t := params.List[0].Type
+	if star, ok := t.(*ast.StarExpr); ok {
+		return true, isElementSlice(star.X)
+	}
+	return false, isElementSlice(t)
+}
+
+// isElementSlice reports whether expr is the type []interface{}.
+func isElementSlice(expr ast.Expr) bool {
+	array, ok := expr.(*ast.ArrayType)
+	if !ok || array.Len != nil {
+		return false
+	}
+	iface, ok := array.Elt.(*ast.InterfaceType)
+	return ok && isEmptyInterface(iface)
+}
+
+// writeMethod writes the method derived from fn to buf.
+func writeMethod(buf *bytes.Buffer, fset *token.FileSet, fn *ast.FuncDecl, byPointer bool) error {
+	print := func(node ast.Node) (string, error) {
+		var b bytes.Buffer
+		err := printer.Fprint(&b, fset, node)
+		return b.String(), err
+	}
+
+	params := []string{}
+	args := []string{"*aa"}
+	if byPointer {
+		args[0] = "boxP(aa)"
+	}
+	for i, field := range fn.Type.Params.List {
+		names := []string{}
+		for j, name := range field.Names {
+			if i == 0 && j == 0 {
+				continue
+			}
+			names = append(names, name.Name)
+			arg := name.Name
+			if _, ok := field.Type.(*ast.Ellipsis); ok {
+				arg += "..."
+			}
+			args = append(args, arg)
+		}
+		if len(names) == 0 {
+			continue
+		}
+		t, err := print(field.Type)
+		if err != nil {
+			return err
+		}
+		params = append(params, strings.Join(names, ", ")+" "+t)
+	}
+
+	unboxed := false
+	results := ""
+	if fn.Type.Results != nil && len(fn.Type.Results.List) > 0 {
+		fields := []string{}
+		for _, field := range fn.Type.Results.List {
+			t, err := print(field.Type)
+			if err != nil {
+				return err
+			}
+			names := []string{}
+			for _, name := range field.Names {
+				names = append(names, name.Name)
+			}
+			if len(names) > 0 {
+				t = strings.Join(names, ", ") + " " + t
+			}
+			fields = append(fields, t)
+		}
+		results = strings.Join(fields, ", ")
+		if len(fn.Type.Results.List) > 1 || len(fn.Type.Results.List[0].Names) > 0 {
+			results = "(" + results + ")"
+		}
+		if list := fn.Type.Results.List; len(list) == 1 && len(list[0].Names) == 0 && isElementSlice(list[0].Type) {
+			unboxed = true
+			results = "*SliceType"
+		}
+	}
+
+	if fn.Doc != nil {
+		for _, comment := range fn.Doc.List {
+			text := comment.Text
+			if unboxed {
+				text = strings.Replace(text, "[]interface{}", "*SliceType", -1)
+			}
+			buf.WriteString(text + "\n")
+		}
+	}
+	call := fmt.Sprintf("%v(%v)", fn.Name.Name, strings.Join(args, ", "))
+	fmt.Fprintf(buf, "func (aa *SliceType) %v(%v) ", fn.Name.Name, strings.Join(params, ", "))
+	switch {
+	case results == "":
+		fmt.Fprintf(buf, "*SliceType {\n\t%v\n\treturn aa\n}\n\n", call)
+	case unboxed:
+		fmt.Fprintf(buf, "%v {\n\treturn unbox(%v)\n}\n\n", results, call)
+	default:
+		fmt.Fprintf(buf, "%v {\n\treturn %v\n}\n\n", results, call)
+	}
+	return nil
+}
+
+// checkMethods reports an error if the methods file does not compile alongside
+// the other files of the template package in dir, in place of its current
+// methods file.
+func checkMethods(dir string, methods generatedFile, importer types.Importer) error {
+	fset := token.NewFileSet()
+	isPackageFile := func(fi os.FileInfo) bool {
+		name := fi.Name()
+		return !strings.HasSuffix(name, "_test.go") && name != methodsFileName
+	}
+	pkgs, err := parser.ParseDir(fset, dir, isPackageFile, 0)
+	if err != nil {
+		return err
+	}
+	for _, p := range pkgs {
+		files := []*ast.File{}
+		for _, f := range p.Files {
+			files = append(files, f)
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, methods.name), methods.source, 0)
+		if err != nil {
+			return err
+		}
+		conf := types.Config{Importer: importer}
+		if _, err := conf.Check(p.Name, fset, append(files, f), nil); err != nil {
+			return fmt.Errorf("derived methods do not compile: %v", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeriveMethods(t *testing.T) {
+	methods, err := deriveMethods(filepath.Join("testdata", "template"), newImporter())
+	assert.NoError(t, err)
+	if !assert.NotNil(t, methods) {
+		return
+	}
+	source := string(methods.source)
+	assert.Equal(t, methodsFileName, methods.name)
+	assert.Contains(t, source, "// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.")
+	assert.Contains(t, source, "// Pair returns a SliceType2 containing aa twice.\nfunc (aa *SliceType) Pair() SliceType2 {\n\treturn Pair(*aa)\n}")
+	assert.NotContains(t, source, "First", "First does not take the source slice")
+	assert.NotContains(t, source, "Describe", "Describe does not take the source slice")
+	assert.NotContains(t, source, `"fmt"`, "unused imports are pruned")
+}
+
+func TestDeriveMethodsWithoutFunctions(t *testing.T) {
+	methods, err := deriveMethods(filepath.Join("testdata", "billing"), newImporter())
+	assert.NoError(t, err)
+	assert.Nil(t, methods)
+}
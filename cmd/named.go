@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+// namedType is a type declared in another package, for which packages are
+// generated in place of the primitive types.
+type namedType struct {
+	ImportPath  string // of the declaring package
+	PackageName string // of the declaring package
+	TypeName    string
+	Pointer     bool   // if set, the element type is a pointer to the type
+	ZeroValue   string // the zero value of the element type, as a Go expression
+}
+
+// loadNamedType finds the type named by spec (github.com/acme/billing.Invoice,
+// for example) using importer, and describes it.
+func loadNamedType(spec string, pointer bool, importer types.Importer) (namedType, error) {
+	dot := strings.LastIndex(spec, ".")
+	if dot <= 0 || strings.HasSuffix(spec[:dot], "/") {
+		return namedType{}, fmt.Errorf("invalid type %q: expected an import path and type name joined by a dot", spec)
+	}
+	importPath, typeName := spec[:dot], spec[dot+1:]
+	if !token.IsExported(typeName) {
+		return namedType{}, fmt.Errorf("invalid type %q: %v is not exported", spec, typeName)
+	}
+
+	pkg, err := importer.Import(importPath)
+	if err != nil {
+		return namedType{}, err
+	}
+	obj, ok := pkg.Scope().Lookup(typeName).(*types.TypeName)
+	if !ok {
+		return namedType{}, fmt.Errorf("invalid type %q: %v declares no type %v", spec, importPath, typeName)
+	}
+	if named, ok := obj.Type().(*types.Named); ok && named.TypeParams().Len() > 0 {
+		return namedType{}, fmt.Errorf("invalid type %q: generic types are not supported", spec)
+	}
+
+	n := namedType{
+		ImportPath:  importPath,
+		PackageName: pkg.Name(),
+		TypeName:    typeName,
+		Pointer:     pointer,
+	}
+	n.ZeroValue = zeroValueOf(n.qualifiedName(), obj.Type())
+	if pointer {
+		n.ZeroValue = "nil"
+	}
+	return n, nil
+}
+
+// qualifiedName returns the name of the type, qualified by its package.
+func (n namedType) qualifiedName() string {
+	return n.PackageName + "." + n.TypeName
+}
+
+// elementType returns the element type, as a Go type expression.
+func (n namedType) elementType() string {
+	if n.Pointer {
+		return "*" + n.qualifiedName()
+	}
+	return n.qualifiedName()
+}
+
+// zeroValueOf returns a Go expression for the zero value of t, which is named
+// name.
+func zeroValueOf(name string, t types.Type) string {
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsBoolean != 0:
+			return "false"
+		case u.Info()&types.IsString != 0:
+			return `""`
+		case u.Info()&types.IsNumeric != 0:
+			return "0"
+		}
+		return "nil"
+	case *types.Struct, *types.Array:
+		return name + "{}"
+	default:
+		return "nil"
+	}
+}
+
+// generateNamedTypeNames returns the names of the packages generated for n,
+// in each dimension from one to depth. No samples are available for a named
+// type, so the generated specifications are skipped.
+func generateNamedTypeNames(n namedType, depth int) []typeNames {
+	packageName := strings.ToLower(n.TypeName) + "slice"
+	result := []typeNames{}
+	for d := 1; d <= depth; d++ {
+		zeroValue := "nil"
+		if d == 1 {
+			zeroValue = n.ZeroValue
+		}
+		result = append(result, typeNames{
+			PackageName:   packageName + dimensionSuffix(d),
+			PrimitiveType: strings.Repeat("[]", d-1) + n.elementType(),
+			SliceType:     n.TypeName + "Slice" + dimensionSuffix(d),
+			ZeroValue:     zeroValue,
+			ImportPath:    n.ImportPath,
+		})
+	}
+	return linkDimensions(result)
+}
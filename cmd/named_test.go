@@ -0,0 +1,68 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const billingPath = "github.com/ideoterra/transforms/cmd/testdata/billing"
+
+func TestLoadNamedType(t *testing.T) {
+	n, err := loadNamedType(billingPath+".Invoice", true, newImporter())
+	assert.NoError(t, err)
+	assert.Equal(t, namedType{
+		ImportPath:  billingPath,
+		PackageName: "billing",
+		TypeName:    "Invoice",
+		Pointer:     true,
+		ZeroValue:   "nil",
+	}, n)
+	assert.Equal(t, "*billing.Invoice", n.elementType())
+
+	n, err = loadNamedType(billingPath+".Invoice", false, newImporter())
+	assert.NoError(t, err)
+	assert.Equal(t, "billing.Invoice{}", n.ZeroValue)
+
+	n, err = loadNamedType(billingPath+".Status", false, newImporter())
+	assert.NoError(t, err)
+	assert.Equal(t, "0", n.ZeroValue)
+}
+
+func TestLoadNamedTypeRejectsInvalidTypes(t *testing.T) {
+	invalid := []string{
+		"Invoice",
+		billingPath + "/.Invoice",
+		billingPath + ".unexported",
+		billingPath + ".Missing",
+		billingPath + ".Pair",
+		"github.com/ideoterra/transforms/cmd/testdata/missing.Invoice",
+	}
+	for _, spec := range invalid {
+		_, err := loadNamedType(spec, false, newImporter())
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestGenerateNamedType(t *testing.T) {
+	for _, pointer := range []bool{true, false} {
+		n, err := loadNamedType(billingPath+".Invoice", pointer, newImporter())
+		assert.NoError(t, err)
+		names := generateNamedTypeNames(n, 2)
+		assert.Equal(t, "invoiceslice", names[0].PackageName)
+		assert.Equal(t, "InvoiceSlice", names[0].SliceType)
+		assert.Equal(t, "invoiceslice2", names[1].PackageName)
+		assert.Equal(t, "InvoiceSlice2", names[1].SliceType)
+
+		for _, names := range names {
+			files := generateFiles(t, "../pkg/slices/generic", defaultConfig.Tests, names)
+			assert.Contains(t, files["types.go"], "type "+names.SliceType+" []"+names.PrimitiveType+"\n")
+			assert.Contains(t, files["closures.go"], "type ConditionFn func("+names.PrimitiveType+") bool")
+			assert.Contains(t, files["closures.go"], "type EqualityFn func(a, b "+names.PrimitiveType+") bool")
+			for _, name := range []string{"types.go", "closures.go", "functions.go", "methods.go", "functions_test.go"} {
+				assert.Contains(t, files[name], `"`+billingPath+`"`, name)
+			}
+			assert.NotContains(t, files["doc.go"], billingPath)
+		}
+	}
+}
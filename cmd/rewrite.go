@@ -0,0 +1,659 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// Identifiers in the template package that are given special meaning by the
+// generator. In addition to these, every empty interface type (spelled
+// interface{}) is replaced by the element type of the generated package.
+// The predeclared any is left alone, and may be used in the template for values
+// that must remain dynamically typed.
+const (
+	primitiveTypeName    = "PrimitiveType"    // replaced by the element type
+	sliceTypeName        = "SliceType"        // renamed to typeNames.SliceType
+	sortedSliceTypeName  = "SortedSliceType"  // renamed to Sorted followed by typeNames.SliceType
+	sliceType2Name       = "SliceType2"       // replaced by a slice of slices of the element type
+	primitiveZeroName    = "primitiveZero"    // a test variable, given the zero value of the element type
+	primitiveSamplesName = "primitiveSamples" // a test variable, given sample values of the element type
+)
+
+// templatePackage is a parsed and type-checked template package, along with
+// any packages that it imports from beneath its own directory. The
+// declarations from those packages are inlined into each generated package, so
+// that generated packages are self-contained.
+type templatePackage struct {
+	fset    *token.FileSet
+	path    string // import path of the template package
+	files   []templateFile
+	inlined map[string]bool // import paths of the inlined packages
+}
+
+// templateFile is a single file from the template package, or from one of the
+// packages that it inlines.
+type templateFile struct {
+	name    string // base name of the file
+	file    *ast.File
+	info    *types.Info
+	inlined bool // the file belongs to an inlined package
+	test    bool // the file belongs to the template's external test package
+}
+
+// generatedFile is the formatted source of a single file of a generated
+// package.
+type generatedFile struct {
+	name   string
+	source []byte
+}
+
+// loadTemplate parses and type-checks the template package located in dir.
+// Of the template's test files, only those named in tests are loaded; they
+// must belong to the template's external test package. doc.go is ignored.
+func loadTemplate(dir string, tests []string, mod module, importer types.Importer) (*templatePackage, error) {
+	rel, err := filepath.Rel(mod.Root, dir)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return nil, fmt.Errorf("%v is not within the module rooted at %v", dir, mod.Root)
+	}
+	importPath := path.Join(mod.Path, filepath.ToSlash(rel))
+
+	t := &templatePackage{
+		fset:    token.NewFileSet(),
+		path:    importPath,
+		inlined: map[string]bool{},
+	}
+	t.files, err = t.loadPackage(dir, importPath, importer, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range t.files {
+		for _, spec := range f.file.Imports {
+			p, _ := strconv.Unquote(spec.Path.Value)
+			if !strings.HasPrefix(p, importPath+"/") || t.inlined[p] {
+				continue
+			}
+			t.inlined[p] = true
+			inlinedDir := filepath.Join(dir, filepath.FromSlash(strings.TrimPrefix(p, importPath+"/")))
+			files, err := t.loadPackage(inlinedDir, p, importer, true)
+			if err != nil {
+				return nil, err
+			}
+			t.files = append(t.files, files...)
+		}
+	}
+
+	if len(tests) > 0 {
+		files, err := t.loadTests(dir, tests, importer)
+		if err != nil {
+			return nil, err
+		}
+		t.files = append(t.files, files...)
+	}
+
+	names := map[string]bool{}
+	for _, f := range t.files {
+		if names[f.name] {
+			return nil, fmt.Errorf("more than one template file is named %v", f.name)
+		}
+		names[f.name] = true
+	}
+	return t, nil
+}
+
+// uninlinedFiles names the files of inlined packages that are not copied into
+// generated packages. The field closures of closures/field.go read struct
+// fields by reflection, which a typed package has no need of.
+var uninlinedFiles = map[string]bool{"field.go": true}
+
+// loadPackage parses and type-checks the package located in dir, whose import
+// path is importPath.
+func (t *templatePackage) loadPackage(dir, importPath string, importer types.Importer, inlined bool) ([]templateFile, error) {
+	isTemplateFile := func(fi os.FileInfo) bool {
+		if inlined && uninlinedFiles[fi.Name()] {
+			return false
+		}
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != "doc.go"
+	}
+	pkgs, err := parser.ParseDir(t.fset, dir, isTemplateFile, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("%v: expected exactly one package, found %v", dir, len(pkgs))
+	}
+
+	var astFiles []*ast.File
+	for _, p := range pkgs {
+		for _, f := range p.Files {
+			astFiles = append(astFiles, f)
+		}
+	}
+	sort.Slice(astFiles, func(i, j int) bool {
+		return t.fset.File(astFiles[i].Pos()).Name() < t.fset.File(astFiles[j].Pos()).Name()
+	})
+	return t.check(importPath, astFiles, importer, templateFile{inlined: inlined})
+}
+
+// loadTests parses and type-checks the named test files from dir.
+func (t *templatePackage) loadTests(dir string, names []string, importer types.Importer) ([]templateFile, error) {
+	astFiles := []*ast.File{}
+	for _, name := range names {
+		f, err := parser.ParseFile(t.fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.HasSuffix(f.Name.Name, "_test") {
+			return nil, fmt.Errorf("%v: only external test packages may be generated", name)
+		}
+		astFiles = append(astFiles, f)
+	}
+	return t.check(t.path+"_test", astFiles, importer, templateFile{test: true})
+}
+
+// check type-checks astFiles as the package importPath, and returns a
+// templateFile for each, based upon proto.
+func (t *templatePackage) check(importPath string, astFiles []*ast.File, importer types.Importer, proto templateFile) ([]templateFile, error) {
+	info := &types.Info{
+		Defs: map[*ast.Ident]types.Object{},
+		Uses: map[*ast.Ident]types.Object{},
+	}
+	conf := types.Config{Importer: importer}
+	if _, err := conf.Check(importPath, t.fset, astFiles, info); err != nil {
+		return nil, err
+	}
+
+	files := []templateFile{}
+	for _, f := range astFiles {
+		file := proto
+		file.name = filepath.Base(t.fset.File(f.Pos()).Name())
+		file.file = f
+		file.info = info
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// generate rewrites the template for the supplied type names, and returns the
+// formatted source of each file of the resulting package. A template may only
+// be generated once, as the rewrite is performed in place.
+// The generated package is given the import path importPath.
+func (t *templatePackage) generate(names typeNames, importPath string) ([]generatedFile, error) {
+	if _, err := parser.ParseExpr(names.PrimitiveType); err != nil {
+		return nil, fmt.Errorf("invalid element type %q: %v", names.PrimitiveType, err)
+	}
+	if _, err := parser.ParseExpr(names.ZeroValue); err != nil {
+		return nil, fmt.Errorf("invalid zero value %q: %v", names.ZeroValue, err)
+	}
+	if _, err := parser.ParseExpr(names.samples()); err != nil {
+		return nil, fmt.Errorf("invalid samples %q: %v", names.Samples, err)
+	}
+
+	r := rewriter{
+		fset:         t.fset,
+		names:        names,
+		templatePath: t.path,
+		importPath:   importPath,
+		inlined:      t.inlined,
+	}
+	r.commentReplacer = r.newCommentReplacer(path.Base(t.path))
+
+	result := []generatedFile{}
+	for _, f := range t.files {
+		r.info = f.info
+		r.rewriteFile(f)
+		source, err := formatFile(t.fset, f.file)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", f.name, err)
+		}
+		result = append(result, generatedFile{name: f.name, source: source})
+	}
+	return result, nil
+}
+
+// rewriter specializes the files of a template package for a single set of
+// type names.
+type rewriter struct {
+	fset            *token.FileSet
+	names           typeNames
+	info            *types.Info
+	templatePath    string
+	importPath      string
+	inlined         map[string]bool
+	commentReplacer func(string) string
+}
+
+// rewriteFile rewrites f in place.
+func (r rewriter) rewriteFile(tf templateFile) {
+	f := tf.file
+	f.Name.Name = r.names.PackageName
+	if tf.test {
+		f.Name.Name += "_test"
+	}
+	if tf.inlined && f.Doc != nil {
+		r.removeComments(f, f.Doc.Pos(), f.Doc.End())
+		f.Doc = nil
+	}
+	// A template file may itself be generated (see deriveMethods), but its
+	// notice is replaced by that of the generated package.
+	for _, group := range f.Comments {
+		if group.Text() == strings.TrimPrefix(generatedNotice, "// ")+"\n" {
+			r.removeComments(f, group.Pos(), group.End())
+			break
+		}
+	}
+
+	astutil.Apply(f, func(c *astutil.Cursor) bool {
+		switch n := c.Node().(type) {
+		case *ast.ImportSpec:
+			if p, _ := strconv.Unquote(n.Path.Value); p == r.templatePath {
+				n.Name = nil
+				n.Path.Value = strconv.Quote(r.importPath)
+			}
+		case *ast.GenDecl:
+			return r.rewriteGenDecl(c, f, n)
+		case *ast.ValueSpec:
+			r.rewriteValueSpec(n)
+		case *ast.InterfaceType:
+			if isEmptyInterface(n) {
+				c.Replace(r.elementType(n.Pos()))
+				return false
+			}
+		case *ast.Ident:
+			obj := r.objectOf(n)
+			switch {
+			case r.isMarker(obj, primitiveTypeName):
+				c.Replace(r.elementType(n.Pos()))
+				return false
+			case r.isMarker(obj, sliceTypeName):
+				n.Name = r.names.SliceType
+			case r.isMarker(obj, sortedSliceTypeName):
+				n.Name = r.sortedSliceType()
+			case r.isMarker(obj, sliceType2Name):
+				c.Replace(r.sliceType2Expr(n.Pos()))
+				return false
+			case r.isTemplatePackage(obj):
+				n.Name = r.names.PackageName
+			}
+		case *ast.SelectorExpr:
+			// Markers are qualified by the template package in test files.
+			switch obj := r.objectOf(n.Sel); {
+			case r.isMarker(obj, primitiveTypeName):
+				c.Replace(r.elementType(n.Pos()))
+				return false
+			case r.isMarker(obj, sliceType2Name):
+				c.Replace(r.sliceType2Expr(n.Pos()))
+				return false
+			}
+			if r.isInlinedSelector(n) {
+				// Declarations from inlined packages belong to the generated
+				// package, which the test package must import.
+				if tf.test {
+					n.X = &ast.Ident{NamePos: n.Pos(), Name: r.names.PackageName}
+					return false
+				}
+				c.Replace(&ast.Ident{NamePos: n.Pos(), Name: n.Sel.Name})
+				return false
+			}
+		case *ast.TypeAssertExpr:
+			// The elements of a SliceType2 are asserted to be slices of the
+			// element type. Once the element type is concrete, the elements
+			// already have that type, so the assertion is dropped.
+			if n.Type != nil && r.mentionsElementType(n.Type) {
+				c.Replace(n.X)
+			}
+		}
+		return true
+	}, nil)
+
+	for _, group := range f.Comments {
+		for _, comment := range group.List {
+			comment.Text = r.commentReplacer(comment.Text)
+		}
+	}
+
+	// The package declaring the element type is imported by every file, and
+	// removed below from those that do not refer to it.
+	if r.names.ImportPath != "" {
+		if name := r.elementTypeQualifier(); name == path.Base(r.names.ImportPath) {
+			astutil.AddImport(r.fset, f, r.names.ImportPath)
+		} else {
+			astutil.AddNamedImport(r.fset, f, name, r.names.ImportPath)
+		}
+	}
+
+	for _, spec := range append([]*ast.ImportSpec{}, f.Imports...) {
+		p, _ := strconv.Unquote(spec.Path.Value)
+		name := ""
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if r.inlined[p] || !astutil.UsesImport(f, p) {
+			astutil.DeleteNamedImport(r.fset, f, name, p)
+		}
+	}
+}
+
+// rewriteGenDecl removes the declarations of the PrimitiveType and SliceType2
+// markers, which have no counterpart in a generated package.
+func (r rewriter) rewriteGenDecl(c *astutil.Cursor, f *ast.File, n *ast.GenDecl) bool {
+	if n.Tok != token.TYPE {
+		return true
+	}
+	specs := []ast.Spec{}
+	for _, spec := range n.Specs {
+		obj := r.info.Defs[spec.(*ast.TypeSpec).Name]
+		if r.isMarker(obj, primitiveTypeName) || r.isMarker(obj, sliceType2Name) {
+			r.removeComments(f, spec.Pos(), spec.End())
+			continue
+		}
+		specs = append(specs, spec)
+	}
+	if len(specs) == 0 {
+		start := n.Pos()
+		if n.Doc != nil {
+			start = n.Doc.Pos()
+		}
+		r.removeComments(f, start, n.End())
+		c.Delete()
+		return false
+	}
+	n.Specs = specs
+	return true
+}
+
+// rewriteValueSpec gives the primitiveZero and primitiveSamples variables of
+// a test package the zero value and sample values of the element type.
+func (r rewriter) rewriteValueSpec(n *ast.ValueSpec) {
+	for i, name := range n.Names {
+		obj := r.info.Defs[name]
+		if obj == nil || obj.Parent() != obj.Pkg().Scope() || i >= len(n.Values) {
+			continue
+		}
+		switch obj.Name() {
+		case primitiveZeroName:
+			n.Values[i] = r.expr(r.names.ZeroValue, n.Values[i].Pos())
+		case primitiveSamplesName:
+			n.Values[i] = r.expr(r.names.samples(), n.Values[i].Pos())
+		}
+	}
+}
+
+// removeComments discards any comments that fall between start and end.
+func (r rewriter) removeComments(f *ast.File, start, end token.Pos) {
+	comments := []*ast.CommentGroup{}
+	for _, group := range f.Comments {
+		if group.Pos() >= start && group.End() <= end {
+			continue
+		}
+		comments = append(comments, group)
+	}
+	f.Comments = comments
+}
+
+// objectOf returns the object defined or referred to by ident, if any.
+func (r rewriter) objectOf(ident *ast.Ident) types.Object {
+	if obj := r.info.Uses[ident]; obj != nil {
+		return obj
+	}
+	return r.info.Defs[ident]
+}
+
+// isMarker reports whether obj is the named marker type declared by the
+// template package.
+func (r rewriter) isMarker(obj types.Object, name string) bool {
+	typeName, ok := obj.(*types.TypeName)
+	return ok && typeName.Name() == name && typeName.Pkg() != nil && typeName.Pkg().Path() == r.templatePath
+}
+
+// isTemplatePackage reports whether obj is an imported name referring to the
+// template package.
+func (r rewriter) isTemplatePackage(obj types.Object) bool {
+	pkgName, ok := obj.(*types.PkgName)
+	return ok && pkgName.Imported().Path() == r.templatePath
+}
+
+// isInlinedSelector reports whether n is a qualified reference to a
+// declaration from an inlined package.
+func (r rewriter) isInlinedSelector(n *ast.SelectorExpr) bool {
+	x, ok := n.X.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	pkgName, ok := r.info.Uses[x].(*types.PkgName)
+	return ok && r.inlined[pkgName.Imported().Path()]
+}
+
+// mentionsElementType reports whether the element type appears anywhere within
+// the type expression expr.
+func (r rewriter) mentionsElementType(expr ast.Expr) bool {
+	found := false
+	ast.Inspect(expr, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.InterfaceType:
+			found = found || isEmptyInterface(n)
+		case *ast.Ident:
+			obj := r.objectOf(n)
+			found = found || r.isMarker(obj, primitiveTypeName) || r.isMarker(obj, sliceTypeName) || r.isMarker(obj, sliceType2Name)
+		}
+		return !found
+	})
+	return found
+}
+
+// elementType returns a new expression for the element type, positioned at
+// pos.
+func (r rewriter) elementType(pos token.Pos) ast.Expr {
+	return r.expr(r.names.PrimitiveType, pos)
+}
+
+// expr returns a new expression parsed from source, positioned at pos. The
+// source must already be known to be valid.
+func (r rewriter) expr(source string, pos token.Pos) ast.Expr {
+	expr, _ := parser.ParseExpr(source)
+	setPos(expr, pos)
+	return expr
+}
+
+// elementTypeQualifier returns the package name by which the element type is
+// qualified, if any.
+func (r rewriter) elementTypeQualifier() string {
+	name := ""
+	ast.Inspect(r.elementType(token.NoPos), func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok && name == "" {
+			if x, ok := sel.X.(*ast.Ident); ok {
+				name = x.Name
+			}
+		}
+		return name == ""
+	})
+	return name
+}
+
+// sortedSliceType returns the name to which SortedSliceType is renamed.
+func (r rewriter) sortedSliceType() string {
+	return "Sorted" + r.names.SliceType
+}
+
+// sliceType2Expr returns a new expression for a slice of slices of the
+// element type, positioned at pos.
+func (r rewriter) sliceType2Expr(pos token.Pos) ast.Expr {
+	return &ast.ArrayType{
+		Lbrack: pos,
+		Elt:    &ast.ArrayType{Lbrack: pos, Elt: r.elementType(pos)},
+	}
+}
+
+// newCommentReplacer returns a function that rewrites references to the
+// template's marker types, and to the template and inlined packages, within
+// the text of a comment.
+func (r rewriter) newCommentReplacer(templateName string) func(string) string {
+	qualifiers := []string{regexp.QuoteMeta(templateName)}
+	for p := range r.inlined {
+		qualifiers = append(qualifiers, regexp.QuoteMeta(path.Base(p)))
+	}
+	sort.Strings(qualifiers)
+
+	type replacement struct {
+		re  *regexp.Regexp
+		new string
+	}
+	replacements := []replacement{
+		{regexp.MustCompile(`\b(?:` + strings.Join(qualifiers, "|") + `)\.([A-Za-z_])`), "$1"},
+		{regexp.MustCompile(`\binterface\{\}`), r.names.PrimitiveType},
+		{regexp.MustCompile(`\b` + sliceType2Name + `\b`), "[][]" + r.names.PrimitiveType},
+		{regexp.MustCompile(`\b` + sliceTypeName + `\b`), r.names.SliceType},
+		{regexp.MustCompile(`\b` + sortedSliceTypeName + `\b`), r.sortedSliceType()},
+		{regexp.MustCompile(`\b` + primitiveTypeName + `\b`), r.names.PrimitiveType},
+	}
+	return func(text string) string {
+		for _, rep := range replacements {
+			text = rep.re.ReplaceAllString(text, rep.new)
+		}
+		return text
+	}
+}
+
+// isEmptyInterface reports whether n is the type interface{}.
+func isEmptyInterface(n *ast.InterfaceType) bool {
+	return n.Methods == nil || len(n.Methods.List) == 0
+}
+
+// setPos positions every node within expr at pos. Expressions that are
+// spliced into a file must be positioned within that file in order for the
+// printer to place the file's comments correctly.
+func setPos(expr ast.Expr, pos token.Pos) {
+	ast.Inspect(expr, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Ident:
+			n.NamePos = pos
+		case *ast.ArrayType:
+			n.Lbrack = pos
+		case *ast.StarExpr:
+			n.Star = pos
+		case *ast.MapType:
+			n.Map = pos
+		case *ast.ChanType:
+			n.Begin, n.Arrow = pos, token.NoPos
+		case *ast.FuncType:
+			n.Func = pos
+		case *ast.FieldList:
+			n.Opening, n.Closing = pos, pos
+		case *ast.InterfaceType:
+			n.Interface = pos
+		case *ast.StructType:
+			n.Struct = pos
+		case *ast.ParenExpr:
+			n.Lparen, n.Rparen = pos, pos
+		case *ast.IndexExpr:
+			n.Lbrack, n.Rbrack = pos, pos
+		case *ast.IndexListExpr:
+			n.Lbrack, n.Rbrack = pos, pos
+		case *ast.BasicLit:
+			n.ValuePos = pos
+		case *ast.CompositeLit:
+			n.Lbrace, n.Rbrace = pos, pos
+		case *ast.UnaryExpr:
+			n.OpPos = pos
+		case *ast.BinaryExpr:
+			n.OpPos = pos
+		case *ast.CallExpr:
+			n.Lparen, n.Rparen = pos, pos
+		case *ast.Ellipsis:
+			n.Ellipsis = pos
+		}
+		return true
+	})
+}
+
+// formatFile prints f in gofmt style, preceded by a notice that the file is
+// generated.
+func formatFile(fset *token.FileSet, f *ast.File) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(generatedNotice + "\n\n")
+	if err := format.Node(&buf, fset, f); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+// generatedNotice marks generated files as such, per
+// https://golang.org/s/generatedcode.
+const generatedNotice = "// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT."
+
+// docFile returns the source of a doc.go file for the generated package,
+// which refers to the package of the next dimension up, if there is one.
+func docFile(names typeNames) generatedFile {
+	next := fmt.Sprintf("\n//\n// Slices of the next dimension up, such as those returned by Group, are of type\n// [][]%v, which converts to %v.", names.PrimitiveType, names.Next)
+	if names.Next == "" {
+		next = ""
+	}
+	source := fmt.Sprintf("%v\n\n// Package %v provides transforms for slices of %v.%v\npackage %v\n",
+		generatedNotice, names.PackageName, names.PrimitiveType, next, names.PackageName)
+	return generatedFile{name: "doc.go", source: []byte(source)}
+}
+
+// typeCheck verifies that the generated files form a package, and a test
+// package, that compile. The package is checked as importPath, so that the test
+// package may import it.
+func typeCheck(files []generatedFile, importPath string, importer types.Importer) error {
+	fset := token.NewFileSet()
+	var pkgFiles, testFiles []*ast.File
+	for _, f := range files {
+		astFile, err := parser.ParseFile(fset, f.name, f.source, 0)
+		if err != nil {
+			return err
+		}
+		if strings.HasSuffix(f.name, "_test.go") {
+			testFiles = append(testFiles, astFile)
+		} else {
+			pkgFiles = append(pkgFiles, astFile)
+		}
+	}
+
+	var errs []string
+	conf := types.Config{
+		Importer: importer,
+		Error: func(err error) {
+			errs = append(errs, err.Error())
+		},
+	}
+	pkg, _ := conf.Check(importPath, fset, pkgFiles, nil)
+	if len(errs) == 0 && len(testFiles) > 0 {
+		conf.Importer = overlayImporter{importer, map[string]*types.Package{importPath: pkg}}
+		conf.Check(importPath+"_test", fset, testFiles, nil)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("generated package does not compile:\n\t%v", strings.Join(errs, "\n\t"))
+	}
+	return nil
+}
+
+// overlayImporter imports packages from pkgs in preference to those available
+// from the underlying importer.
+type overlayImporter struct {
+	types.Importer
+	pkgs map[string]*types.Package
+}
+
+// Import implements types.Importer.
+func (o overlayImporter) Import(path string) (*types.Package, error) {
+	if pkg, ok := o.pkgs[path]; ok {
+		return pkg, nil
+	}
+	return o.Importer.Import(path)
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// generateFiles generates the package described by t from the template in
+// dir, and returns its files keyed by name.
+func generateFiles(t *testing.T, dir string, tests []string, names typeNames) map[string]string {
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+	mod, err := findModule(wd)
+	assert.NoError(t, err)
+
+	g := generator{
+		mod:        mod,
+		importer:   newImporter(),
+		sourcePath: filepath.Join(wd, dir),
+		outputPath: filepath.Join(wd, "testdata"),
+		tests:      tests,
+	}
+	files, err := g.generate(names)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	result := map[string]string{}
+	for _, f := range files {
+		result[f.name] = string(f.source)
+	}
+	return result
+}
+
+func TestGenerateRewritesMarkers(t *testing.T) {
+	files := generateFiles(t, "testdata/template", nil, typeNames{
+		PackageName:   "stringslice",
+		PrimitiveType: "string",
+		SliceType:     "StringSlice",
+		ZeroValue:     `""`,
+	})
+
+	types := files["types.go"]
+	assert.True(t, strings.HasPrefix(types, generatedNotice))
+	assert.Contains(t, types, "package stringslice\n")
+	assert.Contains(t, types, "// StringSlice is a slice of string.\ntype StringSlice []string\n")
+	assert.Contains(t, types, "// SortedStringSlice is a StringSlice kept in order.\ntype SortedStringSlice struct {\n\taa StringSlice\n}\n")
+	assert.NotContains(t, types, "PrimitiveType")
+	assert.NotContains(t, types, "SliceType2")
+	assert.Contains(t, types, "// SliceTypeCount is not a marker, and neither are SliceTypes or\n// genericSliceType")
+	assert.Contains(t, types, "const SliceTypeCount = 2")
+
+	functions := files["functions.go"]
+	assert.Contains(t, functions, "// Pair returns a [][]string containing aa twice.\nfunc Pair(aa []string) [][]string {\n\treturn [][]string{aa, aa}\n}")
+	assert.Contains(t, functions, "func First(aa [][]string) []string {\n\treturn aa[0]\n}")
+	assert.Contains(t, functions, "func Describe(a any) string {\n\tif s, ok := a.(fmt.Stringer); ok {")
+	assert.NotContains(t, functions, "interface{}")
+
+	assert.Contains(t, files["doc.go"], "// Package stringslice provides transforms for slices of string.\npackage stringslice\n")
+}
+
+func TestGenerateInlinesClosures(t *testing.T) {
+	for _, names := range generateTypeNames(primitiveType{TypeName: "int"}, 3) {
+		files := generateFiles(t, "../pkg/slices/generic", nil, names)
+		assert.Contains(t, files, "closures.go")
+		assert.NotContains(t, files["closures.go"], "Package closures")
+		assert.Contains(t, files["closures.go"], "type ConditionFn func("+names.PrimitiveType+") bool")
+		assert.NotContains(t, files, "field.go", "the field closures are not inlined")
+		for name, source := range files {
+			assert.NotContains(t, source, "closures.", name)
+			assert.NotContains(t, source, "interface{}", name)
+			assert.Contains(t, source, "package "+names.PackageName+"\n", name)
+		}
+	}
+}
+
+func TestGenerateRejectsInvalidElementTypes(t *testing.T) {
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+	mod, err := findModule(wd)
+	assert.NoError(t, err)
+	g := generator{
+		mod:        mod,
+		importer:   newImporter(),
+		sourcePath: filepath.Join(wd, "testdata/template"),
+		outputPath: filepath.Join(wd, "testdata"),
+	}
+
+	invalid := []typeNames{
+		{PackageName: "bogus", PrimitiveType: "[]]", SliceType: "BogusSlice", ZeroValue: "nil"},
+		{PackageName: "bogus", PrimitiveType: "undefinedType", SliceType: "BogusSlice", ZeroValue: "nil"},
+		{PackageName: "bogus", PrimitiveType: "int", SliceType: "BogusSlice", ZeroValue: "0)"},
+	}
+	for _, names := range invalid {
+		_, err = g.generate(names)
+		assert.Error(t, err, names.PrimitiveType)
+	}
+}
+
+func TestGenerateTests(t *testing.T) {
+	for _, p := range primitiveTypesFor([]string{"string", "bool"}) {
+		names := generateTypeNames(p, 2)[0]
+		files := generateFiles(t, "../pkg/slices/generic", defaultConfig.Tests, names)
+		for _, name := range defaultConfig.Tests {
+			assert.Contains(t, files[name], "package "+names.PackageName+"_test\n")
+		}
+		assert.Contains(t, files["methods_test.go"], `"github.com/ideoterra/transforms/cmd/testdata/`+names.PackageName+`"`)
+		assert.Contains(t, files["methods_test.go"], names.PackageName+".ConditionFn")
+		assert.Contains(t, files["const_test.go"], "var primitiveZero "+names.PrimitiveType+" = "+names.ZeroValue+"\n")
+		assert.Contains(t, files["const_test.go"], "var primitiveSamples = "+names.Samples+"\n")
+		assert.Contains(t, files["spec_test.go"], "func assertGroupsEqual(t *testing.T, xx, yy [][]"+names.PrimitiveType+") bool {")
+		assert.Contains(t, files["functions_test.go"], "func sampleEqual(a, b "+names.PrimitiveType+") bool {")
+	}
+}
+
+func TestGenerateTypeNamesInEachDimension(t *testing.T) {
+	p := primitiveTypesFor([]string{"int"})[0]
+	names := generateTypeNames(p, 4)
+	if !assert.Len(t, names, 4) {
+		return
+	}
+	assert.Equal(t, typeNames{
+		PackageName:   "intslice3",
+		PrimitiveType: "[][]int",
+		SliceType:     "IntSlice3",
+		ZeroValue:     "nil",
+		Samples:       "[][][]int{{{1}}, {{2}}, {{3}}, {{4}}, {{5}}}",
+		Next:          "intslice4.IntSlice4",
+	}, names[2])
+	assert.Equal(t, "intslice2.IntSlice2", names[0].Next)
+	assert.Equal(t, "", names[3].Next, "nothing is generated beyond the configured depth")
+
+	files := generateFiles(t, "../pkg/slices/generic", defaultConfig.Tests, names[2])
+	assert.Contains(t, files["types.go"], "type IntSlice3 [][][]int\n")
+	assert.Contains(t, files["functions.go"], "func Flatten(aa [][][][]int) [][][]int {")
+	assert.Contains(t, files["functions.go"], "func Group(aa [][][]int, grouper func([][]int) string) [][][][]int {")
+	assert.Contains(t, files["doc.go"], "// [][][][]int, which converts to intslice4.IntSlice4.\n")
+
+	files = generateFiles(t, "../pkg/slices/generic", nil, names[3])
+	assert.Contains(t, files["functions.go"], "func Group(aa [][][][]int, grouper func([][][]int) string) [][][][][]int {")
+	assert.NotContains(t, files["doc.go"], "converts to")
+}
+
+func TestSamplesLiteral(t *testing.T) {
+	assert.Equal(t, "", samplesLiteral("int", nil, 0))
+	assert.Equal(t, "[]int{1, 2, 3, 4, 5}", samplesLiteral("int", numericSamples, 0))
+	assert.Equal(t, `[][]string{{"a"}, {"b"}}`, samplesLiteral("string", []interface{}{"a", "b"}, 1))
+	assert.Equal(t, `[][][]bool{{{false}}, {{true}}}`, samplesLiteral("bool", []interface{}{false, true}, 2))
+	assert.Equal(t, "[]int{}", typeNames{PrimitiveType: "int"}.samples())
+}
+
+func TestGoLiteral(t *testing.T) {
+	assert.Equal(t, "nil", goLiteral(nil))
+	assert.Equal(t, "false", goLiteral(false))
+	assert.Equal(t, `""`, goLiteral(""))
+	assert.Equal(t, "0", goLiteral(uint8(0)))
+	assert.Equal(t, "0", goLiteral(float32(0)))
+	assert.Equal(t, "(0+0i)", goLiteral(complex128(0)))
+}
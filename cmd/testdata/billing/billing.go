@@ -0,0 +1,18 @@
+// Package billing declares types from which packages are generated by tests.
+package billing
+
+// Invoice is a struct type.
+type Invoice struct {
+	ID    string
+	Lines []string
+}
+
+// Status is a named numeric type.
+type Status int
+
+// Pair is a generic type, for which packages may not be generated.
+type Pair[T any] struct {
+	A, B T
+}
+
+type unexported struct{}
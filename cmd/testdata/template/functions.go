@@ -0,0 +1,21 @@
+package template
+
+import "fmt"
+
+// Pair returns a SliceType2 containing aa twice.
+func Pair(aa []interface{}) SliceType2 {
+	return SliceType2{aa, aa}
+}
+
+// First returns the first slice from aa.
+func First(aa SliceType2) []interface{} {
+	return aa[0].([]interface{})
+}
+
+// Describe describes a, which may hold a value of any type.
+func Describe(a any) string {
+	if s, ok := a.(fmt.Stringer); ok {
+		return s.String()
+	}
+	return fmt.Sprint(a, SliceTypeCount)
+}
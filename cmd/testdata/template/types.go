@@ -0,0 +1,19 @@
+package template
+
+// PrimitiveType is replaced by the element type.
+type PrimitiveType = interface{}
+
+// SliceType is a slice of PrimitiveType.
+type SliceType []interface{}
+
+// SortedSliceType is a SliceType kept in order.
+type SortedSliceType struct {
+	aa SliceType
+}
+
+// SliceType2 is a slice of SliceType.
+type SliceType2 = []interface{}
+
+// SliceTypeCount is not a marker, and neither are SliceTypes or
+// genericSliceType, so neither it nor this comment is rewritten.
+const SliceTypeCount = 2
@@ -1,7 +1,13 @@
 module github.com/ideoterra/transforms
 
+go 1.18
+
 require (
-	github.com/davecgh/go-spew v1.1.1 // indirect
-	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0
 	github.com/stretchr/testify v1.2.2
+	golang.org/x/text v0.3.8
+	golang.org/x/tools v0.1.12
+	gopkg.in/yaml.v2 v2.4.0
 )
+
+require github.com/davecgh/go-spew v1.1.1 // indirect
@@ -0,0 +1,292 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package boolslice
+
+// AsByteSlice applies convertFn to each element of aa, and returns the
+// results as a []byte.
+func AsByteSlice(aa []bool, convertFn func(bool) byte) []byte {
+	bb := []byte{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsComplex64Slice applies convertFn to each element of aa, and returns the
+// results as a []complex64.
+func AsComplex64Slice(aa []bool, convertFn func(bool) complex64) []complex64 {
+	bb := []complex64{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsComplex128Slice applies convertFn to each element of aa, and returns the
+// results as a []complex128.
+func AsComplex128Slice(aa []bool, convertFn func(bool) complex128) []complex128 {
+	bb := []complex128{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsFloat32Slice applies convertFn to each element of aa, and returns the
+// results as a []float32.
+func AsFloat32Slice(aa []bool, convertFn func(bool) float32) []float32 {
+	bb := []float32{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsFloat64Slice applies convertFn to each element of aa, and returns the
+// results as a []float64.
+func AsFloat64Slice(aa []bool, convertFn func(bool) float64) []float64 {
+	bb := []float64{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsIntSlice applies convertFn to each element of aa, and returns the
+// results as a []int.
+func AsIntSlice(aa []bool, convertFn func(bool) int) []int {
+	bb := []int{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsInt8Slice applies convertFn to each element of aa, and returns the
+// results as a []int8.
+func AsInt8Slice(aa []bool, convertFn func(bool) int8) []int8 {
+	bb := []int8{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsInt16Slice applies convertFn to each element of aa, and returns the
+// results as a []int16.
+func AsInt16Slice(aa []bool, convertFn func(bool) int16) []int16 {
+	bb := []int16{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsInt32Slice applies convertFn to each element of aa, and returns the
+// results as a []int32.
+func AsInt32Slice(aa []bool, convertFn func(bool) int32) []int32 {
+	bb := []int32{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsInt64Slice applies convertFn to each element of aa, and returns the
+// results as a []int64.
+func AsInt64Slice(aa []bool, convertFn func(bool) int64) []int64 {
+	bb := []int64{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsRuneSlice applies convertFn to each element of aa, and returns the
+// results as a []rune.
+func AsRuneSlice(aa []bool, convertFn func(bool) rune) []rune {
+	bb := []rune{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsStringSlice applies convertFn to each element of aa, and returns the
+// results as a []string.
+func AsStringSlice(aa []bool, convertFn func(bool) string) []string {
+	bb := []string{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsUintSlice applies convertFn to each element of aa, and returns the
+// results as a []uint.
+func AsUintSlice(aa []bool, convertFn func(bool) uint) []uint {
+	bb := []uint{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsUint8Slice applies convertFn to each element of aa, and returns the
+// results as a []uint8.
+func AsUint8Slice(aa []bool, convertFn func(bool) uint8) []uint8 {
+	bb := []uint8{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsUint16Slice applies convertFn to each element of aa, and returns the
+// results as a []uint16.
+func AsUint16Slice(aa []bool, convertFn func(bool) uint16) []uint16 {
+	bb := []uint16{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsUint32Slice applies convertFn to each element of aa, and returns the
+// results as a []uint32.
+func AsUint32Slice(aa []bool, convertFn func(bool) uint32) []uint32 {
+	bb := []uint32{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsUint64Slice applies convertFn to each element of aa, and returns the
+// results as a []uint64.
+func AsUint64Slice(aa []bool, convertFn func(bool) uint64) []uint64 {
+	bb := []uint64{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsByteSlice applies convertFn to each element of aa, and returns the
+// results as a []byte, which may be converted to a ByteSlice
+// where required.
+func (aa *BoolSlice) AsByteSlice(convertFn func(bool) byte) []byte {
+	return AsByteSlice(*aa, convertFn)
+}
+
+// AsComplex64Slice applies convertFn to each element of aa, and returns the
+// results as a []complex64, which may be converted to a Complex64Slice
+// where required.
+func (aa *BoolSlice) AsComplex64Slice(convertFn func(bool) complex64) []complex64 {
+	return AsComplex64Slice(*aa, convertFn)
+}
+
+// AsComplex128Slice applies convertFn to each element of aa, and returns the
+// results as a []complex128, which may be converted to a Complex128Slice
+// where required.
+func (aa *BoolSlice) AsComplex128Slice(convertFn func(bool) complex128) []complex128 {
+	return AsComplex128Slice(*aa, convertFn)
+}
+
+// AsFloat32Slice applies convertFn to each element of aa, and returns the
+// results as a []float32, which may be converted to a Float32Slice
+// where required.
+func (aa *BoolSlice) AsFloat32Slice(convertFn func(bool) float32) []float32 {
+	return AsFloat32Slice(*aa, convertFn)
+}
+
+// AsFloat64Slice applies convertFn to each element of aa, and returns the
+// results as a []float64, which may be converted to a Float64Slice
+// where required.
+func (aa *BoolSlice) AsFloat64Slice(convertFn func(bool) float64) []float64 {
+	return AsFloat64Slice(*aa, convertFn)
+}
+
+// AsIntSlice applies convertFn to each element of aa, and returns the
+// results as a []int, which may be converted to a IntSlice
+// where required.
+func (aa *BoolSlice) AsIntSlice(convertFn func(bool) int) []int {
+	return AsIntSlice(*aa, convertFn)
+}
+
+// AsInt8Slice applies convertFn to each element of aa, and returns the
+// results as a []int8, which may be converted to a Int8Slice
+// where required.
+func (aa *BoolSlice) AsInt8Slice(convertFn func(bool) int8) []int8 {
+	return AsInt8Slice(*aa, convertFn)
+}
+
+// AsInt16Slice applies convertFn to each element of aa, and returns the
+// results as a []int16, which may be converted to a Int16Slice
+// where required.
+func (aa *BoolSlice) AsInt16Slice(convertFn func(bool) int16) []int16 {
+	return AsInt16Slice(*aa, convertFn)
+}
+
+// AsInt32Slice applies convertFn to each element of aa, and returns the
+// results as a []int32, which may be converted to a Int32Slice
+// where required.
+func (aa *BoolSlice) AsInt32Slice(convertFn func(bool) int32) []int32 {
+	return AsInt32Slice(*aa, convertFn)
+}
+
+// AsInt64Slice applies convertFn to each element of aa, and returns the
+// results as a []int64, which may be converted to a Int64Slice
+// where required.
+func (aa *BoolSlice) AsInt64Slice(convertFn func(bool) int64) []int64 {
+	return AsInt64Slice(*aa, convertFn)
+}
+
+// AsRuneSlice applies convertFn to each element of aa, and returns the
+// results as a []rune, which may be converted to a RuneSlice
+// where required.
+func (aa *BoolSlice) AsRuneSlice(convertFn func(bool) rune) []rune {
+	return AsRuneSlice(*aa, convertFn)
+}
+
+// AsStringSlice applies convertFn to each element of aa, and returns the
+// results as a []string, which may be converted to a StringSlice
+// where required.
+func (aa *BoolSlice) AsStringSlice(convertFn func(bool) string) []string {
+	return AsStringSlice(*aa, convertFn)
+}
+
+// AsUintSlice applies convertFn to each element of aa, and returns the
+// results as a []uint, which may be converted to a UintSlice
+// where required.
+func (aa *BoolSlice) AsUintSlice(convertFn func(bool) uint) []uint {
+	return AsUintSlice(*aa, convertFn)
+}
+
+// AsUint8Slice applies convertFn to each element of aa, and returns the
+// results as a []uint8, which may be converted to a Uint8Slice
+// where required.
+func (aa *BoolSlice) AsUint8Slice(convertFn func(bool) uint8) []uint8 {
+	return AsUint8Slice(*aa, convertFn)
+}
+
+// AsUint16Slice applies convertFn to each element of aa, and returns the
+// results as a []uint16, which may be converted to a Uint16Slice
+// where required.
+func (aa *BoolSlice) AsUint16Slice(convertFn func(bool) uint16) []uint16 {
+	return AsUint16Slice(*aa, convertFn)
+}
+
+// AsUint32Slice applies convertFn to each element of aa, and returns the
+// results as a []uint32, which may be converted to a Uint32Slice
+// where required.
+func (aa *BoolSlice) AsUint32Slice(convertFn func(bool) uint32) []uint32 {
+	return AsUint32Slice(*aa, convertFn)
+}
+
+// AsUint64Slice applies convertFn to each element of aa, and returns the
+// results as a []uint64, which may be converted to a Uint64Slice
+// where required.
+func (aa *BoolSlice) AsUint64Slice(convertFn func(bool) uint64) []uint64 {
+	return AsUint64Slice(*aa, convertFn)
+}
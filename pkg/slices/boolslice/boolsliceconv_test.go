@@ -0,0 +1,265 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package boolslice_test
+
+import (
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/boolslice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsByteSlice(t *testing.T) {
+	convertFn := func(a bool) byte {
+		assert.Equal(t, false, a)
+		return byte(0)
+	}
+
+	aa := boolslice.BoolSlice{false, false}
+	bb := aa.AsByteSlice(convertFn)
+	assert.Equal(t, []byte{byte(0), byte(0)}, bb)
+	assert.Equal(t, boolslice.BoolSlice{false, false}, aa)
+
+	cc := boolslice.AsByteSlice(nil, convertFn)
+	assert.Equal(t, []byte{}, cc)
+}
+
+func TestAsComplex64Slice(t *testing.T) {
+	convertFn := func(a bool) complex64 {
+		assert.Equal(t, false, a)
+		return complex64((0 + 0i))
+	}
+
+	aa := boolslice.BoolSlice{false, false}
+	bb := aa.AsComplex64Slice(convertFn)
+	assert.Equal(t, []complex64{complex64((0 + 0i)), complex64((0 + 0i))}, bb)
+	assert.Equal(t, boolslice.BoolSlice{false, false}, aa)
+
+	cc := boolslice.AsComplex64Slice(nil, convertFn)
+	assert.Equal(t, []complex64{}, cc)
+}
+
+func TestAsComplex128Slice(t *testing.T) {
+	convertFn := func(a bool) complex128 {
+		assert.Equal(t, false, a)
+		return complex128((0 + 0i))
+	}
+
+	aa := boolslice.BoolSlice{false, false}
+	bb := aa.AsComplex128Slice(convertFn)
+	assert.Equal(t, []complex128{complex128((0 + 0i)), complex128((0 + 0i))}, bb)
+	assert.Equal(t, boolslice.BoolSlice{false, false}, aa)
+
+	cc := boolslice.AsComplex128Slice(nil, convertFn)
+	assert.Equal(t, []complex128{}, cc)
+}
+
+func TestAsFloat32Slice(t *testing.T) {
+	convertFn := func(a bool) float32 {
+		assert.Equal(t, false, a)
+		return float32(0)
+	}
+
+	aa := boolslice.BoolSlice{false, false}
+	bb := aa.AsFloat32Slice(convertFn)
+	assert.Equal(t, []float32{float32(0), float32(0)}, bb)
+	assert.Equal(t, boolslice.BoolSlice{false, false}, aa)
+
+	cc := boolslice.AsFloat32Slice(nil, convertFn)
+	assert.Equal(t, []float32{}, cc)
+}
+
+func TestAsFloat64Slice(t *testing.T) {
+	convertFn := func(a bool) float64 {
+		assert.Equal(t, false, a)
+		return float64(0)
+	}
+
+	aa := boolslice.BoolSlice{false, false}
+	bb := aa.AsFloat64Slice(convertFn)
+	assert.Equal(t, []float64{float64(0), float64(0)}, bb)
+	assert.Equal(t, boolslice.BoolSlice{false, false}, aa)
+
+	cc := boolslice.AsFloat64Slice(nil, convertFn)
+	assert.Equal(t, []float64{}, cc)
+}
+
+func TestAsIntSlice(t *testing.T) {
+	convertFn := func(a bool) int {
+		assert.Equal(t, false, a)
+		return int(0)
+	}
+
+	aa := boolslice.BoolSlice{false, false}
+	bb := aa.AsIntSlice(convertFn)
+	assert.Equal(t, []int{int(0), int(0)}, bb)
+	assert.Equal(t, boolslice.BoolSlice{false, false}, aa)
+
+	cc := boolslice.AsIntSlice(nil, convertFn)
+	assert.Equal(t, []int{}, cc)
+}
+
+func TestAsInt8Slice(t *testing.T) {
+	convertFn := func(a bool) int8 {
+		assert.Equal(t, false, a)
+		return int8(0)
+	}
+
+	aa := boolslice.BoolSlice{false, false}
+	bb := aa.AsInt8Slice(convertFn)
+	assert.Equal(t, []int8{int8(0), int8(0)}, bb)
+	assert.Equal(t, boolslice.BoolSlice{false, false}, aa)
+
+	cc := boolslice.AsInt8Slice(nil, convertFn)
+	assert.Equal(t, []int8{}, cc)
+}
+
+func TestAsInt16Slice(t *testing.T) {
+	convertFn := func(a bool) int16 {
+		assert.Equal(t, false, a)
+		return int16(0)
+	}
+
+	aa := boolslice.BoolSlice{false, false}
+	bb := aa.AsInt16Slice(convertFn)
+	assert.Equal(t, []int16{int16(0), int16(0)}, bb)
+	assert.Equal(t, boolslice.BoolSlice{false, false}, aa)
+
+	cc := boolslice.AsInt16Slice(nil, convertFn)
+	assert.Equal(t, []int16{}, cc)
+}
+
+func TestAsInt32Slice(t *testing.T) {
+	convertFn := func(a bool) int32 {
+		assert.Equal(t, false, a)
+		return int32(0)
+	}
+
+	aa := boolslice.BoolSlice{false, false}
+	bb := aa.AsInt32Slice(convertFn)
+	assert.Equal(t, []int32{int32(0), int32(0)}, bb)
+	assert.Equal(t, boolslice.BoolSlice{false, false}, aa)
+
+	cc := boolslice.AsInt32Slice(nil, convertFn)
+	assert.Equal(t, []int32{}, cc)
+}
+
+func TestAsInt64Slice(t *testing.T) {
+	convertFn := func(a bool) int64 {
+		assert.Equal(t, false, a)
+		return int64(0)
+	}
+
+	aa := boolslice.BoolSlice{false, false}
+	bb := aa.AsInt64Slice(convertFn)
+	assert.Equal(t, []int64{int64(0), int64(0)}, bb)
+	assert.Equal(t, boolslice.BoolSlice{false, false}, aa)
+
+	cc := boolslice.AsInt64Slice(nil, convertFn)
+	assert.Equal(t, []int64{}, cc)
+}
+
+func TestAsRuneSlice(t *testing.T) {
+	convertFn := func(a bool) rune {
+		assert.Equal(t, false, a)
+		return rune(0)
+	}
+
+	aa := boolslice.BoolSlice{false, false}
+	bb := aa.AsRuneSlice(convertFn)
+	assert.Equal(t, []rune{rune(0), rune(0)}, bb)
+	assert.Equal(t, boolslice.BoolSlice{false, false}, aa)
+
+	cc := boolslice.AsRuneSlice(nil, convertFn)
+	assert.Equal(t, []rune{}, cc)
+}
+
+func TestAsStringSlice(t *testing.T) {
+	convertFn := func(a bool) string {
+		assert.Equal(t, false, a)
+		return ""
+	}
+
+	aa := boolslice.BoolSlice{false, false}
+	bb := aa.AsStringSlice(convertFn)
+	assert.Equal(t, []string{"", ""}, bb)
+	assert.Equal(t, boolslice.BoolSlice{false, false}, aa)
+
+	cc := boolslice.AsStringSlice(nil, convertFn)
+	assert.Equal(t, []string{}, cc)
+}
+
+func TestAsUintSlice(t *testing.T) {
+	convertFn := func(a bool) uint {
+		assert.Equal(t, false, a)
+		return uint(0)
+	}
+
+	aa := boolslice.BoolSlice{false, false}
+	bb := aa.AsUintSlice(convertFn)
+	assert.Equal(t, []uint{uint(0), uint(0)}, bb)
+	assert.Equal(t, boolslice.BoolSlice{false, false}, aa)
+
+	cc := boolslice.AsUintSlice(nil, convertFn)
+	assert.Equal(t, []uint{}, cc)
+}
+
+func TestAsUint8Slice(t *testing.T) {
+	convertFn := func(a bool) uint8 {
+		assert.Equal(t, false, a)
+		return uint8(0)
+	}
+
+	aa := boolslice.BoolSlice{false, false}
+	bb := aa.AsUint8Slice(convertFn)
+	assert.Equal(t, []uint8{uint8(0), uint8(0)}, bb)
+	assert.Equal(t, boolslice.BoolSlice{false, false}, aa)
+
+	cc := boolslice.AsUint8Slice(nil, convertFn)
+	assert.Equal(t, []uint8{}, cc)
+}
+
+func TestAsUint16Slice(t *testing.T) {
+	convertFn := func(a bool) uint16 {
+		assert.Equal(t, false, a)
+		return uint16(0)
+	}
+
+	aa := boolslice.BoolSlice{false, false}
+	bb := aa.AsUint16Slice(convertFn)
+	assert.Equal(t, []uint16{uint16(0), uint16(0)}, bb)
+	assert.Equal(t, boolslice.BoolSlice{false, false}, aa)
+
+	cc := boolslice.AsUint16Slice(nil, convertFn)
+	assert.Equal(t, []uint16{}, cc)
+}
+
+func TestAsUint32Slice(t *testing.T) {
+	convertFn := func(a bool) uint32 {
+		assert.Equal(t, false, a)
+		return uint32(0)
+	}
+
+	aa := boolslice.BoolSlice{false, false}
+	bb := aa.AsUint32Slice(convertFn)
+	assert.Equal(t, []uint32{uint32(0), uint32(0)}, bb)
+	assert.Equal(t, boolslice.BoolSlice{false, false}, aa)
+
+	cc := boolslice.AsUint32Slice(nil, convertFn)
+	assert.Equal(t, []uint32{}, cc)
+}
+
+func TestAsUint64Slice(t *testing.T) {
+	convertFn := func(a bool) uint64 {
+		assert.Equal(t, false, a)
+		return uint64(0)
+	}
+
+	aa := boolslice.BoolSlice{false, false}
+	bb := aa.AsUint64Slice(convertFn)
+	assert.Equal(t, []uint64{uint64(0), uint64(0)}, bb)
+	assert.Equal(t, boolslice.BoolSlice{false, false}, aa)
+
+	cc := boolslice.AsUint64Slice(nil, convertFn)
+	assert.Equal(t, []uint64{}, cc)
+}
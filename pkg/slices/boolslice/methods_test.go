@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package boolslice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/boolslice"
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(boolslice.BoolSlice){
+		func(aa boolslice.BoolSlice) { aa.Clear() },
+		func(aa boolslice.BoolSlice) { aa.Clone() },
+		func(aa boolslice.BoolSlice) { aa.Dequeue() },
+		func(aa boolslice.BoolSlice) { aa.Empty() },
+		func(aa boolslice.BoolSlice) { aa.End() },
+		func(aa boolslice.BoolSlice) { aa.Head() },
+		func(aa boolslice.BoolSlice) { aa.Len() },
+		func(aa boolslice.BoolSlice) { aa.Permutable() },
+		func(aa boolslice.BoolSlice) { aa.Permutations() },
+		func(aa boolslice.BoolSlice) { aa.Permute() },
+		func(aa boolslice.BoolSlice) { aa.Pop() },
+		func(aa boolslice.BoolSlice) { aa.Reverse() },
+		func(aa boolslice.BoolSlice) { _ = aa.String() },
+		func(aa boolslice.BoolSlice) { aa.Tail() },
+		func(aa boolslice.BoolSlice) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(boolslice.BoolSlice{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(boolslice.BoolSlice){
+		func(aa boolslice.BoolSlice) { aa.Item(0) },
+		func(aa boolslice.BoolSlice) { aa.ItemFuzzy(0) },
+		func(aa boolslice.BoolSlice) { aa.RemoveAt(0) },
+		func(aa boolslice.BoolSlice) { aa.Skip(0) },
+		func(aa boolslice.BoolSlice) { aa.SplitAt(0) },
+		func(aa boolslice.BoolSlice) { aa.Take(0) },
+		func(aa boolslice.BoolSlice) { aa.Union(nil) },
+		func(aa boolslice.BoolSlice) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(boolslice.BoolSlice{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(boolslice.BoolSlice, bool){
+		func(aa boolslice.BoolSlice, b bool) { aa.Append(b) },
+		func(aa boolslice.BoolSlice, b bool) { aa.Enqueue(b) },
+		func(aa boolslice.BoolSlice, b bool) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(boolslice.BoolSlice{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(boolslice.BoolSlice, boolslice.ConditionFn){
+		func(aa boolslice.BoolSlice, condition boolslice.ConditionFn) { aa.All(condition) },
+		func(aa boolslice.BoolSlice, condition boolslice.ConditionFn) { aa.Any(condition) },
+		func(aa boolslice.BoolSlice, condition boolslice.ConditionFn) { aa.Count(condition) },
+		func(aa boolslice.BoolSlice, condition boolslice.ConditionFn) { aa.Filter(condition) },
+		func(aa boolslice.BoolSlice, condition boolslice.ConditionFn) { aa.FindIndex(condition) },
+		func(aa boolslice.BoolSlice, condition boolslice.ConditionFn) { aa.First(condition) },
+		func(aa boolslice.BoolSlice, condition boolslice.ConditionFn) { aa.Last(condition) },
+		func(aa boolslice.BoolSlice, condition boolslice.ConditionFn) { aa.None(condition) },
+		func(aa boolslice.BoolSlice, condition boolslice.ConditionFn) { aa.Partition(condition) },
+		func(aa boolslice.BoolSlice, condition boolslice.ConditionFn) { aa.Remove(condition) },
+		func(aa boolslice.BoolSlice, condition boolslice.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa boolslice.BoolSlice, condition boolslice.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa boolslice.BoolSlice, condition boolslice.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa boolslice.BoolSlice, condition boolslice.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ bool) bool {
+				return true
+			}
+			methodCall(boolslice.BoolSlice{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(boolslice.BoolSlice){
+		func(aa boolslice.BoolSlice) {
+			aa.Distinct(func(a, b bool) bool { return true })
+		},
+		func(aa boolslice.BoolSlice) {
+			aa.Expand(func(bool) []bool { return nil })
+		},
+		func(aa boolslice.BoolSlice) {
+			aa.ForEach(func(bool) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa boolslice.BoolSlice) {
+			aa.ForEachR(func(bool) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa boolslice.BoolSlice) {
+			aa.Group(func(bool) string { return "0" })
+		},
+		func(aa boolslice.BoolSlice) {
+			aa.GroupI(func(int64, bool) string { return "0" })
+		},
+		func(aa boolslice.BoolSlice) {
+			aa.GroupByTrait(func(a, b bool) bool { return true }, func(a, b bool) bool { return true })
+		},
+		func(aa boolslice.BoolSlice) {
+			aa.Map(func(bool) bool { return primitiveZero })
+		},
+		func(aa boolslice.BoolSlice) {
+			aa.Reduce(func(a, b bool) bool { return primitiveZero })
+		},
+		func(aa boolslice.BoolSlice) {
+			aa.Sort(func(a, b bool) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(boolslice.BoolSlice{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(boolslice.BoolSlice, boolslice.EqualityFn){
+		func(aa boolslice.BoolSlice, equality boolslice.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa boolslice.BoolSlice, equality boolslice.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa boolslice.BoolSlice, equality boolslice.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa boolslice.BoolSlice, equality boolslice.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa boolslice.BoolSlice, equality boolslice.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa boolslice.BoolSlice, equality boolslice.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b bool) bool {
+				return false
+			}
+			methodCall(boolslice.BoolSlice{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(boolslice.BoolSlice, bool, boolslice.ConditionFn){
+		func(aa boolslice.BoolSlice, b bool, condition boolslice.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa boolslice.BoolSlice, b bool, condition boolslice.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(bool) bool {
+				return false
+			}
+			methodCall(boolslice.BoolSlice{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(boolslice.BoolSlice){
+		func(aa boolslice.BoolSlice) {
+			aa.ForEachC(0, func(bool, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa boolslice.BoolSlice) {
+			aa.WindowCentered(0, func([]bool) bool { return primitiveZero })
+		},
+		func(aa boolslice.BoolSlice) {
+			aa.WindowLeft(0, func([]bool) bool { return primitiveZero })
+		},
+		func(aa boolslice.BoolSlice) {
+			aa.WindowRight(0, func([]bool) bool { return primitiveZero })
+		},
+		func(aa boolslice.BoolSlice) {
+			aa.Fold(primitiveZero, func(a, b bool) bool { return primitiveZero })
+		},
+		func(aa boolslice.BoolSlice) {
+			aa.FoldI(primitiveZero, func(i int64, a, b bool) bool { return primitiveZero })
+		},
+		func(aa boolslice.BoolSlice) {
+			aa.Pairwise(primitiveZero, func(a, b bool) bool { return primitiveZero })
+		},
+		func(aa boolslice.BoolSlice) {
+			aa.Collect([]bool{}, func(a, b bool) bool { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(boolslice.BoolSlice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(boolslice.BoolSlice){
+		func(aa boolslice.BoolSlice) { aa.InsertAt(primitiveZero, 0) },
+		func(aa boolslice.BoolSlice) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(boolslice.BoolSlice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
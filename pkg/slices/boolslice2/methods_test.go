@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package boolslice2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/boolslice2"
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(boolslice2.BoolSlice2){
+		func(aa boolslice2.BoolSlice2) { aa.Clear() },
+		func(aa boolslice2.BoolSlice2) { aa.Clone() },
+		func(aa boolslice2.BoolSlice2) { aa.Dequeue() },
+		func(aa boolslice2.BoolSlice2) { aa.Empty() },
+		func(aa boolslice2.BoolSlice2) { aa.End() },
+		func(aa boolslice2.BoolSlice2) { aa.Head() },
+		func(aa boolslice2.BoolSlice2) { aa.Len() },
+		func(aa boolslice2.BoolSlice2) { aa.Permutable() },
+		func(aa boolslice2.BoolSlice2) { aa.Permutations() },
+		func(aa boolslice2.BoolSlice2) { aa.Permute() },
+		func(aa boolslice2.BoolSlice2) { aa.Pop() },
+		func(aa boolslice2.BoolSlice2) { aa.Reverse() },
+		func(aa boolslice2.BoolSlice2) { _ = aa.String() },
+		func(aa boolslice2.BoolSlice2) { aa.Tail() },
+		func(aa boolslice2.BoolSlice2) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(boolslice2.BoolSlice2{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(boolslice2.BoolSlice2){
+		func(aa boolslice2.BoolSlice2) { aa.Item(0) },
+		func(aa boolslice2.BoolSlice2) { aa.ItemFuzzy(0) },
+		func(aa boolslice2.BoolSlice2) { aa.RemoveAt(0) },
+		func(aa boolslice2.BoolSlice2) { aa.Skip(0) },
+		func(aa boolslice2.BoolSlice2) { aa.SplitAt(0) },
+		func(aa boolslice2.BoolSlice2) { aa.Take(0) },
+		func(aa boolslice2.BoolSlice2) { aa.Union(nil) },
+		func(aa boolslice2.BoolSlice2) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(boolslice2.BoolSlice2{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(boolslice2.BoolSlice2, []bool){
+		func(aa boolslice2.BoolSlice2, b []bool) { aa.Append(b) },
+		func(aa boolslice2.BoolSlice2, b []bool) { aa.Enqueue(b) },
+		func(aa boolslice2.BoolSlice2, b []bool) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(boolslice2.BoolSlice2{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(boolslice2.BoolSlice2, boolslice2.ConditionFn){
+		func(aa boolslice2.BoolSlice2, condition boolslice2.ConditionFn) { aa.All(condition) },
+		func(aa boolslice2.BoolSlice2, condition boolslice2.ConditionFn) { aa.Any(condition) },
+		func(aa boolslice2.BoolSlice2, condition boolslice2.ConditionFn) { aa.Count(condition) },
+		func(aa boolslice2.BoolSlice2, condition boolslice2.ConditionFn) { aa.Filter(condition) },
+		func(aa boolslice2.BoolSlice2, condition boolslice2.ConditionFn) { aa.FindIndex(condition) },
+		func(aa boolslice2.BoolSlice2, condition boolslice2.ConditionFn) { aa.First(condition) },
+		func(aa boolslice2.BoolSlice2, condition boolslice2.ConditionFn) { aa.Last(condition) },
+		func(aa boolslice2.BoolSlice2, condition boolslice2.ConditionFn) { aa.None(condition) },
+		func(aa boolslice2.BoolSlice2, condition boolslice2.ConditionFn) { aa.Partition(condition) },
+		func(aa boolslice2.BoolSlice2, condition boolslice2.ConditionFn) { aa.Remove(condition) },
+		func(aa boolslice2.BoolSlice2, condition boolslice2.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa boolslice2.BoolSlice2, condition boolslice2.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa boolslice2.BoolSlice2, condition boolslice2.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa boolslice2.BoolSlice2, condition boolslice2.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ []bool) bool {
+				return true
+			}
+			methodCall(boolslice2.BoolSlice2{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(boolslice2.BoolSlice2){
+		func(aa boolslice2.BoolSlice2) {
+			aa.Distinct(func(a, b []bool) bool { return true })
+		},
+		func(aa boolslice2.BoolSlice2) {
+			aa.Expand(func([]bool) [][]bool { return nil })
+		},
+		func(aa boolslice2.BoolSlice2) {
+			aa.ForEach(func([]bool) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa boolslice2.BoolSlice2) {
+			aa.ForEachR(func([]bool) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa boolslice2.BoolSlice2) {
+			aa.Group(func([]bool) string { return "0" })
+		},
+		func(aa boolslice2.BoolSlice2) {
+			aa.GroupI(func(int64, []bool) string { return "0" })
+		},
+		func(aa boolslice2.BoolSlice2) {
+			aa.GroupByTrait(func(a, b []bool) bool { return true }, func(a, b []bool) bool { return true })
+		},
+		func(aa boolslice2.BoolSlice2) {
+			aa.Map(func([]bool) []bool { return primitiveZero })
+		},
+		func(aa boolslice2.BoolSlice2) {
+			aa.Reduce(func(a, b []bool) []bool { return primitiveZero })
+		},
+		func(aa boolslice2.BoolSlice2) {
+			aa.Sort(func(a, b []bool) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(boolslice2.BoolSlice2{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(boolslice2.BoolSlice2, boolslice2.EqualityFn){
+		func(aa boolslice2.BoolSlice2, equality boolslice2.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa boolslice2.BoolSlice2, equality boolslice2.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa boolslice2.BoolSlice2, equality boolslice2.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa boolslice2.BoolSlice2, equality boolslice2.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa boolslice2.BoolSlice2, equality boolslice2.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa boolslice2.BoolSlice2, equality boolslice2.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b []bool) bool {
+				return false
+			}
+			methodCall(boolslice2.BoolSlice2{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(boolslice2.BoolSlice2, []bool, boolslice2.ConditionFn){
+		func(aa boolslice2.BoolSlice2, b []bool, condition boolslice2.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa boolslice2.BoolSlice2, b []bool, condition boolslice2.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func([]bool) bool {
+				return false
+			}
+			methodCall(boolslice2.BoolSlice2{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(boolslice2.BoolSlice2){
+		func(aa boolslice2.BoolSlice2) {
+			aa.ForEachC(0, func([]bool, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa boolslice2.BoolSlice2) {
+			aa.WindowCentered(0, func([][]bool) []bool { return primitiveZero })
+		},
+		func(aa boolslice2.BoolSlice2) {
+			aa.WindowLeft(0, func([][]bool) []bool { return primitiveZero })
+		},
+		func(aa boolslice2.BoolSlice2) {
+			aa.WindowRight(0, func([][]bool) []bool { return primitiveZero })
+		},
+		func(aa boolslice2.BoolSlice2) {
+			aa.Fold(primitiveZero, func(a, b []bool) []bool { return primitiveZero })
+		},
+		func(aa boolslice2.BoolSlice2) {
+			aa.FoldI(primitiveZero, func(i int64, a, b []bool) []bool { return primitiveZero })
+		},
+		func(aa boolslice2.BoolSlice2) {
+			aa.Pairwise(primitiveZero, func(a, b []bool) []bool { return primitiveZero })
+		},
+		func(aa boolslice2.BoolSlice2) {
+			aa.Collect([][]bool{}, func(a, b []bool) []bool { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(boolslice2.BoolSlice2{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(boolslice2.BoolSlice2){
+		func(aa boolslice2.BoolSlice2) { aa.InsertAt(primitiveZero, 0) },
+		func(aa boolslice2.BoolSlice2) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(boolslice2.BoolSlice2{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
@@ -0,0 +1,265 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package byteslice_test
+
+import (
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/byteslice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsBoolSlice(t *testing.T) {
+	convertFn := func(a byte) bool {
+		assert.Equal(t, byte(0), a)
+		return false
+	}
+
+	aa := byteslice.ByteSlice{byte(0), byte(0)}
+	bb := aa.AsBoolSlice(convertFn)
+	assert.Equal(t, []bool{false, false}, bb)
+	assert.Equal(t, byteslice.ByteSlice{byte(0), byte(0)}, aa)
+
+	cc := byteslice.AsBoolSlice(nil, convertFn)
+	assert.Equal(t, []bool{}, cc)
+}
+
+func TestAsComplex64Slice(t *testing.T) {
+	convertFn := func(a byte) complex64 {
+		assert.Equal(t, byte(0), a)
+		return complex64((0 + 0i))
+	}
+
+	aa := byteslice.ByteSlice{byte(0), byte(0)}
+	bb := aa.AsComplex64Slice(convertFn)
+	assert.Equal(t, []complex64{complex64((0 + 0i)), complex64((0 + 0i))}, bb)
+	assert.Equal(t, byteslice.ByteSlice{byte(0), byte(0)}, aa)
+
+	cc := byteslice.AsComplex64Slice(nil, convertFn)
+	assert.Equal(t, []complex64{}, cc)
+}
+
+func TestAsComplex128Slice(t *testing.T) {
+	convertFn := func(a byte) complex128 {
+		assert.Equal(t, byte(0), a)
+		return complex128((0 + 0i))
+	}
+
+	aa := byteslice.ByteSlice{byte(0), byte(0)}
+	bb := aa.AsComplex128Slice(convertFn)
+	assert.Equal(t, []complex128{complex128((0 + 0i)), complex128((0 + 0i))}, bb)
+	assert.Equal(t, byteslice.ByteSlice{byte(0), byte(0)}, aa)
+
+	cc := byteslice.AsComplex128Slice(nil, convertFn)
+	assert.Equal(t, []complex128{}, cc)
+}
+
+func TestAsFloat32Slice(t *testing.T) {
+	convertFn := func(a byte) float32 {
+		assert.Equal(t, byte(0), a)
+		return float32(0)
+	}
+
+	aa := byteslice.ByteSlice{byte(0), byte(0)}
+	bb := aa.AsFloat32Slice(convertFn)
+	assert.Equal(t, []float32{float32(0), float32(0)}, bb)
+	assert.Equal(t, byteslice.ByteSlice{byte(0), byte(0)}, aa)
+
+	cc := byteslice.AsFloat32Slice(nil, convertFn)
+	assert.Equal(t, []float32{}, cc)
+}
+
+func TestAsFloat64Slice(t *testing.T) {
+	convertFn := func(a byte) float64 {
+		assert.Equal(t, byte(0), a)
+		return float64(0)
+	}
+
+	aa := byteslice.ByteSlice{byte(0), byte(0)}
+	bb := aa.AsFloat64Slice(convertFn)
+	assert.Equal(t, []float64{float64(0), float64(0)}, bb)
+	assert.Equal(t, byteslice.ByteSlice{byte(0), byte(0)}, aa)
+
+	cc := byteslice.AsFloat64Slice(nil, convertFn)
+	assert.Equal(t, []float64{}, cc)
+}
+
+func TestAsIntSlice(t *testing.T) {
+	convertFn := func(a byte) int {
+		assert.Equal(t, byte(0), a)
+		return int(0)
+	}
+
+	aa := byteslice.ByteSlice{byte(0), byte(0)}
+	bb := aa.AsIntSlice(convertFn)
+	assert.Equal(t, []int{int(0), int(0)}, bb)
+	assert.Equal(t, byteslice.ByteSlice{byte(0), byte(0)}, aa)
+
+	cc := byteslice.AsIntSlice(nil, convertFn)
+	assert.Equal(t, []int{}, cc)
+}
+
+func TestAsInt8Slice(t *testing.T) {
+	convertFn := func(a byte) int8 {
+		assert.Equal(t, byte(0), a)
+		return int8(0)
+	}
+
+	aa := byteslice.ByteSlice{byte(0), byte(0)}
+	bb := aa.AsInt8Slice(convertFn)
+	assert.Equal(t, []int8{int8(0), int8(0)}, bb)
+	assert.Equal(t, byteslice.ByteSlice{byte(0), byte(0)}, aa)
+
+	cc := byteslice.AsInt8Slice(nil, convertFn)
+	assert.Equal(t, []int8{}, cc)
+}
+
+func TestAsInt16Slice(t *testing.T) {
+	convertFn := func(a byte) int16 {
+		assert.Equal(t, byte(0), a)
+		return int16(0)
+	}
+
+	aa := byteslice.ByteSlice{byte(0), byte(0)}
+	bb := aa.AsInt16Slice(convertFn)
+	assert.Equal(t, []int16{int16(0), int16(0)}, bb)
+	assert.Equal(t, byteslice.ByteSlice{byte(0), byte(0)}, aa)
+
+	cc := byteslice.AsInt16Slice(nil, convertFn)
+	assert.Equal(t, []int16{}, cc)
+}
+
+func TestAsInt32Slice(t *testing.T) {
+	convertFn := func(a byte) int32 {
+		assert.Equal(t, byte(0), a)
+		return int32(0)
+	}
+
+	aa := byteslice.ByteSlice{byte(0), byte(0)}
+	bb := aa.AsInt32Slice(convertFn)
+	assert.Equal(t, []int32{int32(0), int32(0)}, bb)
+	assert.Equal(t, byteslice.ByteSlice{byte(0), byte(0)}, aa)
+
+	cc := byteslice.AsInt32Slice(nil, convertFn)
+	assert.Equal(t, []int32{}, cc)
+}
+
+func TestAsInt64Slice(t *testing.T) {
+	convertFn := func(a byte) int64 {
+		assert.Equal(t, byte(0), a)
+		return int64(0)
+	}
+
+	aa := byteslice.ByteSlice{byte(0), byte(0)}
+	bb := aa.AsInt64Slice(convertFn)
+	assert.Equal(t, []int64{int64(0), int64(0)}, bb)
+	assert.Equal(t, byteslice.ByteSlice{byte(0), byte(0)}, aa)
+
+	cc := byteslice.AsInt64Slice(nil, convertFn)
+	assert.Equal(t, []int64{}, cc)
+}
+
+func TestAsRuneSlice(t *testing.T) {
+	convertFn := func(a byte) rune {
+		assert.Equal(t, byte(0), a)
+		return rune(0)
+	}
+
+	aa := byteslice.ByteSlice{byte(0), byte(0)}
+	bb := aa.AsRuneSlice(convertFn)
+	assert.Equal(t, []rune{rune(0), rune(0)}, bb)
+	assert.Equal(t, byteslice.ByteSlice{byte(0), byte(0)}, aa)
+
+	cc := byteslice.AsRuneSlice(nil, convertFn)
+	assert.Equal(t, []rune{}, cc)
+}
+
+func TestAsStringSlice(t *testing.T) {
+	convertFn := func(a byte) string {
+		assert.Equal(t, byte(0), a)
+		return ""
+	}
+
+	aa := byteslice.ByteSlice{byte(0), byte(0)}
+	bb := aa.AsStringSlice(convertFn)
+	assert.Equal(t, []string{"", ""}, bb)
+	assert.Equal(t, byteslice.ByteSlice{byte(0), byte(0)}, aa)
+
+	cc := byteslice.AsStringSlice(nil, convertFn)
+	assert.Equal(t, []string{}, cc)
+}
+
+func TestAsUintSlice(t *testing.T) {
+	convertFn := func(a byte) uint {
+		assert.Equal(t, byte(0), a)
+		return uint(0)
+	}
+
+	aa := byteslice.ByteSlice{byte(0), byte(0)}
+	bb := aa.AsUintSlice(convertFn)
+	assert.Equal(t, []uint{uint(0), uint(0)}, bb)
+	assert.Equal(t, byteslice.ByteSlice{byte(0), byte(0)}, aa)
+
+	cc := byteslice.AsUintSlice(nil, convertFn)
+	assert.Equal(t, []uint{}, cc)
+}
+
+func TestAsUint8Slice(t *testing.T) {
+	convertFn := func(a byte) uint8 {
+		assert.Equal(t, byte(0), a)
+		return uint8(0)
+	}
+
+	aa := byteslice.ByteSlice{byte(0), byte(0)}
+	bb := aa.AsUint8Slice(convertFn)
+	assert.Equal(t, []uint8{uint8(0), uint8(0)}, bb)
+	assert.Equal(t, byteslice.ByteSlice{byte(0), byte(0)}, aa)
+
+	cc := byteslice.AsUint8Slice(nil, convertFn)
+	assert.Equal(t, []uint8{}, cc)
+}
+
+func TestAsUint16Slice(t *testing.T) {
+	convertFn := func(a byte) uint16 {
+		assert.Equal(t, byte(0), a)
+		return uint16(0)
+	}
+
+	aa := byteslice.ByteSlice{byte(0), byte(0)}
+	bb := aa.AsUint16Slice(convertFn)
+	assert.Equal(t, []uint16{uint16(0), uint16(0)}, bb)
+	assert.Equal(t, byteslice.ByteSlice{byte(0), byte(0)}, aa)
+
+	cc := byteslice.AsUint16Slice(nil, convertFn)
+	assert.Equal(t, []uint16{}, cc)
+}
+
+func TestAsUint32Slice(t *testing.T) {
+	convertFn := func(a byte) uint32 {
+		assert.Equal(t, byte(0), a)
+		return uint32(0)
+	}
+
+	aa := byteslice.ByteSlice{byte(0), byte(0)}
+	bb := aa.AsUint32Slice(convertFn)
+	assert.Equal(t, []uint32{uint32(0), uint32(0)}, bb)
+	assert.Equal(t, byteslice.ByteSlice{byte(0), byte(0)}, aa)
+
+	cc := byteslice.AsUint32Slice(nil, convertFn)
+	assert.Equal(t, []uint32{}, cc)
+}
+
+func TestAsUint64Slice(t *testing.T) {
+	convertFn := func(a byte) uint64 {
+		assert.Equal(t, byte(0), a)
+		return uint64(0)
+	}
+
+	aa := byteslice.ByteSlice{byte(0), byte(0)}
+	bb := aa.AsUint64Slice(convertFn)
+	assert.Equal(t, []uint64{uint64(0), uint64(0)}, bb)
+	assert.Equal(t, byteslice.ByteSlice{byte(0), byte(0)}, aa)
+
+	cc := byteslice.AsUint64Slice(nil, convertFn)
+	assert.Equal(t, []uint64{}, cc)
+}
@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package byteslice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/byteslice"
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(byteslice.ByteSlice){
+		func(aa byteslice.ByteSlice) { aa.Clear() },
+		func(aa byteslice.ByteSlice) { aa.Clone() },
+		func(aa byteslice.ByteSlice) { aa.Dequeue() },
+		func(aa byteslice.ByteSlice) { aa.Empty() },
+		func(aa byteslice.ByteSlice) { aa.End() },
+		func(aa byteslice.ByteSlice) { aa.Head() },
+		func(aa byteslice.ByteSlice) { aa.Len() },
+		func(aa byteslice.ByteSlice) { aa.Permutable() },
+		func(aa byteslice.ByteSlice) { aa.Permutations() },
+		func(aa byteslice.ByteSlice) { aa.Permute() },
+		func(aa byteslice.ByteSlice) { aa.Pop() },
+		func(aa byteslice.ByteSlice) { aa.Reverse() },
+		func(aa byteslice.ByteSlice) { _ = aa.String() },
+		func(aa byteslice.ByteSlice) { aa.Tail() },
+		func(aa byteslice.ByteSlice) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(byteslice.ByteSlice{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(byteslice.ByteSlice){
+		func(aa byteslice.ByteSlice) { aa.Item(0) },
+		func(aa byteslice.ByteSlice) { aa.ItemFuzzy(0) },
+		func(aa byteslice.ByteSlice) { aa.RemoveAt(0) },
+		func(aa byteslice.ByteSlice) { aa.Skip(0) },
+		func(aa byteslice.ByteSlice) { aa.SplitAt(0) },
+		func(aa byteslice.ByteSlice) { aa.Take(0) },
+		func(aa byteslice.ByteSlice) { aa.Union(nil) },
+		func(aa byteslice.ByteSlice) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(byteslice.ByteSlice{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(byteslice.ByteSlice, byte){
+		func(aa byteslice.ByteSlice, b byte) { aa.Append(b) },
+		func(aa byteslice.ByteSlice, b byte) { aa.Enqueue(b) },
+		func(aa byteslice.ByteSlice, b byte) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(byteslice.ByteSlice{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(byteslice.ByteSlice, byteslice.ConditionFn){
+		func(aa byteslice.ByteSlice, condition byteslice.ConditionFn) { aa.All(condition) },
+		func(aa byteslice.ByteSlice, condition byteslice.ConditionFn) { aa.Any(condition) },
+		func(aa byteslice.ByteSlice, condition byteslice.ConditionFn) { aa.Count(condition) },
+		func(aa byteslice.ByteSlice, condition byteslice.ConditionFn) { aa.Filter(condition) },
+		func(aa byteslice.ByteSlice, condition byteslice.ConditionFn) { aa.FindIndex(condition) },
+		func(aa byteslice.ByteSlice, condition byteslice.ConditionFn) { aa.First(condition) },
+		func(aa byteslice.ByteSlice, condition byteslice.ConditionFn) { aa.Last(condition) },
+		func(aa byteslice.ByteSlice, condition byteslice.ConditionFn) { aa.None(condition) },
+		func(aa byteslice.ByteSlice, condition byteslice.ConditionFn) { aa.Partition(condition) },
+		func(aa byteslice.ByteSlice, condition byteslice.ConditionFn) { aa.Remove(condition) },
+		func(aa byteslice.ByteSlice, condition byteslice.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa byteslice.ByteSlice, condition byteslice.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa byteslice.ByteSlice, condition byteslice.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa byteslice.ByteSlice, condition byteslice.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ byte) bool {
+				return true
+			}
+			methodCall(byteslice.ByteSlice{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(byteslice.ByteSlice){
+		func(aa byteslice.ByteSlice) {
+			aa.Distinct(func(a, b byte) bool { return true })
+		},
+		func(aa byteslice.ByteSlice) {
+			aa.Expand(func(byte) []byte { return nil })
+		},
+		func(aa byteslice.ByteSlice) {
+			aa.ForEach(func(byte) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa byteslice.ByteSlice) {
+			aa.ForEachR(func(byte) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa byteslice.ByteSlice) {
+			aa.Group(func(byte) string { return "0" })
+		},
+		func(aa byteslice.ByteSlice) {
+			aa.GroupI(func(int64, byte) string { return "0" })
+		},
+		func(aa byteslice.ByteSlice) {
+			aa.GroupByTrait(func(a, b byte) bool { return true }, func(a, b byte) bool { return true })
+		},
+		func(aa byteslice.ByteSlice) {
+			aa.Map(func(byte) byte { return primitiveZero })
+		},
+		func(aa byteslice.ByteSlice) {
+			aa.Reduce(func(a, b byte) byte { return primitiveZero })
+		},
+		func(aa byteslice.ByteSlice) {
+			aa.Sort(func(a, b byte) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(byteslice.ByteSlice{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(byteslice.ByteSlice, byteslice.EqualityFn){
+		func(aa byteslice.ByteSlice, equality byteslice.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa byteslice.ByteSlice, equality byteslice.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa byteslice.ByteSlice, equality byteslice.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa byteslice.ByteSlice, equality byteslice.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa byteslice.ByteSlice, equality byteslice.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa byteslice.ByteSlice, equality byteslice.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b byte) bool {
+				return false
+			}
+			methodCall(byteslice.ByteSlice{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(byteslice.ByteSlice, byte, byteslice.ConditionFn){
+		func(aa byteslice.ByteSlice, b byte, condition byteslice.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa byteslice.ByteSlice, b byte, condition byteslice.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(byte) bool {
+				return false
+			}
+			methodCall(byteslice.ByteSlice{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(byteslice.ByteSlice){
+		func(aa byteslice.ByteSlice) {
+			aa.ForEachC(0, func(byte, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa byteslice.ByteSlice) {
+			aa.WindowCentered(0, func([]byte) byte { return primitiveZero })
+		},
+		func(aa byteslice.ByteSlice) {
+			aa.WindowLeft(0, func([]byte) byte { return primitiveZero })
+		},
+		func(aa byteslice.ByteSlice) {
+			aa.WindowRight(0, func([]byte) byte { return primitiveZero })
+		},
+		func(aa byteslice.ByteSlice) {
+			aa.Fold(primitiveZero, func(a, b byte) byte { return primitiveZero })
+		},
+		func(aa byteslice.ByteSlice) {
+			aa.FoldI(primitiveZero, func(i int64, a, b byte) byte { return primitiveZero })
+		},
+		func(aa byteslice.ByteSlice) {
+			aa.Pairwise(primitiveZero, func(a, b byte) byte { return primitiveZero })
+		},
+		func(aa byteslice.ByteSlice) {
+			aa.Collect([]byte{}, func(a, b byte) byte { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(byteslice.ByteSlice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(byteslice.ByteSlice){
+		func(aa byteslice.ByteSlice) { aa.InsertAt(primitiveZero, 0) },
+		func(aa byteslice.ByteSlice) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(byteslice.ByteSlice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
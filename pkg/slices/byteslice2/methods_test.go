@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package byteslice2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/byteslice2"
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(byteslice2.ByteSlice2){
+		func(aa byteslice2.ByteSlice2) { aa.Clear() },
+		func(aa byteslice2.ByteSlice2) { aa.Clone() },
+		func(aa byteslice2.ByteSlice2) { aa.Dequeue() },
+		func(aa byteslice2.ByteSlice2) { aa.Empty() },
+		func(aa byteslice2.ByteSlice2) { aa.End() },
+		func(aa byteslice2.ByteSlice2) { aa.Head() },
+		func(aa byteslice2.ByteSlice2) { aa.Len() },
+		func(aa byteslice2.ByteSlice2) { aa.Permutable() },
+		func(aa byteslice2.ByteSlice2) { aa.Permutations() },
+		func(aa byteslice2.ByteSlice2) { aa.Permute() },
+		func(aa byteslice2.ByteSlice2) { aa.Pop() },
+		func(aa byteslice2.ByteSlice2) { aa.Reverse() },
+		func(aa byteslice2.ByteSlice2) { _ = aa.String() },
+		func(aa byteslice2.ByteSlice2) { aa.Tail() },
+		func(aa byteslice2.ByteSlice2) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(byteslice2.ByteSlice2{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(byteslice2.ByteSlice2){
+		func(aa byteslice2.ByteSlice2) { aa.Item(0) },
+		func(aa byteslice2.ByteSlice2) { aa.ItemFuzzy(0) },
+		func(aa byteslice2.ByteSlice2) { aa.RemoveAt(0) },
+		func(aa byteslice2.ByteSlice2) { aa.Skip(0) },
+		func(aa byteslice2.ByteSlice2) { aa.SplitAt(0) },
+		func(aa byteslice2.ByteSlice2) { aa.Take(0) },
+		func(aa byteslice2.ByteSlice2) { aa.Union(nil) },
+		func(aa byteslice2.ByteSlice2) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(byteslice2.ByteSlice2{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(byteslice2.ByteSlice2, []byte){
+		func(aa byteslice2.ByteSlice2, b []byte) { aa.Append(b) },
+		func(aa byteslice2.ByteSlice2, b []byte) { aa.Enqueue(b) },
+		func(aa byteslice2.ByteSlice2, b []byte) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(byteslice2.ByteSlice2{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(byteslice2.ByteSlice2, byteslice2.ConditionFn){
+		func(aa byteslice2.ByteSlice2, condition byteslice2.ConditionFn) { aa.All(condition) },
+		func(aa byteslice2.ByteSlice2, condition byteslice2.ConditionFn) { aa.Any(condition) },
+		func(aa byteslice2.ByteSlice2, condition byteslice2.ConditionFn) { aa.Count(condition) },
+		func(aa byteslice2.ByteSlice2, condition byteslice2.ConditionFn) { aa.Filter(condition) },
+		func(aa byteslice2.ByteSlice2, condition byteslice2.ConditionFn) { aa.FindIndex(condition) },
+		func(aa byteslice2.ByteSlice2, condition byteslice2.ConditionFn) { aa.First(condition) },
+		func(aa byteslice2.ByteSlice2, condition byteslice2.ConditionFn) { aa.Last(condition) },
+		func(aa byteslice2.ByteSlice2, condition byteslice2.ConditionFn) { aa.None(condition) },
+		func(aa byteslice2.ByteSlice2, condition byteslice2.ConditionFn) { aa.Partition(condition) },
+		func(aa byteslice2.ByteSlice2, condition byteslice2.ConditionFn) { aa.Remove(condition) },
+		func(aa byteslice2.ByteSlice2, condition byteslice2.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa byteslice2.ByteSlice2, condition byteslice2.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa byteslice2.ByteSlice2, condition byteslice2.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa byteslice2.ByteSlice2, condition byteslice2.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ []byte) bool {
+				return true
+			}
+			methodCall(byteslice2.ByteSlice2{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(byteslice2.ByteSlice2){
+		func(aa byteslice2.ByteSlice2) {
+			aa.Distinct(func(a, b []byte) bool { return true })
+		},
+		func(aa byteslice2.ByteSlice2) {
+			aa.Expand(func([]byte) [][]byte { return nil })
+		},
+		func(aa byteslice2.ByteSlice2) {
+			aa.ForEach(func([]byte) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa byteslice2.ByteSlice2) {
+			aa.ForEachR(func([]byte) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa byteslice2.ByteSlice2) {
+			aa.Group(func([]byte) string { return "0" })
+		},
+		func(aa byteslice2.ByteSlice2) {
+			aa.GroupI(func(int64, []byte) string { return "0" })
+		},
+		func(aa byteslice2.ByteSlice2) {
+			aa.GroupByTrait(func(a, b []byte) bool { return true }, func(a, b []byte) bool { return true })
+		},
+		func(aa byteslice2.ByteSlice2) {
+			aa.Map(func([]byte) []byte { return primitiveZero })
+		},
+		func(aa byteslice2.ByteSlice2) {
+			aa.Reduce(func(a, b []byte) []byte { return primitiveZero })
+		},
+		func(aa byteslice2.ByteSlice2) {
+			aa.Sort(func(a, b []byte) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(byteslice2.ByteSlice2{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(byteslice2.ByteSlice2, byteslice2.EqualityFn){
+		func(aa byteslice2.ByteSlice2, equality byteslice2.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa byteslice2.ByteSlice2, equality byteslice2.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa byteslice2.ByteSlice2, equality byteslice2.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa byteslice2.ByteSlice2, equality byteslice2.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa byteslice2.ByteSlice2, equality byteslice2.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa byteslice2.ByteSlice2, equality byteslice2.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b []byte) bool {
+				return false
+			}
+			methodCall(byteslice2.ByteSlice2{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(byteslice2.ByteSlice2, []byte, byteslice2.ConditionFn){
+		func(aa byteslice2.ByteSlice2, b []byte, condition byteslice2.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa byteslice2.ByteSlice2, b []byte, condition byteslice2.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func([]byte) bool {
+				return false
+			}
+			methodCall(byteslice2.ByteSlice2{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(byteslice2.ByteSlice2){
+		func(aa byteslice2.ByteSlice2) {
+			aa.ForEachC(0, func([]byte, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa byteslice2.ByteSlice2) {
+			aa.WindowCentered(0, func([][]byte) []byte { return primitiveZero })
+		},
+		func(aa byteslice2.ByteSlice2) {
+			aa.WindowLeft(0, func([][]byte) []byte { return primitiveZero })
+		},
+		func(aa byteslice2.ByteSlice2) {
+			aa.WindowRight(0, func([][]byte) []byte { return primitiveZero })
+		},
+		func(aa byteslice2.ByteSlice2) {
+			aa.Fold(primitiveZero, func(a, b []byte) []byte { return primitiveZero })
+		},
+		func(aa byteslice2.ByteSlice2) {
+			aa.FoldI(primitiveZero, func(i int64, a, b []byte) []byte { return primitiveZero })
+		},
+		func(aa byteslice2.ByteSlice2) {
+			aa.Pairwise(primitiveZero, func(a, b []byte) []byte { return primitiveZero })
+		},
+		func(aa byteslice2.ByteSlice2) {
+			aa.Collect([][]byte{}, func(a, b []byte) []byte { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(byteslice2.ByteSlice2{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(byteslice2.ByteSlice2){
+		func(aa byteslice2.ByteSlice2) { aa.InsertAt(primitiveZero, 0) },
+		func(aa byteslice2.ByteSlice2) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(byteslice2.ByteSlice2{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
@@ -0,0 +1,262 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package complex128slice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/complex128slice"
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(complex128slice.Complex128Slice){
+		func(aa complex128slice.Complex128Slice) { aa.Clear() },
+		func(aa complex128slice.Complex128Slice) { aa.Clone() },
+		func(aa complex128slice.Complex128Slice) { aa.Dequeue() },
+		func(aa complex128slice.Complex128Slice) { aa.Empty() },
+		func(aa complex128slice.Complex128Slice) { aa.End() },
+		func(aa complex128slice.Complex128Slice) { aa.Head() },
+		func(aa complex128slice.Complex128Slice) { aa.Len() },
+		func(aa complex128slice.Complex128Slice) { aa.Permutable() },
+		func(aa complex128slice.Complex128Slice) { aa.Permutations() },
+		func(aa complex128slice.Complex128Slice) { aa.Permute() },
+		func(aa complex128slice.Complex128Slice) { aa.Pop() },
+		func(aa complex128slice.Complex128Slice) { aa.Reverse() },
+		func(aa complex128slice.Complex128Slice) { _ = aa.String() },
+		func(aa complex128slice.Complex128Slice) { aa.Tail() },
+		func(aa complex128slice.Complex128Slice) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(complex128slice.Complex128Slice{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(complex128slice.Complex128Slice){
+		func(aa complex128slice.Complex128Slice) { aa.Item(0) },
+		func(aa complex128slice.Complex128Slice) { aa.ItemFuzzy(0) },
+		func(aa complex128slice.Complex128Slice) { aa.RemoveAt(0) },
+		func(aa complex128slice.Complex128Slice) { aa.Skip(0) },
+		func(aa complex128slice.Complex128Slice) { aa.SplitAt(0) },
+		func(aa complex128slice.Complex128Slice) { aa.Take(0) },
+		func(aa complex128slice.Complex128Slice) { aa.Union(nil) },
+		func(aa complex128slice.Complex128Slice) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(complex128slice.Complex128Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(complex128slice.Complex128Slice, complex128){
+		func(aa complex128slice.Complex128Slice, b complex128) { aa.Append(b) },
+		func(aa complex128slice.Complex128Slice, b complex128) { aa.Enqueue(b) },
+		func(aa complex128slice.Complex128Slice, b complex128) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(complex128slice.Complex128Slice{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(complex128slice.Complex128Slice, complex128slice.ConditionFn){
+		func(aa complex128slice.Complex128Slice, condition complex128slice.ConditionFn) { aa.All(condition) },
+		func(aa complex128slice.Complex128Slice, condition complex128slice.ConditionFn) { aa.Any(condition) },
+		func(aa complex128slice.Complex128Slice, condition complex128slice.ConditionFn) { aa.Count(condition) },
+		func(aa complex128slice.Complex128Slice, condition complex128slice.ConditionFn) { aa.Filter(condition) },
+		func(aa complex128slice.Complex128Slice, condition complex128slice.ConditionFn) {
+			aa.FindIndex(condition)
+		},
+		func(aa complex128slice.Complex128Slice, condition complex128slice.ConditionFn) { aa.First(condition) },
+		func(aa complex128slice.Complex128Slice, condition complex128slice.ConditionFn) { aa.Last(condition) },
+		func(aa complex128slice.Complex128Slice, condition complex128slice.ConditionFn) { aa.None(condition) },
+		func(aa complex128slice.Complex128Slice, condition complex128slice.ConditionFn) {
+			aa.Partition(condition)
+		},
+		func(aa complex128slice.Complex128Slice, condition complex128slice.ConditionFn) { aa.Remove(condition) },
+		func(aa complex128slice.Complex128Slice, condition complex128slice.ConditionFn) {
+			aa.SkipWhile(condition)
+		},
+		func(aa complex128slice.Complex128Slice, condition complex128slice.ConditionFn) {
+			aa.SplitAfter(condition)
+		},
+		func(aa complex128slice.Complex128Slice, condition complex128slice.ConditionFn) {
+			aa.SplitBefore(condition)
+		},
+		func(aa complex128slice.Complex128Slice, condition complex128slice.ConditionFn) {
+			aa.TakeWhile(condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ complex128) bool {
+				return true
+			}
+			methodCall(complex128slice.Complex128Slice{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(complex128slice.Complex128Slice){
+		func(aa complex128slice.Complex128Slice) {
+			aa.Distinct(func(a, b complex128) bool { return true })
+		},
+		func(aa complex128slice.Complex128Slice) {
+			aa.Expand(func(complex128) []complex128 { return nil })
+		},
+		func(aa complex128slice.Complex128Slice) {
+			aa.ForEach(func(complex128) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa complex128slice.Complex128Slice) {
+			aa.ForEachR(func(complex128) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa complex128slice.Complex128Slice) {
+			aa.Group(func(complex128) string { return "0" })
+		},
+		func(aa complex128slice.Complex128Slice) {
+			aa.GroupI(func(int64, complex128) string { return "0" })
+		},
+		func(aa complex128slice.Complex128Slice) {
+			aa.GroupByTrait(func(a, b complex128) bool { return true }, func(a, b complex128) bool { return true })
+		},
+		func(aa complex128slice.Complex128Slice) {
+			aa.Map(func(complex128) complex128 { return primitiveZero })
+		},
+		func(aa complex128slice.Complex128Slice) {
+			aa.Reduce(func(a, b complex128) complex128 { return primitiveZero })
+		},
+		func(aa complex128slice.Complex128Slice) {
+			aa.Sort(func(a, b complex128) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(complex128slice.Complex128Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(complex128slice.Complex128Slice, complex128slice.EqualityFn){
+		func(aa complex128slice.Complex128Slice, equality complex128slice.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa complex128slice.Complex128Slice, equality complex128slice.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa complex128slice.Complex128Slice, equality complex128slice.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa complex128slice.Complex128Slice, equality complex128slice.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa complex128slice.Complex128Slice, equality complex128slice.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa complex128slice.Complex128Slice, equality complex128slice.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b complex128) bool {
+				return false
+			}
+			methodCall(complex128slice.Complex128Slice{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(complex128slice.Complex128Slice, complex128, complex128slice.ConditionFn){
+		func(aa complex128slice.Complex128Slice, b complex128, condition complex128slice.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa complex128slice.Complex128Slice, b complex128, condition complex128slice.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(complex128) bool {
+				return false
+			}
+			methodCall(complex128slice.Complex128Slice{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(complex128slice.Complex128Slice){
+		func(aa complex128slice.Complex128Slice) {
+			aa.ForEachC(0, func(complex128, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa complex128slice.Complex128Slice) {
+			aa.WindowCentered(0, func([]complex128) complex128 { return primitiveZero })
+		},
+		func(aa complex128slice.Complex128Slice) {
+			aa.WindowLeft(0, func([]complex128) complex128 { return primitiveZero })
+		},
+		func(aa complex128slice.Complex128Slice) {
+			aa.WindowRight(0, func([]complex128) complex128 { return primitiveZero })
+		},
+		func(aa complex128slice.Complex128Slice) {
+			aa.Fold(primitiveZero, func(a, b complex128) complex128 { return primitiveZero })
+		},
+		func(aa complex128slice.Complex128Slice) {
+			aa.FoldI(primitiveZero, func(i int64, a, b complex128) complex128 { return primitiveZero })
+		},
+		func(aa complex128slice.Complex128Slice) {
+			aa.Pairwise(primitiveZero, func(a, b complex128) complex128 { return primitiveZero })
+		},
+		func(aa complex128slice.Complex128Slice) {
+			aa.Collect([]complex128{}, func(a, b complex128) complex128 { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(complex128slice.Complex128Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(complex128slice.Complex128Slice){
+		func(aa complex128slice.Complex128Slice) { aa.InsertAt(primitiveZero, 0) },
+		func(aa complex128slice.Complex128Slice) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(complex128slice.Complex128Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
@@ -0,0 +1,252 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package complex64slice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/complex64slice"
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(complex64slice.Complex64Slice){
+		func(aa complex64slice.Complex64Slice) { aa.Clear() },
+		func(aa complex64slice.Complex64Slice) { aa.Clone() },
+		func(aa complex64slice.Complex64Slice) { aa.Dequeue() },
+		func(aa complex64slice.Complex64Slice) { aa.Empty() },
+		func(aa complex64slice.Complex64Slice) { aa.End() },
+		func(aa complex64slice.Complex64Slice) { aa.Head() },
+		func(aa complex64slice.Complex64Slice) { aa.Len() },
+		func(aa complex64slice.Complex64Slice) { aa.Permutable() },
+		func(aa complex64slice.Complex64Slice) { aa.Permutations() },
+		func(aa complex64slice.Complex64Slice) { aa.Permute() },
+		func(aa complex64slice.Complex64Slice) { aa.Pop() },
+		func(aa complex64slice.Complex64Slice) { aa.Reverse() },
+		func(aa complex64slice.Complex64Slice) { _ = aa.String() },
+		func(aa complex64slice.Complex64Slice) { aa.Tail() },
+		func(aa complex64slice.Complex64Slice) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(complex64slice.Complex64Slice{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(complex64slice.Complex64Slice){
+		func(aa complex64slice.Complex64Slice) { aa.Item(0) },
+		func(aa complex64slice.Complex64Slice) { aa.ItemFuzzy(0) },
+		func(aa complex64slice.Complex64Slice) { aa.RemoveAt(0) },
+		func(aa complex64slice.Complex64Slice) { aa.Skip(0) },
+		func(aa complex64slice.Complex64Slice) { aa.SplitAt(0) },
+		func(aa complex64slice.Complex64Slice) { aa.Take(0) },
+		func(aa complex64slice.Complex64Slice) { aa.Union(nil) },
+		func(aa complex64slice.Complex64Slice) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(complex64slice.Complex64Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(complex64slice.Complex64Slice, complex64){
+		func(aa complex64slice.Complex64Slice, b complex64) { aa.Append(b) },
+		func(aa complex64slice.Complex64Slice, b complex64) { aa.Enqueue(b) },
+		func(aa complex64slice.Complex64Slice, b complex64) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(complex64slice.Complex64Slice{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(complex64slice.Complex64Slice, complex64slice.ConditionFn){
+		func(aa complex64slice.Complex64Slice, condition complex64slice.ConditionFn) { aa.All(condition) },
+		func(aa complex64slice.Complex64Slice, condition complex64slice.ConditionFn) { aa.Any(condition) },
+		func(aa complex64slice.Complex64Slice, condition complex64slice.ConditionFn) { aa.Count(condition) },
+		func(aa complex64slice.Complex64Slice, condition complex64slice.ConditionFn) { aa.Filter(condition) },
+		func(aa complex64slice.Complex64Slice, condition complex64slice.ConditionFn) { aa.FindIndex(condition) },
+		func(aa complex64slice.Complex64Slice, condition complex64slice.ConditionFn) { aa.First(condition) },
+		func(aa complex64slice.Complex64Slice, condition complex64slice.ConditionFn) { aa.Last(condition) },
+		func(aa complex64slice.Complex64Slice, condition complex64slice.ConditionFn) { aa.None(condition) },
+		func(aa complex64slice.Complex64Slice, condition complex64slice.ConditionFn) { aa.Partition(condition) },
+		func(aa complex64slice.Complex64Slice, condition complex64slice.ConditionFn) { aa.Remove(condition) },
+		func(aa complex64slice.Complex64Slice, condition complex64slice.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa complex64slice.Complex64Slice, condition complex64slice.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa complex64slice.Complex64Slice, condition complex64slice.ConditionFn) {
+			aa.SplitBefore(condition)
+		},
+		func(aa complex64slice.Complex64Slice, condition complex64slice.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ complex64) bool {
+				return true
+			}
+			methodCall(complex64slice.Complex64Slice{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(complex64slice.Complex64Slice){
+		func(aa complex64slice.Complex64Slice) {
+			aa.Distinct(func(a, b complex64) bool { return true })
+		},
+		func(aa complex64slice.Complex64Slice) {
+			aa.Expand(func(complex64) []complex64 { return nil })
+		},
+		func(aa complex64slice.Complex64Slice) {
+			aa.ForEach(func(complex64) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa complex64slice.Complex64Slice) {
+			aa.ForEachR(func(complex64) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa complex64slice.Complex64Slice) {
+			aa.Group(func(complex64) string { return "0" })
+		},
+		func(aa complex64slice.Complex64Slice) {
+			aa.GroupI(func(int64, complex64) string { return "0" })
+		},
+		func(aa complex64slice.Complex64Slice) {
+			aa.GroupByTrait(func(a, b complex64) bool { return true }, func(a, b complex64) bool { return true })
+		},
+		func(aa complex64slice.Complex64Slice) {
+			aa.Map(func(complex64) complex64 { return primitiveZero })
+		},
+		func(aa complex64slice.Complex64Slice) {
+			aa.Reduce(func(a, b complex64) complex64 { return primitiveZero })
+		},
+		func(aa complex64slice.Complex64Slice) {
+			aa.Sort(func(a, b complex64) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(complex64slice.Complex64Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(complex64slice.Complex64Slice, complex64slice.EqualityFn){
+		func(aa complex64slice.Complex64Slice, equality complex64slice.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa complex64slice.Complex64Slice, equality complex64slice.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa complex64slice.Complex64Slice, equality complex64slice.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa complex64slice.Complex64Slice, equality complex64slice.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa complex64slice.Complex64Slice, equality complex64slice.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa complex64slice.Complex64Slice, equality complex64slice.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b complex64) bool {
+				return false
+			}
+			methodCall(complex64slice.Complex64Slice{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(complex64slice.Complex64Slice, complex64, complex64slice.ConditionFn){
+		func(aa complex64slice.Complex64Slice, b complex64, condition complex64slice.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa complex64slice.Complex64Slice, b complex64, condition complex64slice.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(complex64) bool {
+				return false
+			}
+			methodCall(complex64slice.Complex64Slice{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(complex64slice.Complex64Slice){
+		func(aa complex64slice.Complex64Slice) {
+			aa.ForEachC(0, func(complex64, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa complex64slice.Complex64Slice) {
+			aa.WindowCentered(0, func([]complex64) complex64 { return primitiveZero })
+		},
+		func(aa complex64slice.Complex64Slice) {
+			aa.WindowLeft(0, func([]complex64) complex64 { return primitiveZero })
+		},
+		func(aa complex64slice.Complex64Slice) {
+			aa.WindowRight(0, func([]complex64) complex64 { return primitiveZero })
+		},
+		func(aa complex64slice.Complex64Slice) {
+			aa.Fold(primitiveZero, func(a, b complex64) complex64 { return primitiveZero })
+		},
+		func(aa complex64slice.Complex64Slice) {
+			aa.FoldI(primitiveZero, func(i int64, a, b complex64) complex64 { return primitiveZero })
+		},
+		func(aa complex64slice.Complex64Slice) {
+			aa.Pairwise(primitiveZero, func(a, b complex64) complex64 { return primitiveZero })
+		},
+		func(aa complex64slice.Complex64Slice) {
+			aa.Collect([]complex64{}, func(a, b complex64) complex64 { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(complex64slice.Complex64Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(complex64slice.Complex64Slice){
+		func(aa complex64slice.Complex64Slice) { aa.InsertAt(primitiveZero, 0) },
+		func(aa complex64slice.Complex64Slice) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(complex64slice.Complex64Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
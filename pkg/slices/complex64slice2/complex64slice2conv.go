@@ -0,0 +1,292 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package complex64slice2
+
+// AsBoolSlice2 applies convertFn to each element of aa, and returns the
+// results as a [][]bool.
+func AsBoolSlice2(aa [][]complex64, convertFn func([]complex64) []bool) [][]bool {
+	bb := [][]bool{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsByteSlice2 applies convertFn to each element of aa, and returns the
+// results as a [][]byte.
+func AsByteSlice2(aa [][]complex64, convertFn func([]complex64) []byte) [][]byte {
+	bb := [][]byte{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsComplex128Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]complex128.
+func AsComplex128Slice2(aa [][]complex64, convertFn func([]complex64) []complex128) [][]complex128 {
+	bb := [][]complex128{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsFloat32Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]float32.
+func AsFloat32Slice2(aa [][]complex64, convertFn func([]complex64) []float32) [][]float32 {
+	bb := [][]float32{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsFloat64Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]float64.
+func AsFloat64Slice2(aa [][]complex64, convertFn func([]complex64) []float64) [][]float64 {
+	bb := [][]float64{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsIntSlice2 applies convertFn to each element of aa, and returns the
+// results as a [][]int.
+func AsIntSlice2(aa [][]complex64, convertFn func([]complex64) []int) [][]int {
+	bb := [][]int{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsInt8Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]int8.
+func AsInt8Slice2(aa [][]complex64, convertFn func([]complex64) []int8) [][]int8 {
+	bb := [][]int8{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsInt16Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]int16.
+func AsInt16Slice2(aa [][]complex64, convertFn func([]complex64) []int16) [][]int16 {
+	bb := [][]int16{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsInt32Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]int32.
+func AsInt32Slice2(aa [][]complex64, convertFn func([]complex64) []int32) [][]int32 {
+	bb := [][]int32{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsInt64Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]int64.
+func AsInt64Slice2(aa [][]complex64, convertFn func([]complex64) []int64) [][]int64 {
+	bb := [][]int64{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsRuneSlice2 applies convertFn to each element of aa, and returns the
+// results as a [][]rune.
+func AsRuneSlice2(aa [][]complex64, convertFn func([]complex64) []rune) [][]rune {
+	bb := [][]rune{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsStringSlice2 applies convertFn to each element of aa, and returns the
+// results as a [][]string.
+func AsStringSlice2(aa [][]complex64, convertFn func([]complex64) []string) [][]string {
+	bb := [][]string{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsUintSlice2 applies convertFn to each element of aa, and returns the
+// results as a [][]uint.
+func AsUintSlice2(aa [][]complex64, convertFn func([]complex64) []uint) [][]uint {
+	bb := [][]uint{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsUint8Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]uint8.
+func AsUint8Slice2(aa [][]complex64, convertFn func([]complex64) []uint8) [][]uint8 {
+	bb := [][]uint8{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsUint16Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]uint16.
+func AsUint16Slice2(aa [][]complex64, convertFn func([]complex64) []uint16) [][]uint16 {
+	bb := [][]uint16{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsUint32Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]uint32.
+func AsUint32Slice2(aa [][]complex64, convertFn func([]complex64) []uint32) [][]uint32 {
+	bb := [][]uint32{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsUint64Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]uint64.
+func AsUint64Slice2(aa [][]complex64, convertFn func([]complex64) []uint64) [][]uint64 {
+	bb := [][]uint64{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsBoolSlice2 applies convertFn to each element of aa, and returns the
+// results as a [][]bool, which may be converted to a BoolSlice2
+// where required.
+func (aa *Complex64Slice2) AsBoolSlice2(convertFn func([]complex64) []bool) [][]bool {
+	return AsBoolSlice2(*aa, convertFn)
+}
+
+// AsByteSlice2 applies convertFn to each element of aa, and returns the
+// results as a [][]byte, which may be converted to a ByteSlice2
+// where required.
+func (aa *Complex64Slice2) AsByteSlice2(convertFn func([]complex64) []byte) [][]byte {
+	return AsByteSlice2(*aa, convertFn)
+}
+
+// AsComplex128Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]complex128, which may be converted to a Complex128Slice2
+// where required.
+func (aa *Complex64Slice2) AsComplex128Slice2(convertFn func([]complex64) []complex128) [][]complex128 {
+	return AsComplex128Slice2(*aa, convertFn)
+}
+
+// AsFloat32Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]float32, which may be converted to a Float32Slice2
+// where required.
+func (aa *Complex64Slice2) AsFloat32Slice2(convertFn func([]complex64) []float32) [][]float32 {
+	return AsFloat32Slice2(*aa, convertFn)
+}
+
+// AsFloat64Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]float64, which may be converted to a Float64Slice2
+// where required.
+func (aa *Complex64Slice2) AsFloat64Slice2(convertFn func([]complex64) []float64) [][]float64 {
+	return AsFloat64Slice2(*aa, convertFn)
+}
+
+// AsIntSlice2 applies convertFn to each element of aa, and returns the
+// results as a [][]int, which may be converted to a IntSlice2
+// where required.
+func (aa *Complex64Slice2) AsIntSlice2(convertFn func([]complex64) []int) [][]int {
+	return AsIntSlice2(*aa, convertFn)
+}
+
+// AsInt8Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]int8, which may be converted to a Int8Slice2
+// where required.
+func (aa *Complex64Slice2) AsInt8Slice2(convertFn func([]complex64) []int8) [][]int8 {
+	return AsInt8Slice2(*aa, convertFn)
+}
+
+// AsInt16Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]int16, which may be converted to a Int16Slice2
+// where required.
+func (aa *Complex64Slice2) AsInt16Slice2(convertFn func([]complex64) []int16) [][]int16 {
+	return AsInt16Slice2(*aa, convertFn)
+}
+
+// AsInt32Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]int32, which may be converted to a Int32Slice2
+// where required.
+func (aa *Complex64Slice2) AsInt32Slice2(convertFn func([]complex64) []int32) [][]int32 {
+	return AsInt32Slice2(*aa, convertFn)
+}
+
+// AsInt64Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]int64, which may be converted to a Int64Slice2
+// where required.
+func (aa *Complex64Slice2) AsInt64Slice2(convertFn func([]complex64) []int64) [][]int64 {
+	return AsInt64Slice2(*aa, convertFn)
+}
+
+// AsRuneSlice2 applies convertFn to each element of aa, and returns the
+// results as a [][]rune, which may be converted to a RuneSlice2
+// where required.
+func (aa *Complex64Slice2) AsRuneSlice2(convertFn func([]complex64) []rune) [][]rune {
+	return AsRuneSlice2(*aa, convertFn)
+}
+
+// AsStringSlice2 applies convertFn to each element of aa, and returns the
+// results as a [][]string, which may be converted to a StringSlice2
+// where required.
+func (aa *Complex64Slice2) AsStringSlice2(convertFn func([]complex64) []string) [][]string {
+	return AsStringSlice2(*aa, convertFn)
+}
+
+// AsUintSlice2 applies convertFn to each element of aa, and returns the
+// results as a [][]uint, which may be converted to a UintSlice2
+// where required.
+func (aa *Complex64Slice2) AsUintSlice2(convertFn func([]complex64) []uint) [][]uint {
+	return AsUintSlice2(*aa, convertFn)
+}
+
+// AsUint8Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]uint8, which may be converted to a Uint8Slice2
+// where required.
+func (aa *Complex64Slice2) AsUint8Slice2(convertFn func([]complex64) []uint8) [][]uint8 {
+	return AsUint8Slice2(*aa, convertFn)
+}
+
+// AsUint16Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]uint16, which may be converted to a Uint16Slice2
+// where required.
+func (aa *Complex64Slice2) AsUint16Slice2(convertFn func([]complex64) []uint16) [][]uint16 {
+	return AsUint16Slice2(*aa, convertFn)
+}
+
+// AsUint32Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]uint32, which may be converted to a Uint32Slice2
+// where required.
+func (aa *Complex64Slice2) AsUint32Slice2(convertFn func([]complex64) []uint32) [][]uint32 {
+	return AsUint32Slice2(*aa, convertFn)
+}
+
+// AsUint64Slice2 applies convertFn to each element of aa, and returns the
+// results as a [][]uint64, which may be converted to a Uint64Slice2
+// where required.
+func (aa *Complex64Slice2) AsUint64Slice2(convertFn func([]complex64) []uint64) [][]uint64 {
+	return AsUint64Slice2(*aa, convertFn)
+}
@@ -0,0 +1,292 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package float32slice
+
+// AsBoolSlice applies convertFn to each element of aa, and returns the
+// results as a []bool.
+func AsBoolSlice(aa []float32, convertFn func(float32) bool) []bool {
+	bb := []bool{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsByteSlice applies convertFn to each element of aa, and returns the
+// results as a []byte.
+func AsByteSlice(aa []float32, convertFn func(float32) byte) []byte {
+	bb := []byte{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsComplex64Slice applies convertFn to each element of aa, and returns the
+// results as a []complex64.
+func AsComplex64Slice(aa []float32, convertFn func(float32) complex64) []complex64 {
+	bb := []complex64{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsComplex128Slice applies convertFn to each element of aa, and returns the
+// results as a []complex128.
+func AsComplex128Slice(aa []float32, convertFn func(float32) complex128) []complex128 {
+	bb := []complex128{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsFloat64Slice applies convertFn to each element of aa, and returns the
+// results as a []float64.
+func AsFloat64Slice(aa []float32, convertFn func(float32) float64) []float64 {
+	bb := []float64{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsIntSlice applies convertFn to each element of aa, and returns the
+// results as a []int.
+func AsIntSlice(aa []float32, convertFn func(float32) int) []int {
+	bb := []int{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsInt8Slice applies convertFn to each element of aa, and returns the
+// results as a []int8.
+func AsInt8Slice(aa []float32, convertFn func(float32) int8) []int8 {
+	bb := []int8{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsInt16Slice applies convertFn to each element of aa, and returns the
+// results as a []int16.
+func AsInt16Slice(aa []float32, convertFn func(float32) int16) []int16 {
+	bb := []int16{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsInt32Slice applies convertFn to each element of aa, and returns the
+// results as a []int32.
+func AsInt32Slice(aa []float32, convertFn func(float32) int32) []int32 {
+	bb := []int32{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsInt64Slice applies convertFn to each element of aa, and returns the
+// results as a []int64.
+func AsInt64Slice(aa []float32, convertFn func(float32) int64) []int64 {
+	bb := []int64{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsRuneSlice applies convertFn to each element of aa, and returns the
+// results as a []rune.
+func AsRuneSlice(aa []float32, convertFn func(float32) rune) []rune {
+	bb := []rune{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsStringSlice applies convertFn to each element of aa, and returns the
+// results as a []string.
+func AsStringSlice(aa []float32, convertFn func(float32) string) []string {
+	bb := []string{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsUintSlice applies convertFn to each element of aa, and returns the
+// results as a []uint.
+func AsUintSlice(aa []float32, convertFn func(float32) uint) []uint {
+	bb := []uint{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsUint8Slice applies convertFn to each element of aa, and returns the
+// results as a []uint8.
+func AsUint8Slice(aa []float32, convertFn func(float32) uint8) []uint8 {
+	bb := []uint8{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsUint16Slice applies convertFn to each element of aa, and returns the
+// results as a []uint16.
+func AsUint16Slice(aa []float32, convertFn func(float32) uint16) []uint16 {
+	bb := []uint16{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsUint32Slice applies convertFn to each element of aa, and returns the
+// results as a []uint32.
+func AsUint32Slice(aa []float32, convertFn func(float32) uint32) []uint32 {
+	bb := []uint32{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsUint64Slice applies convertFn to each element of aa, and returns the
+// results as a []uint64.
+func AsUint64Slice(aa []float32, convertFn func(float32) uint64) []uint64 {
+	bb := []uint64{}
+	for _, a := range aa {
+		bb = append(bb, convertFn(a))
+	}
+	return bb
+}
+
+// AsBoolSlice applies convertFn to each element of aa, and returns the
+// results as a []bool, which may be converted to a BoolSlice
+// where required.
+func (aa *Float32Slice) AsBoolSlice(convertFn func(float32) bool) []bool {
+	return AsBoolSlice(*aa, convertFn)
+}
+
+// AsByteSlice applies convertFn to each element of aa, and returns the
+// results as a []byte, which may be converted to a ByteSlice
+// where required.
+func (aa *Float32Slice) AsByteSlice(convertFn func(float32) byte) []byte {
+	return AsByteSlice(*aa, convertFn)
+}
+
+// AsComplex64Slice applies convertFn to each element of aa, and returns the
+// results as a []complex64, which may be converted to a Complex64Slice
+// where required.
+func (aa *Float32Slice) AsComplex64Slice(convertFn func(float32) complex64) []complex64 {
+	return AsComplex64Slice(*aa, convertFn)
+}
+
+// AsComplex128Slice applies convertFn to each element of aa, and returns the
+// results as a []complex128, which may be converted to a Complex128Slice
+// where required.
+func (aa *Float32Slice) AsComplex128Slice(convertFn func(float32) complex128) []complex128 {
+	return AsComplex128Slice(*aa, convertFn)
+}
+
+// AsFloat64Slice applies convertFn to each element of aa, and returns the
+// results as a []float64, which may be converted to a Float64Slice
+// where required.
+func (aa *Float32Slice) AsFloat64Slice(convertFn func(float32) float64) []float64 {
+	return AsFloat64Slice(*aa, convertFn)
+}
+
+// AsIntSlice applies convertFn to each element of aa, and returns the
+// results as a []int, which may be converted to a IntSlice
+// where required.
+func (aa *Float32Slice) AsIntSlice(convertFn func(float32) int) []int {
+	return AsIntSlice(*aa, convertFn)
+}
+
+// AsInt8Slice applies convertFn to each element of aa, and returns the
+// results as a []int8, which may be converted to a Int8Slice
+// where required.
+func (aa *Float32Slice) AsInt8Slice(convertFn func(float32) int8) []int8 {
+	return AsInt8Slice(*aa, convertFn)
+}
+
+// AsInt16Slice applies convertFn to each element of aa, and returns the
+// results as a []int16, which may be converted to a Int16Slice
+// where required.
+func (aa *Float32Slice) AsInt16Slice(convertFn func(float32) int16) []int16 {
+	return AsInt16Slice(*aa, convertFn)
+}
+
+// AsInt32Slice applies convertFn to each element of aa, and returns the
+// results as a []int32, which may be converted to a Int32Slice
+// where required.
+func (aa *Float32Slice) AsInt32Slice(convertFn func(float32) int32) []int32 {
+	return AsInt32Slice(*aa, convertFn)
+}
+
+// AsInt64Slice applies convertFn to each element of aa, and returns the
+// results as a []int64, which may be converted to a Int64Slice
+// where required.
+func (aa *Float32Slice) AsInt64Slice(convertFn func(float32) int64) []int64 {
+	return AsInt64Slice(*aa, convertFn)
+}
+
+// AsRuneSlice applies convertFn to each element of aa, and returns the
+// results as a []rune, which may be converted to a RuneSlice
+// where required.
+func (aa *Float32Slice) AsRuneSlice(convertFn func(float32) rune) []rune {
+	return AsRuneSlice(*aa, convertFn)
+}
+
+// AsStringSlice applies convertFn to each element of aa, and returns the
+// results as a []string, which may be converted to a StringSlice
+// where required.
+func (aa *Float32Slice) AsStringSlice(convertFn func(float32) string) []string {
+	return AsStringSlice(*aa, convertFn)
+}
+
+// AsUintSlice applies convertFn to each element of aa, and returns the
+// results as a []uint, which may be converted to a UintSlice
+// where required.
+func (aa *Float32Slice) AsUintSlice(convertFn func(float32) uint) []uint {
+	return AsUintSlice(*aa, convertFn)
+}
+
+// AsUint8Slice applies convertFn to each element of aa, and returns the
+// results as a []uint8, which may be converted to a Uint8Slice
+// where required.
+func (aa *Float32Slice) AsUint8Slice(convertFn func(float32) uint8) []uint8 {
+	return AsUint8Slice(*aa, convertFn)
+}
+
+// AsUint16Slice applies convertFn to each element of aa, and returns the
+// results as a []uint16, which may be converted to a Uint16Slice
+// where required.
+func (aa *Float32Slice) AsUint16Slice(convertFn func(float32) uint16) []uint16 {
+	return AsUint16Slice(*aa, convertFn)
+}
+
+// AsUint32Slice applies convertFn to each element of aa, and returns the
+// results as a []uint32, which may be converted to a Uint32Slice
+// where required.
+func (aa *Float32Slice) AsUint32Slice(convertFn func(float32) uint32) []uint32 {
+	return AsUint32Slice(*aa, convertFn)
+}
+
+// AsUint64Slice applies convertFn to each element of aa, and returns the
+// results as a []uint64, which may be converted to a Uint64Slice
+// where required.
+func (aa *Float32Slice) AsUint64Slice(convertFn func(float32) uint64) []uint64 {
+	return AsUint64Slice(*aa, convertFn)
+}
@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package float32slice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/float32slice"
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(float32slice.Float32Slice){
+		func(aa float32slice.Float32Slice) { aa.Clear() },
+		func(aa float32slice.Float32Slice) { aa.Clone() },
+		func(aa float32slice.Float32Slice) { aa.Dequeue() },
+		func(aa float32slice.Float32Slice) { aa.Empty() },
+		func(aa float32slice.Float32Slice) { aa.End() },
+		func(aa float32slice.Float32Slice) { aa.Head() },
+		func(aa float32slice.Float32Slice) { aa.Len() },
+		func(aa float32slice.Float32Slice) { aa.Permutable() },
+		func(aa float32slice.Float32Slice) { aa.Permutations() },
+		func(aa float32slice.Float32Slice) { aa.Permute() },
+		func(aa float32slice.Float32Slice) { aa.Pop() },
+		func(aa float32slice.Float32Slice) { aa.Reverse() },
+		func(aa float32slice.Float32Slice) { _ = aa.String() },
+		func(aa float32slice.Float32Slice) { aa.Tail() },
+		func(aa float32slice.Float32Slice) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(float32slice.Float32Slice{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(float32slice.Float32Slice){
+		func(aa float32slice.Float32Slice) { aa.Item(0) },
+		func(aa float32slice.Float32Slice) { aa.ItemFuzzy(0) },
+		func(aa float32slice.Float32Slice) { aa.RemoveAt(0) },
+		func(aa float32slice.Float32Slice) { aa.Skip(0) },
+		func(aa float32slice.Float32Slice) { aa.SplitAt(0) },
+		func(aa float32slice.Float32Slice) { aa.Take(0) },
+		func(aa float32slice.Float32Slice) { aa.Union(nil) },
+		func(aa float32slice.Float32Slice) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(float32slice.Float32Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(float32slice.Float32Slice, float32){
+		func(aa float32slice.Float32Slice, b float32) { aa.Append(b) },
+		func(aa float32slice.Float32Slice, b float32) { aa.Enqueue(b) },
+		func(aa float32slice.Float32Slice, b float32) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(float32slice.Float32Slice{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(float32slice.Float32Slice, float32slice.ConditionFn){
+		func(aa float32slice.Float32Slice, condition float32slice.ConditionFn) { aa.All(condition) },
+		func(aa float32slice.Float32Slice, condition float32slice.ConditionFn) { aa.Any(condition) },
+		func(aa float32slice.Float32Slice, condition float32slice.ConditionFn) { aa.Count(condition) },
+		func(aa float32slice.Float32Slice, condition float32slice.ConditionFn) { aa.Filter(condition) },
+		func(aa float32slice.Float32Slice, condition float32slice.ConditionFn) { aa.FindIndex(condition) },
+		func(aa float32slice.Float32Slice, condition float32slice.ConditionFn) { aa.First(condition) },
+		func(aa float32slice.Float32Slice, condition float32slice.ConditionFn) { aa.Last(condition) },
+		func(aa float32slice.Float32Slice, condition float32slice.ConditionFn) { aa.None(condition) },
+		func(aa float32slice.Float32Slice, condition float32slice.ConditionFn) { aa.Partition(condition) },
+		func(aa float32slice.Float32Slice, condition float32slice.ConditionFn) { aa.Remove(condition) },
+		func(aa float32slice.Float32Slice, condition float32slice.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa float32slice.Float32Slice, condition float32slice.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa float32slice.Float32Slice, condition float32slice.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa float32slice.Float32Slice, condition float32slice.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ float32) bool {
+				return true
+			}
+			methodCall(float32slice.Float32Slice{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(float32slice.Float32Slice){
+		func(aa float32slice.Float32Slice) {
+			aa.Distinct(func(a, b float32) bool { return true })
+		},
+		func(aa float32slice.Float32Slice) {
+			aa.Expand(func(float32) []float32 { return nil })
+		},
+		func(aa float32slice.Float32Slice) {
+			aa.ForEach(func(float32) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa float32slice.Float32Slice) {
+			aa.ForEachR(func(float32) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa float32slice.Float32Slice) {
+			aa.Group(func(float32) string { return "0" })
+		},
+		func(aa float32slice.Float32Slice) {
+			aa.GroupI(func(int64, float32) string { return "0" })
+		},
+		func(aa float32slice.Float32Slice) {
+			aa.GroupByTrait(func(a, b float32) bool { return true }, func(a, b float32) bool { return true })
+		},
+		func(aa float32slice.Float32Slice) {
+			aa.Map(func(float32) float32 { return primitiveZero })
+		},
+		func(aa float32slice.Float32Slice) {
+			aa.Reduce(func(a, b float32) float32 { return primitiveZero })
+		},
+		func(aa float32slice.Float32Slice) {
+			aa.Sort(func(a, b float32) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(float32slice.Float32Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(float32slice.Float32Slice, float32slice.EqualityFn){
+		func(aa float32slice.Float32Slice, equality float32slice.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa float32slice.Float32Slice, equality float32slice.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa float32slice.Float32Slice, equality float32slice.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa float32slice.Float32Slice, equality float32slice.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa float32slice.Float32Slice, equality float32slice.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa float32slice.Float32Slice, equality float32slice.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b float32) bool {
+				return false
+			}
+			methodCall(float32slice.Float32Slice{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(float32slice.Float32Slice, float32, float32slice.ConditionFn){
+		func(aa float32slice.Float32Slice, b float32, condition float32slice.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa float32slice.Float32Slice, b float32, condition float32slice.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(float32) bool {
+				return false
+			}
+			methodCall(float32slice.Float32Slice{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(float32slice.Float32Slice){
+		func(aa float32slice.Float32Slice) {
+			aa.ForEachC(0, func(float32, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa float32slice.Float32Slice) {
+			aa.WindowCentered(0, func([]float32) float32 { return primitiveZero })
+		},
+		func(aa float32slice.Float32Slice) {
+			aa.WindowLeft(0, func([]float32) float32 { return primitiveZero })
+		},
+		func(aa float32slice.Float32Slice) {
+			aa.WindowRight(0, func([]float32) float32 { return primitiveZero })
+		},
+		func(aa float32slice.Float32Slice) {
+			aa.Fold(primitiveZero, func(a, b float32) float32 { return primitiveZero })
+		},
+		func(aa float32slice.Float32Slice) {
+			aa.FoldI(primitiveZero, func(i int64, a, b float32) float32 { return primitiveZero })
+		},
+		func(aa float32slice.Float32Slice) {
+			aa.Pairwise(primitiveZero, func(a, b float32) float32 { return primitiveZero })
+		},
+		func(aa float32slice.Float32Slice) {
+			aa.Collect([]float32{}, func(a, b float32) float32 { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(float32slice.Float32Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(float32slice.Float32Slice){
+		func(aa float32slice.Float32Slice) { aa.InsertAt(primitiveZero, 0) },
+		func(aa float32slice.Float32Slice) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(float32slice.Float32Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package float64slice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/float64slice"
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(float64slice.Float64Slice){
+		func(aa float64slice.Float64Slice) { aa.Clear() },
+		func(aa float64slice.Float64Slice) { aa.Clone() },
+		func(aa float64slice.Float64Slice) { aa.Dequeue() },
+		func(aa float64slice.Float64Slice) { aa.Empty() },
+		func(aa float64slice.Float64Slice) { aa.End() },
+		func(aa float64slice.Float64Slice) { aa.Head() },
+		func(aa float64slice.Float64Slice) { aa.Len() },
+		func(aa float64slice.Float64Slice) { aa.Permutable() },
+		func(aa float64slice.Float64Slice) { aa.Permutations() },
+		func(aa float64slice.Float64Slice) { aa.Permute() },
+		func(aa float64slice.Float64Slice) { aa.Pop() },
+		func(aa float64slice.Float64Slice) { aa.Reverse() },
+		func(aa float64slice.Float64Slice) { _ = aa.String() },
+		func(aa float64slice.Float64Slice) { aa.Tail() },
+		func(aa float64slice.Float64Slice) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(float64slice.Float64Slice{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(float64slice.Float64Slice){
+		func(aa float64slice.Float64Slice) { aa.Item(0) },
+		func(aa float64slice.Float64Slice) { aa.ItemFuzzy(0) },
+		func(aa float64slice.Float64Slice) { aa.RemoveAt(0) },
+		func(aa float64slice.Float64Slice) { aa.Skip(0) },
+		func(aa float64slice.Float64Slice) { aa.SplitAt(0) },
+		func(aa float64slice.Float64Slice) { aa.Take(0) },
+		func(aa float64slice.Float64Slice) { aa.Union(nil) },
+		func(aa float64slice.Float64Slice) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(float64slice.Float64Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(float64slice.Float64Slice, float64){
+		func(aa float64slice.Float64Slice, b float64) { aa.Append(b) },
+		func(aa float64slice.Float64Slice, b float64) { aa.Enqueue(b) },
+		func(aa float64slice.Float64Slice, b float64) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(float64slice.Float64Slice{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(float64slice.Float64Slice, float64slice.ConditionFn){
+		func(aa float64slice.Float64Slice, condition float64slice.ConditionFn) { aa.All(condition) },
+		func(aa float64slice.Float64Slice, condition float64slice.ConditionFn) { aa.Any(condition) },
+		func(aa float64slice.Float64Slice, condition float64slice.ConditionFn) { aa.Count(condition) },
+		func(aa float64slice.Float64Slice, condition float64slice.ConditionFn) { aa.Filter(condition) },
+		func(aa float64slice.Float64Slice, condition float64slice.ConditionFn) { aa.FindIndex(condition) },
+		func(aa float64slice.Float64Slice, condition float64slice.ConditionFn) { aa.First(condition) },
+		func(aa float64slice.Float64Slice, condition float64slice.ConditionFn) { aa.Last(condition) },
+		func(aa float64slice.Float64Slice, condition float64slice.ConditionFn) { aa.None(condition) },
+		func(aa float64slice.Float64Slice, condition float64slice.ConditionFn) { aa.Partition(condition) },
+		func(aa float64slice.Float64Slice, condition float64slice.ConditionFn) { aa.Remove(condition) },
+		func(aa float64slice.Float64Slice, condition float64slice.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa float64slice.Float64Slice, condition float64slice.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa float64slice.Float64Slice, condition float64slice.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa float64slice.Float64Slice, condition float64slice.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ float64) bool {
+				return true
+			}
+			methodCall(float64slice.Float64Slice{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(float64slice.Float64Slice){
+		func(aa float64slice.Float64Slice) {
+			aa.Distinct(func(a, b float64) bool { return true })
+		},
+		func(aa float64slice.Float64Slice) {
+			aa.Expand(func(float64) []float64 { return nil })
+		},
+		func(aa float64slice.Float64Slice) {
+			aa.ForEach(func(float64) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa float64slice.Float64Slice) {
+			aa.ForEachR(func(float64) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa float64slice.Float64Slice) {
+			aa.Group(func(float64) string { return "0" })
+		},
+		func(aa float64slice.Float64Slice) {
+			aa.GroupI(func(int64, float64) string { return "0" })
+		},
+		func(aa float64slice.Float64Slice) {
+			aa.GroupByTrait(func(a, b float64) bool { return true }, func(a, b float64) bool { return true })
+		},
+		func(aa float64slice.Float64Slice) {
+			aa.Map(func(float64) float64 { return primitiveZero })
+		},
+		func(aa float64slice.Float64Slice) {
+			aa.Reduce(func(a, b float64) float64 { return primitiveZero })
+		},
+		func(aa float64slice.Float64Slice) {
+			aa.Sort(func(a, b float64) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(float64slice.Float64Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(float64slice.Float64Slice, float64slice.EqualityFn){
+		func(aa float64slice.Float64Slice, equality float64slice.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa float64slice.Float64Slice, equality float64slice.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa float64slice.Float64Slice, equality float64slice.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa float64slice.Float64Slice, equality float64slice.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa float64slice.Float64Slice, equality float64slice.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa float64slice.Float64Slice, equality float64slice.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b float64) bool {
+				return false
+			}
+			methodCall(float64slice.Float64Slice{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(float64slice.Float64Slice, float64, float64slice.ConditionFn){
+		func(aa float64slice.Float64Slice, b float64, condition float64slice.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa float64slice.Float64Slice, b float64, condition float64slice.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(float64) bool {
+				return false
+			}
+			methodCall(float64slice.Float64Slice{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(float64slice.Float64Slice){
+		func(aa float64slice.Float64Slice) {
+			aa.ForEachC(0, func(float64, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa float64slice.Float64Slice) {
+			aa.WindowCentered(0, func([]float64) float64 { return primitiveZero })
+		},
+		func(aa float64slice.Float64Slice) {
+			aa.WindowLeft(0, func([]float64) float64 { return primitiveZero })
+		},
+		func(aa float64slice.Float64Slice) {
+			aa.WindowRight(0, func([]float64) float64 { return primitiveZero })
+		},
+		func(aa float64slice.Float64Slice) {
+			aa.Fold(primitiveZero, func(a, b float64) float64 { return primitiveZero })
+		},
+		func(aa float64slice.Float64Slice) {
+			aa.FoldI(primitiveZero, func(i int64, a, b float64) float64 { return primitiveZero })
+		},
+		func(aa float64slice.Float64Slice) {
+			aa.Pairwise(primitiveZero, func(a, b float64) float64 { return primitiveZero })
+		},
+		func(aa float64slice.Float64Slice) {
+			aa.Collect([]float64{}, func(a, b float64) float64 { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(float64slice.Float64Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(float64slice.Float64Slice){
+		func(aa float64slice.Float64Slice) { aa.InsertAt(primitiveZero, 0) },
+		func(aa float64slice.Float64Slice) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(float64slice.Float64Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
@@ -0,0 +1,202 @@
+package closures
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Equaler is implemented by values that determine for themselves whether they
+// are equal to another value of the same type.
+type Equaler interface {
+	Equal(other interface{}) bool
+}
+
+// Lesser is implemented by values that determine for themselves whether they
+// sort before another value of the same type.
+type Lesser interface {
+	Less(other interface{}) bool
+}
+
+// Comparer is implemented by values that determine for themselves their order
+// relative to another value of the same type, returning a negative number, zero
+// or a positive number as a Comparator does.
+type Comparer interface {
+	Compare(other interface{}) int
+}
+
+// AutoEquality returns an EqualityFn for values that may know how to compare
+// themselves, and that agrees with AutoLess. If a is an Equaler, a.Equal(b)
+// decides; otherwise, if a is a Comparer, a and b are equal if a.Compare(b) is
+// zero; otherwise, if a is a Lesser, they are equal if neither sorts before
+// the other. Methods named Equal, Compare and Less that take a value of a's own
+// type, such as time.Time's Equal, are used in the same way when b is of that
+// type. Values that have none of these methods are equal if they have the same
+// place in the natural order described by AutoLess, and are otherwise compared
+// with reflect.DeepEqual.
+func AutoEquality() EqualityFn {
+	return func(a, b interface{}) bool {
+		if x, ok := any(a).(Equaler); ok {
+			return x.Equal(b)
+		}
+		if x, ok := any(a).(Comparer); ok {
+			return x.Compare(b) == 0
+		}
+		if x, ok := any(a).(Lesser); ok {
+			if y, ok := any(b).(Lesser); ok {
+				return !x.Less(b) && !y.Less(a)
+			}
+		}
+		if r, ok := callSelfMethod(a, b, "Equal", boolType); ok {
+			return r.Bool()
+		}
+		if r, ok := callSelfMethod(a, b, "Compare", intType); ok {
+			return r.Int() == 0
+		}
+		if r, ok := callSelfMethod(a, b, "Less", boolType); ok {
+			s, _ := callSelfMethod(b, a, "Less", boolType)
+			return !r.Bool() && !s.Bool()
+		}
+		if c, ok := compareNatural(reflect.ValueOf(a), reflect.ValueOf(b)); ok {
+			return c == 0
+		}
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+// AutoLess returns a LessFn for values that may know how to order themselves.
+// If a is a Lesser, a.Less(b) decides; otherwise, if a is a Comparer, a sorts
+// before b if a.Compare(b) is negative. Methods named Less and Compare that
+// take a value of a's own type are used in the same way when b is of that
+// type. Other values must be of the same type, and are ordered naturally:
+// booleans false before true, numbers (NaN first, and complex numbers by their
+// real parts, then their imaginary parts) and strings as the < operator orders them,
+// time.Time values chronologically, and slices and arrays element by element,
+// a shorter slice sorting before a longer one that it begins. The LessFn
+// panics if a and b are of different types, or of a type that has no natural
+// order.
+func AutoLess() LessFn {
+	return func(a, b interface{}) bool {
+		if x, ok := any(a).(Lesser); ok {
+			return x.Less(b)
+		}
+		if x, ok := any(a).(Comparer); ok {
+			return x.Compare(b) < 0
+		}
+		if r, ok := callSelfMethod(a, b, "Less", boolType); ok {
+			return r.Bool()
+		}
+		if r, ok := callSelfMethod(a, b, "Compare", intType); ok {
+			return r.Int() < 0
+		}
+		c, ok := compareNatural(reflect.ValueOf(a), reflect.ValueOf(b))
+		if !ok {
+			panic(fmt.Sprintf("AutoLess: cannot order values of types %T and %T", a, b))
+		}
+		return c < 0
+	}
+}
+
+var (
+	boolType = reflect.TypeOf(false)
+	intType  = reflect.TypeOf(0)
+)
+
+// callSelfMethod calls a's method of the given name with b, and returns its
+// result, if a has such a method that takes a single value of a's own type and
+// returns a single value of type out, and b is of a's type. It reports whether
+// the method was called.
+func callSelfMethod(a, b interface{}, name string, out reflect.Type) (reflect.Value, bool) {
+	x, y := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !x.IsValid() || !y.IsValid() || x.Type() != y.Type() {
+		return reflect.Value{}, false
+	}
+	m := x.MethodByName(name)
+	if !m.IsValid() {
+		return reflect.Value{}, false
+	}
+	t := m.Type()
+	if t.NumIn() != 1 || t.In(0) != x.Type() || t.NumOut() != 1 || t.Out(0) != out {
+		return reflect.Value{}, false
+	}
+	return m.Call([]reflect.Value{y})[0], true
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// compareNatural returns -1, 0 or 1 as x orders before, with, or after y in
+// the natural order described by AutoLess, and whether they may be so ordered.
+func compareNatural(x, y reflect.Value) (int, bool) {
+	for x.Kind() == reflect.Interface && !x.IsNil() {
+		x = x.Elem()
+	}
+	for y.Kind() == reflect.Interface && !y.IsNil() {
+		y = y.Elem()
+	}
+	if !x.IsValid() || !y.IsValid() || x.Type() != y.Type() {
+		return 0, false
+	}
+	if c, ok := compareOrdered(x, y); ok {
+		return c, true
+	}
+	switch x.Kind() {
+	case reflect.Complex64, reflect.Complex128:
+		xc, yc := x.Complex(), y.Complex()
+		if c := compareFloat(real(xc), real(yc)); c != 0 {
+			return c, true
+		}
+		return compareFloat(imag(xc), imag(yc)), true
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < x.Len() && i < y.Len(); i++ {
+			c, ok := compareNatural(x.Index(i), y.Index(i))
+			if !ok || c != 0 {
+				return c, ok
+			}
+		}
+		return compareOrder(x.Len() < y.Len(), x.Len() > y.Len()), true
+	}
+	return 0, false
+}
+
+// compareOrdered returns -1, 0 or 1 as x orders before, with, or after y, if
+// they are booleans, integers, floating point numbers, strings or time.Time
+// values of the same type, and reports whether they are.
+func compareOrdered(x, y reflect.Value) (int, bool) {
+	switch x.Kind() {
+	case reflect.Bool:
+		return compareOrder(!x.Bool() && y.Bool(), x.Bool() && !y.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareOrder(x.Int() < y.Int(), x.Int() > y.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return compareOrder(x.Uint() < y.Uint(), x.Uint() > y.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return compareFloat(x.Float(), y.Float()), true
+	case reflect.String:
+		return compareOrder(x.String() < y.String(), x.String() > y.String()), true
+	}
+	if x.Type() == timeType {
+		xt, yt := x.Interface().(time.Time), y.Interface().(time.Time)
+		return compareOrder(xt.Before(yt), yt.Before(xt)), true
+	}
+	return 0, false
+}
+
+// compareFloat returns -1, 0 or 1 as x orders before, with, or after y, a NaN
+// ordering before any other number and with any other NaN.
+func compareFloat(x, y float64) int {
+	xNaN, yNaN := x != x, y != y
+	if xNaN || yNaN {
+		return compareOrder(xNaN && !yNaN, yNaN && !xNaN)
+	}
+	return compareOrder(x < y, x > y)
+}
+
+func compareOrder(less, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	}
+	return 0
+}
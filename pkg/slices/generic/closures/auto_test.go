@@ -0,0 +1,134 @@
+package closures_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic"
+	"github.com/ideoterra/transforms/pkg/slices/generic/closures"
+	"github.com/stretchr/testify/assert"
+)
+
+// word is a closures.Equaler and a closures.Lesser that ignores case.
+type word string
+
+func (w word) Equal(other interface{}) bool {
+	return strings.EqualFold(string(w), string(other.(word)))
+}
+
+func (w word) Less(other interface{}) bool {
+	return strings.ToLower(string(w)) < strings.ToLower(string(other.(word)))
+}
+
+// version is a closures.Comparer.
+type version struct {
+	major, minor int
+}
+
+func (v version) Compare(other interface{}) int {
+	o := other.(version)
+	if v.major != o.major {
+		return v.major - o.major
+	}
+	return v.minor - o.minor
+}
+
+// span has a Less method that takes a span, rather than an interface{}, and
+// orders spans by their length.
+type span struct {
+	lo, hi int
+}
+
+func (s span) Less(other span) bool {
+	return s.hi-s.lo < other.hi-other.lo
+}
+
+func TestAutoEquality(t *testing.T) {
+	equality := closures.AutoEquality()
+	epoch := time.Unix(0, 0)
+
+	assert.True(t, equality(word("Go"), word("GO")))
+	assert.False(t, equality(word("Go"), word("Rust")))
+	assert.True(t, equality(version{1, 2}, version{1, 2}))
+	assert.False(t, equality(version{1, 2}, version{1, 3}))
+	assert.True(t, equality([]int{1, 2}, []int{1, 2}))
+	assert.False(t, equality([]int{1, 2}, []int{2, 1}))
+	assert.True(t, equality(nil, nil))
+	assert.False(t, equality(1, int64(1)))
+	assert.True(t, equality(epoch, epoch))
+	assert.True(t, equality(span{0, 2}, span{5, 7}))
+	assert.False(t, equality(span{0, 2}, span{0, 3}))
+	assert.True(t, equality(0.0, math.Copysign(0, -1)))
+	assert.True(t, equality(math.NaN(), math.NaN()))
+	assert.False(t, equality(math.NaN(), 1.0))
+}
+
+func TestAutoEqualityAgreesWithAutoLess(t *testing.T) {
+	a := time.Date(2020, 1, 2, 3, 4, 5, 6, time.FixedZone("EST", -5*60*60))
+	equality := closures.AutoEquality()
+	less := closures.AutoLess()
+	for _, b := range []interface{}{a.Round(0), a.UTC()} {
+		assert.True(t, equality(a, b), "%v should equal %v", a, b)
+		assert.False(t, less(a, b) || less(b, a))
+	}
+	assert.False(t, equality(a, a.Add(time.Nanosecond)))
+
+	aa := []interface{}{a, a.Round(0), a.UTC()}
+	generic.DistinctAuto(&aa)
+	assert.Len(t, aa, 1)
+}
+
+func TestAutoLess(t *testing.T) {
+	epoch := time.Unix(0, 0)
+	orderings := [][]interface{}{
+		{word("apple"), word("Banana"), word("cherry")},
+		{version{1, 9}, version{1, 10}, version{2, 0}},
+		{false, true},
+		{-2, 0, 3},
+		{uint8(0), uint8(255)},
+		{-1.5, 0.0, 2.5},
+		{"", "B", "a", "ab"},
+		{complex(-1, 5), complex(1, 0), complex(1, 2)},
+		{epoch.Add(-time.Hour), epoch, epoch.Add(time.Nanosecond)},
+		{[]int{}, []int{1}, []int{1, 0}, []int{2}},
+		{[2]string{"a", "b"}, [2]string{"b", "a"}},
+		{[]interface{}{1, "a"}, []interface{}{1, "b"}, []interface{}{2}},
+		{span{5, 5}, span{0, 2}, span{1, 9}},
+	}
+
+	less := closures.AutoLess()
+	for _, sorted := range orderings {
+		for i, a := range sorted {
+			for j, b := range sorted {
+				assert.Equal(t, i < j, less(a, b), "%#v should sort before %#v: %v", a, b, i < j)
+			}
+		}
+
+		aa := append([]interface{}{}, sorted...)
+		generic.Reverse(&aa)
+		generic.SortAuto(&aa)
+		assert.Equal(t, sorted, aa)
+	}
+}
+
+func TestAutoLessOrdersNaNFirst(t *testing.T) {
+	less := closures.AutoLess()
+	assert.True(t, less(math.NaN(), math.Inf(-1)))
+	assert.False(t, less(math.Inf(-1), math.NaN()))
+	assert.False(t, less(math.NaN(), math.NaN()))
+}
+
+func TestAutoLessPanicsOnUnorderedValues(t *testing.T) {
+	less := closures.AutoLess()
+	assert.PanicsWithValue(t, "AutoLess: cannot order values of types int and int64", func() {
+		less(1, int64(2))
+	})
+	assert.PanicsWithValue(t, "AutoLess: cannot order values of types map[int]int and map[int]int", func() {
+		less(map[int]int{}, map[int]int{})
+	})
+	assert.Panics(t, func() { less(nil, nil) })
+	assert.Panics(t, func() { less(struct{}{}, struct{}{}) })
+	assert.Panics(t, func() { less([]interface{}{1}, []interface{}{"a"}) })
+}
@@ -1,5 +1,6 @@
 // Package closures contains the definitions of functions that are frequently
-// used as closures in various transformations.
+// used as closures in various transformations, along with combinators that
+// build ConditionFns from other functions (see combinators.go).
 package closures
 
 // ConditionFn determines whether or not a value meets some condition.
@@ -7,3 +8,18 @@ type ConditionFn func(interface{}) bool
 
 // EqualityFn determins whethre or not two values are equal.
 type EqualityFn func(a, b interface{}) bool
+
+// LessFn determines whether or not value a sorts before value b.
+type LessFn func(a, b interface{}) bool
+
+// SearchFn locates a value relative to those being sought within a sorted
+// slice: it returns a negative number if the value sorts before them, a
+// positive number if it sorts after them, and zero if it is one of them. The
+// values sought must therefore lie together in a sorted slice, with those for
+// which the SearchFn is negative before them and those for which it is
+// positive after them.
+type SearchFn func(interface{}) int
+
+// Hasher returns a hash of a value. Values that are equal must have the same
+// hash, while values that are not should, as far as possible, differ.
+type Hasher func(interface{}) uint64
@@ -0,0 +1,108 @@
+package closures
+
+// And returns a ConditionFn that is met if both test1 and test2 are met. test2
+// is not evaluated if test1 is not met.
+func And(test1, test2 ConditionFn) ConditionFn {
+	return func(a interface{}) bool {
+		return test1(a) && test2(a)
+	}
+}
+
+// Or returns a ConditionFn that is met if either test1 or test2 is met. test2
+// is not evaluated if test1 is met.
+func Or(test1, test2 ConditionFn) ConditionFn {
+	return func(a interface{}) bool {
+		return test1(a) || test2(a)
+	}
+}
+
+// Not returns a ConditionFn that is met if test is not.
+func Not(test ConditionFn) ConditionFn {
+	return func(a interface{}) bool {
+		return !test(a)
+	}
+}
+
+// Xor returns a ConditionFn that is met if exactly one of test1 and test2 is
+// met. Both are always evaluated, since neither result alone decides the
+// outcome.
+func Xor(test1, test2 ConditionFn) ConditionFn {
+	return func(a interface{}) bool {
+		return test1(a) != test2(a)
+	}
+}
+
+// AllOf returns a ConditionFn that is met if every one of tests is met. The
+// tests are evaluated in order, and evaluation stops at the first that is not
+// met. If there are no tests, the condition is always met.
+func AllOf(tests ...ConditionFn) ConditionFn {
+	return func(a interface{}) bool {
+		for _, test := range tests {
+			if !test(a) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// AnyOf returns a ConditionFn that is met if any one of tests is met. The
+// tests are evaluated in order, and evaluation stops at the first that is met.
+// If there are no tests, the condition is never met.
+func AnyOf(tests ...ConditionFn) ConditionFn {
+	return func(a interface{}) bool {
+		for _, test := range tests {
+			if test(a) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Always returns a ConditionFn that is met by every value.
+func Always() ConditionFn {
+	return func(interface{}) bool {
+		return true
+	}
+}
+
+// Never returns a ConditionFn that is met by no value.
+func Never() ConditionFn {
+	return func(interface{}) bool {
+		return false
+	}
+}
+
+// Equals returns a ConditionFn that is met by values equal to v, according to
+// equality.
+func Equals(v interface{}, equality EqualityFn) ConditionFn {
+	return func(a interface{}) bool {
+		return equality(a, v)
+	}
+}
+
+// In returns a ConditionFn that is met by values equal, according to equality,
+// to any of values. The values are compared in order, and comparison stops at
+// the first that is equal. The values are copied, so later changes to the
+// supplied slice do not affect the condition.
+func In(values []interface{}, equality EqualityFn) ConditionFn {
+	values = append([]interface{}{}, values...)
+	return func(a interface{}) bool {
+		for _, v := range values {
+			if equality(a, v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Between returns a ConditionFn that is met by values from lo to hi
+// inclusive, as ordered by less. hi is not compared if a value sorts before
+// lo.
+func Between(lo, hi interface{}, less LessFn) ConditionFn {
+	return func(a interface{}) bool {
+		return !less(a, lo) && !less(hi, a)
+	}
+}
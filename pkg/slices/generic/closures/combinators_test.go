@@ -0,0 +1,122 @@
+package closures_test
+
+import (
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic"
+	"github.com/ideoterra/transforms/pkg/slices/generic/closures"
+	"github.com/stretchr/testify/assert"
+)
+
+func equal(a, b interface{}) bool { return a == b }
+
+func less(a, b interface{}) bool { return a.(int) < b.(int) }
+
+func even(a interface{}) bool { return a.(int)%2 == 0 }
+
+func positive(a interface{}) bool { return a.(int) > 0 }
+
+// counted returns test, and a pointer to the number of times it is called.
+func counted(test closures.ConditionFn) (closures.ConditionFn, *int) {
+	calls := 0
+	return func(a interface{}) bool {
+		calls++
+		return test(a)
+	}, &calls
+}
+
+func TestCombinators(t *testing.T) {
+	type testCase struct {
+		name string
+		test closures.ConditionFn
+		met  []interface{}
+	}
+	values := []interface{}{-2, -1, 0, 1, 2, 3}
+	testCases := []testCase{
+		{"And", closures.And(even, positive), []interface{}{2}},
+		{"Or", closures.Or(even, positive), []interface{}{-2, 0, 1, 2, 3}},
+		{"Not", closures.Not(even), []interface{}{-1, 1, 3}},
+		{"Xor", closures.Xor(even, positive), []interface{}{-2, 0, 1, 3}},
+		{"AllOf", closures.AllOf(even, positive, closures.Equals(2, equal)), []interface{}{2}},
+		{"AllOf()", closures.AllOf(), values},
+		{"AnyOf", closures.AnyOf(closures.Equals(-1, equal), closures.Equals(3, equal)), []interface{}{-1, 3}},
+		{"AnyOf()", closures.AnyOf(), []interface{}{}},
+		{"Always", closures.Always(), values},
+		{"Never", closures.Never(), []interface{}{}},
+		{"Equals", closures.Equals(0, equal), []interface{}{0}},
+		{"In", closures.In([]interface{}{3, -2, 7}, equal), []interface{}{-2, 3}},
+		{"In(nil)", closures.In(nil, equal), []interface{}{}},
+		{"Between", closures.Between(-1, 1, less), []interface{}{-1, 0, 1}},
+		{"Between(empty)", closures.Between(1, -1, less), []interface{}{}},
+	}
+	for _, tc := range testCases {
+		met := []interface{}{}
+		for _, v := range values {
+			if tc.test(v) {
+				met = append(met, v)
+			}
+		}
+		assert.Equal(t, tc.met, met, tc.name)
+	}
+}
+
+func TestCombinatorsShortCircuit(t *testing.T) {
+	type testCase struct {
+		name  string
+		build func(test1, test2 closures.ConditionFn) closures.ConditionFn
+		calls [2]int
+	}
+	testCases := []testCase{
+		{"And", closures.And, [2]int{1, 0}},
+		{"Or", closures.Or, [2]int{1, 1}},
+		{"AllOf", func(t1, t2 closures.ConditionFn) closures.ConditionFn { return closures.AllOf(t1, t2) }, [2]int{1, 0}},
+		{"AnyOf", func(t1, t2 closures.ConditionFn) closures.ConditionFn { return closures.AnyOf(t1, t2) }, [2]int{1, 1}},
+		{"Xor", closures.Xor, [2]int{1, 1}},
+	}
+	for _, tc := range testCases {
+		// test1 is not met by 1, so And and AllOf need not evaluate test2, and
+		// Or and AnyOf must.
+		test1, calls1 := counted(even)
+		test2, calls2 := counted(positive)
+		tc.build(test1, test2)(1)
+		assert.Equal(t, tc.calls, [2]int{*calls1, *calls2}, tc.name)
+	}
+
+	test1, calls1 := counted(even)
+	test2, calls2 := counted(positive)
+	closures.Or(test1, test2)(2)
+	assert.Equal(t, [2]int{1, 0}, [2]int{*calls1, *calls2}, "Or stops at a met condition")
+
+	lessCalls := 0
+	countedLess := func(a, b interface{}) bool {
+		lessCalls++
+		return less(a, b)
+	}
+	closures.Between(0, 10, countedLess)(-5)
+	assert.Equal(t, 1, lessCalls, "Between stops below lo")
+}
+
+func TestInCopiesValues(t *testing.T) {
+	values := []interface{}{1, 2}
+	in := closures.In(values, equal)
+	values[0] = 3
+	assert.True(t, in(1))
+	assert.False(t, in(3))
+}
+
+func TestCombinatorsWithTransforms(t *testing.T) {
+	aa := []interface{}{1, 2, 3, 4, 5, 6}
+	generic.Filter(&aa, closures.Or(closures.In([]interface{}{1, 6}, equal), closures.Not(even)))
+	assert.Equal(t, []interface{}{2, 4}, aa)
+
+	bb := generic.SliceType{1, 2, 3, 4, 5, 6}
+	bb.Remove(closures.Between(2, 4, less))
+	assert.Equal(t, generic.SliceType{1, 5, 6}, bb)
+
+	cc := generic.SliceType{2, 4, 5, 6}
+	cc.TakeWhile(closures.AllOf(even, positive))
+	assert.Equal(t, generic.SliceType{2, 4}, cc)
+
+	parts := generic.Partition([]interface{}{1, 2, 3, 4}, closures.And(even, closures.Not(closures.Equals(4, equal))))
+	assert.ElementsMatch(t, generic.SliceType2{[]interface{}{2}, []interface{}{1, 3, 4}}, parts)
+}
@@ -0,0 +1,152 @@
+package closures
+
+import "reflect"
+
+// Comparator determines the order of two values: it returns a negative number
+// if a sorts before b, a positive number if a sorts after b, and zero if
+// neither sorts before the other. Comparators are composed from keys with By
+// and ThenBy, and converted for use with transforms such as Sort and Distinct
+// with Less and Equality, so that one ordering may serve them all.
+type Comparator func(a, b interface{}) int
+
+// Compare returns a Comparator that orders values as less does.
+func Compare(less LessFn) Comparator {
+	return func(a, b interface{}) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		}
+		return 0
+	}
+}
+
+// Seek returns a SearchFn that seeks the values that compare equal to target
+// in a slice sorted by compare.
+func Seek(target interface{}, compare Comparator) SearchFn {
+	return func(a interface{}) int {
+		return compare(a, target)
+	}
+}
+
+// SeekBetween returns a SearchFn that seeks the values from lo to hi,
+// inclusive, in a slice sorted by compare. It is the SearchFn counterpart of
+// Between.
+func SeekBetween(lo, hi interface{}, compare Comparator) SearchFn {
+	return func(a interface{}) int {
+		if compare(a, lo) < 0 {
+			return -1
+		}
+		if compare(a, hi) > 0 {
+			return 1
+		}
+		return 0
+	}
+}
+
+// By returns a Comparator that orders values by the key that key extracts
+// from each, as the keys are ordered by less. Keys may be of any type, so
+// customers might be ordered by name, then by date of birth, with
+//
+//	By(name, less.String()).ThenBy(birthDate, less.Time())
+//
+// less sees only keys, so a key that may be nil calls for a less function that
+// orders nil, such as less.Mixed; NilsFirst and NilsLast apply to the values
+// themselves.
+func By(key func(interface{}) any, less func(a, b any) bool) Comparator {
+	return func(a, b interface{}) int {
+		x, y := key(a), key(b)
+		switch {
+		case less(x, y):
+			return -1
+		case less(y, x):
+			return 1
+		}
+		return 0
+	}
+}
+
+// ThenBy returns a Comparator that orders values as c does, and orders those
+// that c does not by key and less, as By does.
+func (c Comparator) ThenBy(key func(interface{}) any, less func(a, b any) bool) Comparator {
+	return c.Then(By(key, less))
+}
+
+// Then returns a Comparator that orders values as c does, and orders those
+// that c does not as next does. next is not called for values that c orders.
+func (c Comparator) Then(next Comparator) Comparator {
+	return func(a, b interface{}) int {
+		if result := c(a, b); result != 0 {
+			return result
+		}
+		return next(a, b)
+	}
+}
+
+// Reverse returns a Comparator that orders values in the reverse of the order
+// given by c.
+func (c Comparator) Reverse() Comparator {
+	return func(a, b interface{}) int {
+		return c(b, a)
+	}
+}
+
+// NilsFirst returns a Comparator that orders nil values (including nil
+// pointers, maps, slices, channels and functions) before all others, and
+// orders the others as c does. c is not called with a nil value.
+func (c Comparator) NilsFirst() Comparator {
+	return c.nils(-1)
+}
+
+// NilsLast returns a Comparator that orders nil values (including nil
+// pointers, maps, slices, channels and functions) after all others, and orders
+// the others as c does. c is not called with a nil value.
+func (c Comparator) NilsLast() Comparator {
+	return c.nils(1)
+}
+
+// nils returns a Comparator that orders nil values before others if order is
+// negative, and after them otherwise.
+func (c Comparator) nils(order int) Comparator {
+	return func(a, b interface{}) int {
+		aNil, bNil := isNil(a), isNil(b)
+		switch {
+		case aNil && bNil:
+			return 0
+		case aNil:
+			return order
+		case bNil:
+			return -order
+		}
+		return c(a, b)
+	}
+}
+
+// Less returns a LessFn that reports whether c orders a before b.
+func (c Comparator) Less() LessFn {
+	return func(a, b interface{}) bool {
+		return c(a, b) < 0
+	}
+}
+
+// Equality returns an EqualityFn that reports whether c orders a and b
+// together.
+func (c Comparator) Equality() EqualityFn {
+	return func(a, b interface{}) bool {
+		return c(a, b) == 0
+	}
+}
+
+// isNil reports whether v is nil, or holds a nil value of a type that may be
+// nil.
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func, reflect.Interface:
+		return rv.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,119 @@
+package closures_test
+
+import (
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic"
+	"github.com/ideoterra/transforms/pkg/slices/generic/closures"
+	"github.com/stretchr/testify/assert"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+func name(a interface{}) any { return a.(*person).name }
+
+func age(a interface{}) any { return a.(*person).age }
+
+func lessAny(a, b any) bool {
+	switch a := a.(type) {
+	case int:
+		return a < b.(int)
+	default:
+		return a.(string) < b.(string)
+	}
+}
+
+func TestComparator(t *testing.T) {
+	ann30 := &person{"ann", 30}
+	bob25 := &person{"bob", 25}
+	bob40 := &person{"bob", 40}
+	cat25 := &person{"cat", 25}
+	var nobody *person
+
+	type testCase struct {
+		name       string
+		comparator closures.Comparator
+		sorted     []interface{}
+	}
+	testCases := []testCase{
+		{"Compare", closures.Compare(less), []interface{}{-1, 0, 2}},
+		{"Compare.Reverse", closures.Compare(less).Reverse(), []interface{}{2, 0, -1}},
+		{"By", closures.By(name, lessAny), []interface{}{ann30, bob25, cat25}},
+		{"By.ThenBy", closures.By(name, lessAny).ThenBy(age, lessAny), []interface{}{ann30, bob25, bob40, cat25}},
+		{"By.ThenBy.Reverse", closures.By(age, lessAny).ThenBy(name, lessAny).Reverse(), []interface{}{bob40, ann30, cat25, bob25}},
+		{"By.Reverse.ThenBy", closures.By(age, lessAny).Reverse().ThenBy(name, lessAny), []interface{}{bob40, ann30, bob25, cat25}},
+		{"NilsFirst", closures.By(age, lessAny).NilsFirst(), []interface{}{nil, ann30, bob40}},
+		{"NilsLast", closures.By(age, lessAny).NilsLast(), []interface{}{ann30, bob40, nobody}},
+		{"NilsLast.Reverse", closures.By(age, lessAny).NilsLast().Reverse(), []interface{}{nobody, bob40, ann30}},
+	}
+	for _, tc := range testCases {
+		for i, a := range tc.sorted {
+			for j, b := range tc.sorted {
+				c := tc.comparator(a, b)
+				switch {
+				case i < j:
+					assert.True(t, c < 0, "%v: %v should sort before %v", tc.name, i, j)
+				case i > j:
+					assert.True(t, c > 0, "%v: %v should sort after %v", tc.name, i, j)
+				default:
+					assert.Zero(t, c, "%v: %v should sort with itself", tc.name, i)
+				}
+			}
+		}
+
+		aa := append([]interface{}{}, tc.sorted...)
+		generic.Reverse(&aa)
+		generic.Sort(&aa, tc.comparator.Less())
+		assert.Equal(t, tc.sorted, aa, tc.name)
+	}
+
+	assert.Zero(t, closures.By(name, lessAny).NilsFirst()(nobody, nil), "nils sort together")
+}
+
+func TestComparatorThenIsLazy(t *testing.T) {
+	calls := 0
+	next := func(a, b interface{}) int {
+		calls++
+		return 0
+	}
+	c := closures.Compare(less).Then(next)
+	assert.Equal(t, -1, c(1, 2))
+	assert.Equal(t, 0, calls)
+	assert.Equal(t, 0, c(1, 1))
+	assert.Equal(t, 1, calls)
+}
+
+func TestComparatorAdapters(t *testing.T) {
+	byName := closures.By(name, lessAny)
+	people := []interface{}{&person{"bob", 25}, &person{"ann", 30}, &person{"bob", 40}}
+
+	generic.Sort(&people, byName.Less())
+	assert.Equal(t, []interface{}{&person{"ann", 30}, &person{"bob", 25}, &person{"bob", 40}}, people)
+
+	generic.Distinct(&people, byName.Equality())
+	assert.Equal(t, []interface{}{&person{"ann", 30}, &person{"bob", 25}}, people)
+
+	others := []interface{}{&person{"bob", 99}, &person{"dan", 20}}
+	assert.Equal(t, []interface{}{&person{"bob", 25}}, generic.Intersection(people, others, byName.Equality()))
+}
+
+func TestSeek(t *testing.T) {
+	byAge := closures.By(age, lessAny)
+	people := []interface{}{&person{"ann", 20}, &person{"bob", 30}, &person{"cat", 30}, &person{"dan", 45}}
+
+	thirty := closures.Seek(&person{age: 30}, byAge)
+	assert.Equal(t, -1, thirty(people[0]))
+	assert.Equal(t, 0, thirty(people[1]))
+	assert.Equal(t, 1, thirty(people[3]))
+	assert.Equal(t, []interface{}{&person{"bob", 30}}, generic.FirstS(people, thirty))
+	assert.Equal(t, []interface{}{&person{"cat", 30}}, generic.LastS(people, thirty))
+
+	thirties := closures.SeekBetween(&person{age: 30}, &person{age: 39}, byAge)
+	assert.Equal(t, int64(2), generic.CountS(people, thirties))
+	assert.Equal(t, int64(1), generic.FindIndexS(people, thirties))
+	assert.Equal(t, -1, thirties(people[0]))
+	assert.Equal(t, 1, thirties(people[3]))
+}
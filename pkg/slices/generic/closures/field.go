@@ -0,0 +1,187 @@
+package closures
+
+import (
+	"fmt"
+	"go/token"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Field returns a function that gets the field named by path from a struct,
+// for use as a key with By, or with Map. path names a field of the struct, or a
+// field of one of its fields, and so on, joined by dots (Customer.ID, for
+// example). Pointers and interfaces are followed, both to the struct and along
+// the path, as are embedded fields.
+//
+// Field panics if path is not a valid path. The function it returns panics if
+// the value has no such field, if the field is unexported, or if a pointer or
+// interface along the path is nil. Fields are found by name once for each
+// type, and by index thereafter.
+//
+// Since they read fields by reflection, Field and the closures built on it
+// suit only slices of structs, or of pointers or interfaces holding them. The
+// generator leaves them out of typed slice packages, whose elements' fields
+// may be read directly.
+func Field(path string) func(interface{}) any {
+	f := newFieldPath("closures.Field", path)
+	return func(a interface{}) any {
+		return f.value(a).Interface()
+	}
+}
+
+// FieldEquals returns a ConditionFn that is met by structs whose field, named
+// by path as for Field, is equal to value, as reflect.DeepEqual determines. If
+// value is of a different type of the same kind as the field (the string
+// "open" for a field of type Status string, for example), it is converted to
+// the field's type before they are compared. FieldEquals panics as Field does.
+func FieldEquals(path string, value any) ConditionFn {
+	f := newFieldPath("closures.FieldEquals", path)
+	want := reflect.ValueOf(value)
+	return func(a interface{}) bool {
+		v := f.value(a)
+		if !want.IsValid() {
+			return isNil(v.Interface())
+		}
+		w := want
+		if w.Type() != v.Type() && w.Kind() == v.Kind() && w.Type().ConvertibleTo(v.Type()) {
+			w = w.Convert(v.Type())
+		}
+		return reflect.DeepEqual(v.Interface(), w.Interface())
+	}
+}
+
+// FieldLess returns a LessFn that orders structs by their field named by path,
+// as for Field. The field must be a boolean (false before true), a number (NaN
+// before any other), a string or a time.Time, ordered as AutoLess orders them.
+// FieldLess panics as Field does, and if the field is not of an ordered type.
+func FieldLess(path string) LessFn {
+	f := newFieldPath("closures.FieldLess", path)
+	return func(a, b interface{}) bool {
+		return f.compare(f.value(a), f.value(b)) < 0
+	}
+}
+
+// FieldGroup returns a grouper, for use with Group, that groups structs by
+// their field named by path, as for Field. Each group is keyed by the fmt.Sprint
+// representation of the field's value. FieldGroup panics as Field does.
+func FieldGroup(path string) func(interface{}) string {
+	f := newFieldPath("closures.FieldGroup", path)
+	return func(a interface{}) string {
+		return fmt.Sprint(f.value(a).Interface())
+	}
+}
+
+// fieldPath is a parsed field path, along with the indexes of the fields that
+// it names within each struct type encountered so far.
+type fieldPath struct {
+	caller string // the function that parsed the path, for use in panics
+	path   string
+	names  []string
+	cache  sync.Map // fieldKey to []int
+}
+
+// fieldKey identifies a field by its position in a path, and the type of the
+// struct that holds it.
+type fieldKey struct {
+	step int
+	t    reflect.Type
+}
+
+// newFieldPath parses path, panicking if it is not a dot separated list of
+// identifiers.
+func newFieldPath(caller, path string) *fieldPath {
+	f := &fieldPath{caller: caller, path: path, names: strings.Split(path, ".")}
+	for _, name := range f.names {
+		if !token.IsIdentifier(name) {
+			f.panicf("invalid field path")
+		}
+	}
+	return f
+}
+
+// value returns the field of a named by the path.
+func (f *fieldPath) value(a interface{}) reflect.Value {
+	v := reflect.ValueOf(a)
+	for step, name := range f.names {
+		v = f.indirect(v, step)
+		if v.Kind() != reflect.Struct {
+			f.panicf("%v is a %v, not a struct", f.describe(step), v.Type())
+		}
+		index := f.index(v.Type(), step)
+		field, err := v.FieldByIndexErr(index)
+		if err != nil {
+			f.panicf("%v.%v is reached through a nil embedded pointer", f.describe(step), name)
+		}
+		v = field
+	}
+	return v
+}
+
+// indirect follows any pointers and interfaces from v, which is reached by the
+// first step names of the path.
+func (f *fieldPath) indirect(v reflect.Value, step int) reflect.Value {
+	for {
+		switch v.Kind() {
+		case reflect.Invalid:
+			f.panicf("%v is nil", f.describe(step))
+		case reflect.Ptr, reflect.Interface:
+			if v.IsNil() {
+				f.panicf("%v is nil", f.describe(step))
+			}
+			v = v.Elem()
+		default:
+			return v
+		}
+	}
+}
+
+// index returns the index of the field named by the step of the path within
+// the struct type t.
+func (f *fieldPath) index(t reflect.Type, step int) []int {
+	key := fieldKey{step, t}
+	if index, ok := f.cache.Load(key); ok {
+		return index.([]int)
+	}
+	field, ok := t.FieldByName(f.names[step])
+	if !ok {
+		f.panicf("%v has no field %v", t, f.names[step])
+	}
+	if field.PkgPath != "" {
+		f.panicf("field %v of %v is unexported", f.names[step], t)
+	}
+	f.cache.Store(key, field.Index)
+	return field.Index
+}
+
+// compare returns -1, 0 or 1 as the field x orders before, with, or after the
+// field y. Fields of interface type are compared by their dynamic values.
+func (f *fieldPath) compare(x, y reflect.Value) int {
+	for x.Kind() == reflect.Interface && !x.IsNil() {
+		x = x.Elem()
+	}
+	for y.Kind() == reflect.Interface && !y.IsNil() {
+		y = y.Elem()
+	}
+	if x.Type() != y.Type() {
+		f.panicf("cannot order values of types %v and %v", x.Type(), y.Type())
+	}
+	if c, ok := compareOrdered(x, y); ok {
+		return c
+	}
+	f.panicf("cannot order values of type %v", x.Type())
+	return 0
+}
+
+// describe returns a description of the value reached by the first step names
+// of the path, for use in panics.
+func (f *fieldPath) describe(step int) string {
+	if step == 0 {
+		return "the value"
+	}
+	return strings.Join(f.names[:step], ".")
+}
+
+func (f *fieldPath) panicf(format string, args ...any) {
+	panic(fmt.Sprintf("%v(%q): ", f.caller, f.path) + fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,128 @@
+package closures_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic"
+	"github.com/ideoterra/transforms/pkg/slices/generic/closures"
+	"github.com/stretchr/testify/assert"
+)
+
+type status string
+
+type customer struct {
+	ID     int
+	Region string
+}
+
+type Audit struct {
+	CreatedAt time.Time
+}
+
+type order struct {
+	*Audit
+	Customer *customer
+	Status   status
+	Total    float64
+	Tags     []string
+	Note     interface{}
+	secret   int
+}
+
+func TestField(t *testing.T) {
+	o := &order{Customer: &customer{ID: 7}, Status: "open", Note: customer{ID: 8}}
+	assert.Equal(t, 7, closures.Field("Customer.ID")(o))
+	assert.Equal(t, 7, closures.Field("Customer.ID")(*o), "values as well as pointers")
+	assert.Equal(t, status("open"), closures.Field("Status")(o))
+	assert.Equal(t, 8, closures.Field("Note.ID")(o), "interfaces are followed")
+
+	id := closures.Field("Customer.ID")
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, i, id(order{Customer: &customer{ID: i}}), "lookups are cached per type")
+	}
+	assert.Equal(t, 1, id(struct{ Customer customer }{customer{ID: 1}}), "the cache is keyed by type")
+}
+
+func TestFieldPanics(t *testing.T) {
+	type testCase struct {
+		name    string
+		fn      func()
+		message string
+	}
+	now := time.Now()
+	testCases := []testCase{
+		{"invalid path", func() { closures.Field("Customer..ID") }, `closures.Field("Customer..ID"): invalid field path`},
+		{"empty path", func() { closures.FieldLess("") }, `closures.FieldLess(""): invalid field path`},
+		{"missing field", func() { closures.Field("Customer.Name")(order{Customer: &customer{}}) }, `closures.Field("Customer.Name"): closures_test.customer has no field Name`},
+		{"unexported field", func() { closures.Field("secret")(order{}) }, `closures.Field("secret"): field secret of closures_test.order is unexported`},
+		{"nil value", func() { closures.Field("Status")(nil) }, `closures.Field("Status"): the value is nil`},
+		{"nil pointer", func() { closures.FieldEquals("Customer.ID", 1)(order{}) }, `closures.FieldEquals("Customer.ID"): Customer is nil`},
+		{"not a struct", func() { closures.FieldGroup("Status.Code")(order{}) }, `closures.FieldGroup("Status.Code"): Status is a closures_test.status, not a struct`},
+		{"nil embedded pointer", func() { closures.Field("CreatedAt")(order{}) }, `closures.Field("CreatedAt"): the value.CreatedAt is reached through a nil embedded pointer`},
+		{"unordered field", func() { closures.FieldLess("Tags")(order{}, order{}) }, `closures.FieldLess("Tags"): cannot order values of type []string`},
+		{"mixed types", func() { closures.FieldLess("Note")(order{Note: now}, order{Note: 1}) }, `closures.FieldLess("Note"): cannot order values of types time.Time and int`},
+	}
+	for _, tc := range testCases {
+		assert.PanicsWithValue(t, tc.message, tc.fn, tc.name)
+	}
+}
+
+func TestFieldEquals(t *testing.T) {
+	open := closures.FieldEquals("Status", "open")
+	assert.True(t, open(order{Status: "open"}), "the value is converted to the field's type")
+	assert.False(t, open(&order{Status: "closed"}))
+
+	assert.True(t, closures.FieldEquals("Tags", []string{"a"})(order{Tags: []string{"a"}}))
+	assert.True(t, closures.FieldEquals("Customer", nil)(order{}))
+	assert.False(t, closures.FieldEquals("Customer", nil)(order{Customer: &customer{}}))
+	assert.False(t, closures.FieldEquals("Total", 1)(order{Total: 1}), "an int is not converted to a float64")
+
+	orders := []interface{}{order{Status: "open"}, order{Status: "closed"}, order{Status: "open"}}
+	generic.Filter(&orders, closures.Not(open))
+	assert.Equal(t, []interface{}{order{Status: "open"}, order{Status: "open"}}, orders)
+}
+
+func TestFieldLess(t *testing.T) {
+	epoch := time.Unix(0, 0)
+	orders := []interface{}{
+		&order{Audit: &Audit{epoch.Add(time.Hour)}, Total: 2},
+		&order{Audit: &Audit{epoch}, Total: 3},
+		&order{Audit: &Audit{epoch.Add(time.Minute)}, Total: 1},
+	}
+
+	generic.Sort(&orders, closures.FieldLess("CreatedAt"))
+	assert.Equal(t, []interface{}{3.0, 1.0, 2.0}, generic.Map(orders, func(a interface{}) interface{} { return a.(*order).Total }))
+
+	generic.Sort(&orders, closures.FieldLess("Total"))
+	assert.Equal(t, []interface{}{1.0, 2.0, 3.0}, generic.Map(orders, func(a interface{}) interface{} { return a.(*order).Total }))
+
+	nan := math.NaN()
+	totals := []interface{}{order{Total: 1}, order{Total: nan}, order{Total: 0}}
+	generic.Sort(&totals, closures.FieldLess("Total"))
+	assert.True(t, math.IsNaN(totals[0].(order).Total), "NaN orders first, as with AutoLess")
+	assert.Equal(t, []interface{}{0.0, 1.0}, generic.Map(totals[1:], closures.Field("Total")))
+
+	byRegion := closures.By(closures.Field("Customer.Region"), lessAny).Then(closures.Compare(closures.FieldLess("Customer.ID")))
+	customers := []interface{}{
+		order{Customer: &customer{2, "west"}},
+		order{Customer: &customer{3, "east"}},
+		order{Customer: &customer{1, "west"}},
+	}
+	generic.Sort(&customers, byRegion.Less())
+	assert.Equal(t, []interface{}{3, 1, 2}, generic.Map(customers, closures.Field("Customer.ID")))
+}
+
+func TestFieldGroup(t *testing.T) {
+	orders := []interface{}{
+		order{Customer: &customer{1, "west"}},
+		order{Customer: &customer{2, "east"}},
+		order{Customer: &customer{3, "west"}},
+	}
+	groups := generic.Group(orders, closures.FieldGroup("Customer.Region"))
+	assert.ElementsMatch(t, generic.SliceType2{
+		[]interface{}{orders[0], orders[2]},
+		[]interface{}{orders[1]},
+	}, groups)
+}
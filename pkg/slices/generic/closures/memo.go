@@ -0,0 +1,127 @@
+package closures
+
+import (
+	"container/list"
+	"sync"
+)
+
+// MemoizeCondition returns a ConditionFn that is met as test is, but that
+// remembers the result of test for each value, keyed by the hash of the value
+// given by hasher, and does not call test again for a value of the same hash.
+// Values of the same hash are taken to meet the condition alike, so the hasher
+// must distinguish every pair of values that test might not.
+//
+// If capacity is positive, only the results for the capacity most recently
+// used hashes are remembered; otherwise every result is. The ConditionFn may
+// be called concurrently, as by ForEachC, though test may then be called more
+// than once for the same hash.
+func MemoizeCondition(test ConditionFn, hasher Hasher, capacity int) ConditionFn {
+	m := newMemo(capacity)
+	return func(a interface{}) bool {
+		key := memoKey{hasher(a), 0}
+		if result, ok := m.get(key); ok {
+			return result.met
+		}
+		met := test(a)
+		m.put(key, memoResult{met: met})
+		return met
+	}
+}
+
+// MemoizeEquality returns an EqualityFn that compares values as equality does,
+// but that remembers the result of equality for each pair of values, keyed by
+// their hashes, as MemoizeCondition does. Each ordered pair is remembered
+// separately, so equality need not be symmetric.
+func MemoizeEquality(equality EqualityFn, hasher Hasher, capacity int) EqualityFn {
+	m := newMemo(capacity)
+	return func(a, b interface{}) bool {
+		key := memoKey{hasher(a), hasher(b)}
+		if result, ok := m.get(key); ok {
+			return result.met
+		}
+		met := equality(a, b)
+		m.put(key, memoResult{met: met})
+		return met
+	}
+}
+
+// MemoizeMap returns a function, for use with Map or Apply, that maps values
+// as fn does, but that remembers the result of fn for each value, keyed by
+// its hash, as MemoizeCondition does. The same result is returned each time,
+// so a result that refers to mutable data is shared by every value of the
+// same hash.
+func MemoizeMap(fn func(interface{}) interface{}, hasher Hasher, capacity int) func(interface{}) interface{} {
+	m := newMemo(capacity)
+	return func(a interface{}) interface{} {
+		key := memoKey{hasher(a), 0}
+		if result, ok := m.get(key); ok {
+			return result.mapped
+		}
+		mapped := fn(a)
+		m.put(key, memoResult{mapped: mapped})
+		return mapped
+	}
+}
+
+// memo holds the results of a function, keyed by the hashes of its arguments.
+// If capacity is positive, the least recently used result is discarded
+// whenever more than capacity are held.
+type memo struct {
+	mu       sync.Mutex
+	capacity int
+	results  map[memoKey]*list.Element
+	recency  *list.List // of *memoEntry, the most recently used first
+}
+
+// memoKey holds the hashes of the arguments of a function of one or two
+// arguments.
+type memoKey struct {
+	a, b uint64
+}
+
+// memoResult holds the result of a ConditionFn or EqualityFn, as met, or of
+// a mapping function, as mapped.
+type memoResult struct {
+	met    bool
+	mapped interface{}
+}
+
+type memoEntry struct {
+	key    memoKey
+	result memoResult
+}
+
+func newMemo(capacity int) *memo {
+	return &memo{capacity: capacity, results: map[memoKey]*list.Element{}, recency: list.New()}
+}
+
+// get returns the result held for key, if any, and marks it as the most
+// recently used.
+func (m *memo) get(key memoKey) (memoResult, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.results[key]
+	if !ok {
+		return memoResult{}, false
+	}
+	m.recency.MoveToFront(e)
+	return e.Value.(*memoEntry).result, true
+}
+
+// put holds result for key, as the most recently used, discarding the least
+// recently used result if the memo is then over capacity.
+func (m *memo) put(key memoKey, result memoResult) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if e, ok := m.results[key]; ok {
+		e.Value.(*memoEntry).result = result
+		m.recency.MoveToFront(e)
+		return
+	}
+	m.results[key] = m.recency.PushFront(&memoEntry{key, result})
+	if m.capacity > 0 && m.recency.Len() > m.capacity {
+		oldest := m.recency.Back()
+		m.recency.Remove(oldest)
+		delete(m.results, oldest.Value.(*memoEntry).key)
+	}
+}
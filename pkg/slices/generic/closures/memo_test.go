@@ -0,0 +1,103 @@
+package closures_test
+
+import (
+	"hash/fnv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic"
+	"github.com/ideoterra/transforms/pkg/slices/generic/closures"
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+// intHash hashes ints as themselves.
+func intHash(a interface{}) uint64 {
+	return uint64(a.(int))
+}
+
+// foldHash hashes strings without regard to case.
+func foldHash(a interface{}) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(strings.ToLower(a.(string))))
+	return h.Sum64()
+}
+
+func TestMemoizeCondition(t *testing.T) {
+	calls := 0
+	even := closures.MemoizeCondition(func(a interface{}) bool {
+		calls++
+		return a.(int)%2 == 0
+	}, intHash, 0)
+	for _, a := range []int{1, 2, 3, 2, 1, 4, 4} {
+		assert.Equal(t, a%2 == 0, even(a))
+	}
+	assert.Equal(t, 4, calls)
+}
+
+func TestMemoizeConditionCapacity(t *testing.T) {
+	var called []int
+	even := closures.MemoizeCondition(func(a interface{}) bool {
+		called = append(called, a.(int))
+		return a.(int)%2 == 0
+	}, intHash, 2)
+	for _, a := range []int{1, 2, 1, 3, 1, 2} {
+		even(a)
+	}
+	// 3 displaces 2, the least recently used, so 2 is tested again.
+	assert.Equal(t, []int{1, 2, 3, 2}, called)
+}
+
+func TestMemoizeEquality(t *testing.T) {
+	calls := 0
+	equality := closures.MemoizeEquality(func(a, b interface{}) bool {
+		calls++
+		return strings.EqualFold(a.(string), b.(string))
+	}, foldHash, 0)
+	aa := []interface{}{"a", "A", "b", "a", "B", "a", "A"}
+	generic.Distinct(&aa, equality)
+	assert.Equal(t, []interface{}{"a", "b"}, aa)
+	uncached := 0
+	bb := []interface{}{"a", "A", "b", "a", "B", "a", "A"}
+	generic.Distinct(&bb, func(a, b interface{}) bool {
+		uncached++
+		return strings.EqualFold(a.(string), b.(string))
+	})
+	assert.True(t, calls < uncached)
+
+	assert.True(t, equality("b", "B"))
+	assert.False(t, equality("B", "a"))
+}
+
+func TestMemoizeMap(t *testing.T) {
+	calls := 0
+	square := closures.MemoizeMap(func(a interface{}) interface{} {
+		calls++
+		return a.(int) * a.(int)
+	}, intHash, 0)
+	squares := generic.Map([]interface{}{3, 1, 3, 2, 1}, square)
+	assert.Equal(t, []interface{}{9, 1, 9, 4, 1}, squares)
+	assert.Equal(t, 3, calls)
+}
+
+func TestMemoizeConcurrently(t *testing.T) {
+	calls := int64(0)
+	even := closures.MemoizeCondition(func(a interface{}) bool {
+		atomic.AddInt64(&calls, 1)
+		return a.(int)%2 == 0
+	}, intHash, 4)
+	aa := []interface{}{}
+	for i := 0; i < 1000; i++ {
+		aa = append(aa, i%10)
+	}
+	evens := int64(0)
+	generic.ForEachC(aa, 8, func(a interface{}, cancelPending func() bool) shared.Continue {
+		if even(a) {
+			atomic.AddInt64(&evens, 1)
+		}
+		return shared.ContinueYes
+	})
+	assert.Equal(t, int64(500), evens)
+	assert.True(t, atomic.LoadInt64(&calls) >= 10)
+}
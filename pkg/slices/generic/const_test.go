@@ -1,9 +1,19 @@
 package generic_test
 
-// Constants used by tests
+// Values used by tests
+
+// primitiveZero stands in for the zero value of the element type. When tests
+// are generated for a typed package, it is given that type's zero value.
+var primitiveZero interface{} = 0
 
 const (
-	primitiveZero   = 0
 	primitiveAValue = 1
 	primitiveBValue = "1"
 )
+
+// primitiveSamples are distinct values of the element type, in ascending
+// order, from which the specifications (see functions_test.go) build their
+// slices. When tests are generated for a typed package, it is given
+// values of that type. Some types have fewer samples than others (bool has
+// two), and a specification requiring more is skipped.
+var primitiveSamples = []interface{}{1, 2, 3, 4, 5}
@@ -7,11 +7,25 @@
 // with 0 or more characters that indicate details about the implementation.
 // Such suffixes are as follows:
 //
+//   Auto: Functions with this suffix take no equality or less function, and
+//		compare elements as closures.AutoEquality and closures.AutoLess do:
+//		by their own Equal, Less or Compare methods if they have them (see
+//		closures.Equaler, closures.Lesser and closures.Comparer, though
+//		methods that take a value of the element's own type, such as those
+//		of time.Time, serve as well), and otherwise in their natural order,
+//		falling back to reflect.DeepEqual for equality.
+//
 //   C: Functions with this suffix use concurrent operations internally, and
 //		will typically require that a concurrency pool size be specified as an
 //		argument. C functions guarantee that they will only use up to their
 //		alotted number of concurrent goroutines for each invocation.
 //
+//   H: Functions with this suffix require a Hasher as well as an equality
+//		function, and only compare elements whose hashes are the same. They
+//		return the same results as their unsuffixed counterparts, but in
+//		linear rather than quadratic time, so long as the Hasher spreads the
+//		elements well and returns the same hash for elements that are equal.
+//
 //   I: Functions with this suffix will have an index value threaded
 // 		through each call to their applicable closure function.
 //
@@ -22,7 +36,26 @@
 //   S: Functions with this suffix are optimized for an ordinal dataset
 //		that has been presorted. Any time data is ordinal in nature, and
 //		can be pre-sorted, there is typically a significant performance
-//		advantage to using S variants.
+//		advantage to using S variants. Searches such as AnyS and CountS
+//		take a closures.SearchFn, such as one from closures.Seek, in place
+//		of a test, and find the elements it seeks by binary search.
+//		Set operations such as IntersectionS take the closures.Comparator
+//		by which their slices are sorted, and merge them in linear time.
+//		S variants do not check that their slices are sorted; if they are
+//		not sorted as the search or Comparator requires, the result is
+//		unspecified.
+//		GroupS and PartitionS may differ from Group and Partition in the
+//		shape of their result: their groups are in the order of the
+//		sorted slice, and PartitionS always returns two groups, the
+//		elements that passed the test and then those that failed, either
+//		of which may be empty.
+//
+//   T: Functions with this suffix tolerate an approximate equality
+//		function, such as one from eq.Float64Within, which may consider a
+//		equal to b and b to c but not a to c. They require a less function
+//		as well, sort the elements by it, and divide them into clusters of
+//		elements equal to the least of each cluster, so that their results
+//		do not depend upon the order of the elements.
 //
 // Parameter Naming Conventions:
 // By convention, the source slice will be named `aa`. If multiple slices are
@@ -66,14 +99,17 @@
 // alternate function variants are provided, such as in `AnyS()`, and
 // `DifferenceS())`. Bear in mind that passing unsorted data to a function
 // variant that expects sorted data, will likely result in an incorrect result.
+// A SortedSliceType, created with NewSorted, keeps its elements sorted as they
+// are inserted, and may be used to ensure that they are.
 //
 // Equality functions:
 // Transforms that need to test the equality of slice elements are intentionaly
 // left naive, and do not make any assumptions about how to test for equality.
 // As a result, functions such as `Difference()` require an equality function
 // to be supplied. For primitive types, typical equality functions are provided
-// in the `eq` and `less` packages. It is encouraged to use the supplied
-// equality functions for primitive types.
+// in the `eq` package, and typical less functions, for transforms such as
+// `Sort()`, in the `less` package. It is encouraged to use the supplied
+// functions for primitive types.
 //
 // Inclusion of non-native slice operations:
 // This package provides functions independent of the underlaying data
@@ -95,3 +131,5 @@
 // allowed to be tollerant to duplicates. These differences are noted in the
 // description for each method, as warranted.package generic
 package generic
+
+//go:generate go run github.com/ideoterra/transforms/cmd -source=pkg/slices/generic -output=pkg/slices
@@ -0,0 +1,119 @@
+package generic_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic"
+	"github.com/ideoterra/transforms/pkg/slices/generic/eq"
+	"github.com/ideoterra/transforms/pkg/slices/generic/less"
+	"github.com/stretchr/testify/assert"
+)
+
+// The tests in this file depend upon particular element types, such as those
+// that decide their own equality, or float64s compared approximately, and so,
+// unlike the specifications of functions_test.go, are not generated alongside
+// each typed package.
+
+type caseless string
+
+func (c caseless) Equal(other interface{}) bool {
+	return strings.EqualFold(string(c), string(other.(caseless)))
+}
+
+func (c caseless) Less(other interface{}) bool {
+	return strings.ToLower(string(c)) < strings.ToLower(string(other.(caseless)))
+}
+
+func TestAutoFunctionsDeferToEqualMethods(t *testing.T) {
+	aa := []interface{}{caseless("Go"), caseless("Rust")}
+	bb := []interface{}{caseless("GO"), caseless("Zig")}
+	assert.Equal(t, []interface{}{caseless("Rust"), caseless("Zig")}, generic.DifferenceAuto(aa, bb))
+
+	aa = []interface{}{caseless("Go"), caseless("Rust"), caseless("go")}
+	assert.Equal(t, []interface{}{caseless("Go")}, generic.IntersectionAuto(aa, bb))
+
+	aa = []interface{}{caseless("Go"), caseless("go"), caseless("Rust"), caseless("GO")}
+	generic.DistinctAuto(&aa)
+	assert.Equal(t, []interface{}{caseless("Go"), caseless("Rust")}, aa)
+}
+
+func TestAutoFunctionsFallBackToDeepEqual(t *testing.T) {
+	aa := []interface{}{[]int{1}, []int{2}}
+	bb := []interface{}{[]int{2}, []int{3}}
+	assert.Equal(t, []interface{}{[]int{1}, []int{3}}, generic.DifferenceAuto(aa, bb))
+	assert.Equal(t, []interface{}{[]int{2}}, generic.IntersectionAuto(aa, bb))
+
+	aa = []interface{}{1, []int{1}, 1, int64(1), []int{1}}
+	generic.DistinctAuto(&aa)
+	assert.Equal(t, []interface{}{1, []int{1}, int64(1)}, aa)
+}
+
+func TestSortAuto(t *testing.T) {
+	aa := []interface{}{caseless("cherry"), caseless("apple"), caseless("Banana")}
+	generic.SortAuto(&aa)
+	assert.Equal(t, []interface{}{caseless("apple"), caseless("Banana"), caseless("cherry")}, aa, "elements that implement Less decide their own order")
+
+	aa = []interface{}{"cherry", "apple", "Banana"}
+	generic.SortAuto(&aa)
+	assert.Equal(t, []interface{}{"Banana", "apple", "cherry"}, aa, "other elements are sorted in their natural order")
+
+	aa = []interface{}{1, "a"}
+	assert.Panics(t, func() { generic.SortAuto(&aa) }, "elements that cannot be ordered")
+}
+
+func TestTolerantFunctionsCompareApproximately(t *testing.T) {
+	within := eq.Float64Within(1e-6, eq.NaNsUnequal)
+
+	aa := []interface{}{0.1 + 0.2, 1.0, 2.0}
+	bb := []interface{}{0.3, 2.0000001, 5.0}
+	assert.Equal(t, []interface{}{1.0, 5.0}, generic.DifferenceT(aa, bb, less.Float64(), within))
+
+	aa = []interface{}{0.1 + 0.2, 1.0, 0.3}
+	bb = []interface{}{0.3, 2.0}
+	assert.Equal(t, []interface{}{0.1 + 0.2}, generic.IntersectionT(aa, bb, less.Float64(), within))
+
+	aa = []interface{}{0.3, 0.5, 0.1 + 0.2, 0.5000001}
+	generic.DistinctT(&aa, less.Float64(), within)
+	assert.Equal(t, []interface{}{0.3, 0.5}, aa)
+}
+
+func TestTolerantFunctionsCompareByCluster(t *testing.T) {
+	within := eq.Float64Within(0.5, eq.NaNsUnequal)
+
+	cc := generic.DifferenceT([]interface{}{1.0, 1.4, 1.8}, []interface{}{1.8}, less.Float64(), within)
+	assert.Equal(t, []interface{}{1.0, 1.4}, cc)
+	cc = generic.DifferenceT([]interface{}{1.8, 1.4, 1.0}, []interface{}{1.8}, less.Float64(), within)
+	assert.Equal(t, []interface{}{1.4, 1.0}, cc)
+
+	cc = generic.IntersectionT([]interface{}{1.0}, []interface{}{1.4, 1.8}, less.Float64(), within)
+	assert.Equal(t, []interface{}{1.0}, cc)
+	cc = generic.IntersectionT([]interface{}{1.8}, []interface{}{1.4, 1.0}, less.Float64(), within)
+	assert.Equal(t, []interface{}{}, cc, "an element may match none it is equal to")
+
+	aa := []interface{}{1.0, 1.4, 1.8}
+	generic.DistinctT(&aa, less.Float64(), within)
+	assert.Equal(t, []interface{}{1.0, 1.8}, aa)
+	aa = []interface{}{1.8, 1.4, 1.0}
+	generic.DistinctT(&aa, less.Float64(), within)
+	assert.Equal(t, []interface{}{1.8, 1.4}, aa)
+}
+
+func TestDistinctTKeepsNaNsAsTheEqualityFunctionDetermines(t *testing.T) {
+	aa := []interface{}{math.NaN(), 1.0, math.NaN()}
+	generic.DistinctT(&aa, less.Float64(), eq.Float64Within(0.5, eq.NaNsUnequal))
+	assert.Len(t, aa, 3)
+
+	aa = []interface{}{math.NaN(), 1.0, math.NaN()}
+	generic.DistinctT(&aa, less.Float64(), eq.Float64Within(0.5, eq.NaNsEqual))
+	assert.Len(t, aa, 2)
+	assert.True(t, math.IsNaN(aa[0].(float64)))
+	assert.Equal(t, 1.0, aa[1])
+}
+
+func TestStringRepresentsElementsAsJSON(t *testing.T) {
+	assert.Equal(t, "[1,2,3]", generic.String([]interface{}{1, 2, 3}))
+	assert.Equal(t, "[(1+2i),(3-4i)]", generic.String([]interface{}{complex(1, 2), complex(3, -4)}), "elements that cannot be marshaled are formatted by fmt.Sprint")
+	assert.Equal(t, "[1,2]", generic.String([]interface{}{byte(1), byte(2)}), "bytes are numbers, rather than base64")
+}
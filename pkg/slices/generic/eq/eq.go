@@ -0,0 +1,149 @@
+// Package eq provides EqualityFns for use with transforms such as Difference,
+// Distinct and Intersection.
+//
+// Each function returns a new EqualityFn. The values compared are asserted to
+// be of the expected type, and an EqualityFn panics, as the assertion does, if
+// either is not. Every function has a Safe variant (IntSafe, for example)
+// whose EqualityFn instead reports false for values of an unexpected type,
+// which suits slices that hold values of more than one type.
+//
+// Float64Within and Float64ULPs, and their float32 counterparts, consider
+// floating point numbers equal if they are close enough, for data such as
+// measurements in which 0.1+0.2 should equal 0.3. Such equality is not
+// transitive, so they are best used with the T variants of transforms, such
+// as DistinctT.
+package eq
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic/closures"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Bytes returns an EqualityFn that compares []byte values with bytes.Equal, so
+// a nil slice is equal to an empty one. It panics if either value is not a
+// []byte.
+func Bytes() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return bytes.Equal(a.([]byte), b.([]byte))
+	}
+}
+
+// BytesSafe is like Bytes, but reports false, rather than panicking, if either
+// value is not a []byte.
+func BytesSafe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.([]byte)
+		y, ok2 := b.([]byte)
+		return ok && ok2 && bytes.Equal(x, y)
+	}
+}
+
+// StringFold returns an EqualityFn that compares strings without regard to
+// case, using Unicode case folding (see strings.EqualFold). It panics if either
+// value is not a string.
+func StringFold() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return strings.EqualFold(a.(string), b.(string))
+	}
+}
+
+// StringFoldSafe is like StringFold, but reports false, rather than panicking,
+// if either value is not a string.
+func StringFoldSafe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(string)
+		y, ok2 := b.(string)
+		return ok && ok2 && strings.EqualFold(x, y)
+	}
+}
+
+// Norm returns an EqualityFn that converts strings to the Unicode
+// normalization form, and compares the results with equality. Strings that
+// differ only in how their characters are composed, such as "é" spelled as one
+// code point or as "e" followed by a combining accent, are then equal. For
+// example:
+//
+//	eq.Norm(norm.NFC, eq.String())
+//	eq.Norm(norm.NFKC, eq.StringFold())
+//
+// It panics if either value is not a string.
+func Norm(form norm.Form, equality closures.EqualityFn) closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return equality(form.String(a.(string)), form.String(b.(string)))
+	}
+}
+
+// NormSafe is like Norm, but reports false, rather than panicking, if either
+// value is not a string.
+func NormSafe(form norm.Form, equality closures.EqualityFn) closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(string)
+		y, ok2 := b.(string)
+		return ok && ok2 && equality(form.String(x), form.String(y))
+	}
+}
+
+// Pointer returns an EqualityFn that compares pointers by identity: two
+// pointers are equal if they hold the same address, whatever the values at that
+// address. It panics unless both values are pointers of the same type.
+func Pointer() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		mustBePointers("Pointer", a, b)
+		return a == b
+	}
+}
+
+// PointerSafe is like Pointer, but reports false, rather than panicking, unless
+// both values are pointers of the same type.
+func PointerSafe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return arePointers(a, b) && a == b
+	}
+}
+
+// Pointee returns an EqualityFn that compares pointers by the values to which
+// they point, using equality. Two nil pointers are equal, and a nil pointer is
+// not equal to any other. It panics unless both values are pointers of the same
+// type.
+func Pointee(equality closures.EqualityFn) closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		mustBePointers("Pointee", a, b)
+		return pointeesEqual(a, b, equality)
+	}
+}
+
+// PointeeSafe is like Pointee, but reports false, rather than panicking, unless
+// both values are pointers of the same type.
+func PointeeSafe(equality closures.EqualityFn) closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return arePointers(a, b) && pointeesEqual(a, b, equality)
+	}
+}
+
+// arePointers reports whether a and b are pointers of the same type.
+func arePointers(a, b interface{}) bool {
+	ta := reflect.TypeOf(a)
+	return ta != nil && ta.Kind() == reflect.Ptr && ta == reflect.TypeOf(b)
+}
+
+// mustBePointers panics, naming the function fn, unless a and b are pointers of
+// the same type.
+func mustBePointers(fn string, a, b interface{}) {
+	if !arePointers(a, b) {
+		panic(fmt.Sprintf("eq.%v: cannot compare %T and %T as pointers of the same type", fn, a, b))
+	}
+}
+
+// pointeesEqual compares the values to which the pointers a and b point.
+func pointeesEqual(a, b interface{}, equality closures.EqualityFn) bool {
+	x, y := reflect.ValueOf(a), reflect.ValueOf(b)
+	if x.IsNil() || y.IsNil() {
+		return x.IsNil() && y.IsNil()
+	}
+	return equality(x.Elem().Interface(), y.Elem().Interface())
+}
@@ -0,0 +1,151 @@
+package eq_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic/closures"
+	"github.com/ideoterra/transforms/pkg/slices/generic/eq"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/unicode/norm"
+)
+
+type comparison struct {
+	name     string
+	equality closures.EqualityFn
+	a, b     interface{}
+	want     bool
+	panics   bool
+}
+
+func TestEqualityFns(t *testing.T) {
+	one, alsoOne, two := 1, 1, 2
+	var nilInt *int
+	nan, inf := math.NaN(), math.Inf(1)
+	nan32 := float32(nan)
+
+	comparisons := []comparison{
+		{"Bool", eq.Bool(), true, true, true, false},
+		{"Bool", eq.Bool(), true, false, false, false},
+		{"Byte", eq.Byte(), byte(1), byte(1), true, false},
+		{"Complex64", eq.Complex64(), complex64(1i), complex64(1i), true, false},
+		{"Complex128", eq.Complex128(), 1i, 2i, false, false},
+		{"Float32", eq.Float32(), float32(1.5), float32(1.5), true, false},
+		{"Float64", eq.Float64(), 1.5, 2.5, false, false},
+		{"Int", eq.Int(), 1, 1, true, false},
+		{"Int", eq.Int(), 1, 2, false, false},
+		{"Int", eq.Int(), 1, int64(1), false, true},
+		{"Int", eq.Int(), nil, 1, false, true},
+		{"Int8", eq.Int8(), int8(1), int8(1), true, false},
+		{"Int16", eq.Int16(), int16(1), int16(2), false, false},
+		{"Int32", eq.Int32(), int32(1), int32(1), true, false},
+		{"Int64", eq.Int64(), int64(1), int64(1), true, false},
+		{"Rune", eq.Rune(), 'a', 'a', true, false},
+		{"String", eq.String(), "a", "a", true, false},
+		{"String", eq.String(), "a", "A", false, false},
+		{"Uint", eq.Uint(), uint(1), uint(1), true, false},
+		{"Uint8", eq.Uint8(), uint8(1), uint8(2), false, false},
+		{"Uint16", eq.Uint16(), uint16(1), uint16(1), true, false},
+		{"Uint32", eq.Uint32(), uint32(1), uint32(1), true, false},
+		{"Uint64", eq.Uint64(), uint64(1), uint64(1), true, false},
+		{"Uintptr", eq.Uintptr(), uintptr(1), uintptr(1), true, false},
+
+		{"IntSafe", eq.IntSafe(), 1, 1, true, false},
+		{"IntSafe", eq.IntSafe(), 1, int64(1), false, false},
+		{"IntSafe", eq.IntSafe(), nil, nil, false, false},
+		{"StringSafe", eq.StringSafe(), "a", 'a', false, false},
+		{"Float64Safe", eq.Float64Safe(), 1.5, 1.5, true, false},
+
+		{"Bytes", eq.Bytes(), []byte("ab"), []byte("ab"), true, false},
+		{"Bytes", eq.Bytes(), []byte(nil), []byte{}, true, false},
+		{"Bytes", eq.Bytes(), []byte("ab"), []byte("ba"), false, false},
+		{"Bytes", eq.Bytes(), []byte("ab"), "ab", false, true},
+		{"BytesSafe", eq.BytesSafe(), []byte("ab"), "ab", false, false},
+
+		{"StringFold", eq.StringFold(), "Go", "GO", true, false},
+		{"StringFold", eq.StringFold(), "σ", "Σ", true, false},
+		{"StringFold", eq.StringFold(), "Go", "Ga", false, false},
+		{"StringFold", eq.StringFold(), "Go", []byte("Go"), false, true},
+		{"StringFoldSafe", eq.StringFoldSafe(), "Go", []byte("Go"), false, false},
+
+		{"Norm", eq.Norm(norm.NFC, eq.String()), "café", "café", true, false},
+		{"Norm", eq.Norm(norm.NFC, eq.String()), "ﬁ", "fi", false, false},
+		{"Norm", eq.Norm(norm.NFKC, eq.String()), "ﬁ", "fi", true, false},
+		{"Norm", eq.Norm(norm.NFC, eq.StringFold()), "CAFÉ", "café", true, false},
+		{"Norm", eq.Norm(norm.NFC, eq.String()), "cafe", 1, false, true},
+		{"NormSafe", eq.NormSafe(norm.NFC, eq.String()), "cafe", 1, false, false},
+		{"NormSafe", eq.NormSafe(norm.NFC, eq.String()), "café", "café", true, false},
+
+		{"Pointer", eq.Pointer(), &one, &one, true, false},
+		{"Pointer", eq.Pointer(), &one, &alsoOne, false, false},
+		{"Pointer", eq.Pointer(), nilInt, nilInt, true, false},
+		{"Pointer", eq.Pointer(), one, one, false, true},
+		{"Pointer", eq.Pointer(), &one, &[]int{1}, false, true},
+		{"PointerSafe", eq.PointerSafe(), one, one, false, false},
+		{"PointerSafe", eq.PointerSafe(), nil, nil, false, false},
+		{"PointerSafe", eq.PointerSafe(), &one, &one, true, false},
+
+		{"Pointee", eq.Pointee(eq.Int()), &one, &alsoOne, true, false},
+		{"Pointee", eq.Pointee(eq.Int()), &one, &two, false, false},
+		{"Pointee", eq.Pointee(eq.Int()), nilInt, nilInt, true, false},
+		{"Pointee", eq.Pointee(eq.Int()), &one, nilInt, false, false},
+		{"Pointee", eq.Pointee(eq.Int()), one, alsoOne, false, true},
+		{"PointeeSafe", eq.PointeeSafe(eq.Int()), one, alsoOne, false, false},
+		{"PointeeSafe", eq.PointeeSafe(eq.Int()), &one, &alsoOne, true, false},
+
+		{"Float64Within", eq.Float64Within(1e-9, eq.NaNsUnequal), 0.1 + 0.2, 0.3, true, false},
+		{"Float64Within", eq.Float64Within(0.5, eq.NaNsUnequal), 1.0, 1.5, true, false},
+		{"Float64Within", eq.Float64Within(0.5, eq.NaNsUnequal), 1.0, 1.75, false, false},
+		{"Float64Within", eq.Float64Within(0, eq.NaNsUnequal), 0.0, math.Copysign(0, -1), true, false},
+		{"Float64Within", eq.Float64Within(inf, eq.NaNsUnequal), inf, 1.0, false, false},
+		{"Float64Within", eq.Float64Within(inf, eq.NaNsUnequal), inf, inf, true, false},
+		{"Float64Within", eq.Float64Within(inf, eq.NaNsUnequal), inf, -inf, false, false},
+		{"Float64Within", eq.Float64Within(inf, eq.NaNsUnequal), nan, nan, false, false},
+		{"Float64Within", eq.Float64Within(inf, eq.NaNsEqual), nan, nan, true, false},
+		{"Float64Within", eq.Float64Within(inf, eq.NaNsEqual), nan, 1.0, false, false},
+		{"Float64Within", eq.Float64Within(1, eq.NaNsUnequal), 1.0, float32(1), false, true},
+		{"Float64WithinSafe", eq.Float64WithinSafe(1, eq.NaNsUnequal), 1.0, float32(1), false, false},
+		{"Float64WithinSafe", eq.Float64WithinSafe(1, eq.NaNsUnequal), 1.0, 1.5, true, false},
+		{"Float32Within", eq.Float32Within(1e-6, eq.NaNsUnequal), float32(0.1) + float32(0.2), float32(0.3), true, false},
+		{"Float32Within", eq.Float32Within(0.5, eq.NaNsEqual), nan32, nan32, true, false},
+		{"Float32Within", eq.Float32Within(0.5, eq.NaNsEqual), float32(1), float32(2), false, false},
+		{"Float32WithinSafe", eq.Float32WithinSafe(0.5, eq.NaNsEqual), float32(1), 1.0, false, false},
+
+		{"Float64ULPs", eq.Float64ULPs(0, eq.NaNsUnequal), 1.0, 1.0, true, false},
+		{"Float64ULPs", eq.Float64ULPs(0, eq.NaNsUnequal), 1.0, math.Nextafter(1, 2), false, false},
+		{"Float64ULPs", eq.Float64ULPs(1, eq.NaNsUnequal), 1.0, math.Nextafter(1, 2), true, false},
+		{"Float64ULPs", eq.Float64ULPs(1, eq.NaNsUnequal), 1.0, math.Nextafter(math.Nextafter(1, 2), 2), false, false},
+		{"Float64ULPs", eq.Float64ULPs(4, eq.NaNsUnequal), 0.1 + 0.2, 0.3, true, false},
+		{"Float64ULPs", eq.Float64ULPs(1, eq.NaNsUnequal), 1e300, math.Nextafter(1e300, inf), true, false},
+		{"Float64Within", eq.Float64Within(1e-9, eq.NaNsUnequal), 1e300, math.Nextafter(1e300, inf), false, false},
+		{"Float64ULPs", eq.Float64ULPs(0, eq.NaNsUnequal), 0.0, math.Copysign(0, -1), true, false},
+		{"Float64ULPs", eq.Float64ULPs(2, eq.NaNsUnequal), math.SmallestNonzeroFloat64, -math.SmallestNonzeroFloat64, true, false},
+		{"Float64ULPs", eq.Float64ULPs(1, eq.NaNsUnequal), math.SmallestNonzeroFloat64, -math.SmallestNonzeroFloat64, false, false},
+		{"Float64ULPs", eq.Float64ULPs(math.MaxUint64, eq.NaNsUnequal), -math.MaxFloat64, math.MaxFloat64, true, false},
+		{"Float64ULPs", eq.Float64ULPs(1, eq.NaNsUnequal), math.MaxFloat64, inf, false, false},
+		{"Float64ULPs", eq.Float64ULPs(1, eq.NaNsUnequal), nan, nan, false, false},
+		{"Float64ULPs", eq.Float64ULPs(1, eq.NaNsEqual), nan, nan, true, false},
+		{"Float64ULPs", eq.Float64ULPs(1, eq.NaNsEqual), 1, 1, false, true},
+		{"Float64ULPsSafe", eq.Float64ULPsSafe(1, eq.NaNsEqual), 1, 1, false, false},
+		{"Float32ULPs", eq.Float32ULPs(1, eq.NaNsUnequal), float32(1), math.Nextafter32(1, 2), true, false},
+		{"Float32ULPs", eq.Float32ULPs(1, eq.NaNsUnequal), float32(1), math.Nextafter32(1, 0), true, false},
+		{"Float32ULPs", eq.Float32ULPs(1, eq.NaNsUnequal), math.Nextafter32(1, 0), math.Nextafter32(1, 2), false, false},
+		{"Float32ULPs", eq.Float32ULPs(1, eq.NaNsEqual), nan32, nan32, true, false},
+		{"Float32ULPsSafe", eq.Float32ULPsSafe(1, eq.NaNsEqual), float32(1), 1.0, false, false},
+	}
+
+	for _, c := range comparisons {
+		if c.panics {
+			assert.Panics(t, func() { c.equality(c.a, c.b) }, "%v(%#v, %#v)", c.name, c.a, c.b)
+			continue
+		}
+		assert.Equal(t, c.want, c.equality(c.a, c.b), "%v(%#v, %#v)", c.name, c.a, c.b)
+		assert.Equal(t, c.want, c.equality(c.b, c.a), "%v(%#v, %#v)", c.name, c.b, c.a)
+	}
+}
+
+func TestPointerPanicMessage(t *testing.T) {
+	assert.PanicsWithValue(t, "eq.Pointer: cannot compare int and string as pointers of the same type", func() {
+		eq.Pointer()(1, "a")
+	})
+}
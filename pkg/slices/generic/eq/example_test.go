@@ -0,0 +1,30 @@
+package eq_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic"
+	"github.com/ideoterra/transforms/pkg/slices/generic/eq"
+	"github.com/ideoterra/transforms/pkg/slices/generic/less"
+)
+
+func ExampleStringFold() {
+	words := []interface{}{"Go", "go", "GO", "Rust"}
+	generic.Distinct(&words, eq.StringFold())
+	fmt.Println(words)
+	// Output: [Go Rust]
+}
+
+func ExampleIntSafe() {
+	values := []interface{}{1, int64(1), "1"}
+	fmt.Println(generic.Intersection(values, []interface{}{1}, eq.IntSafe()))
+	// Output: [1]
+}
+
+func ExampleFloat64Within() {
+	readings := []interface{}{0.3, 0.1 + 0.2, 1.0, math.NaN(), 1.05, math.NaN()}
+	generic.DistinctT(&readings, less.Float64(), eq.Float64Within(0.1, eq.NaNsEqual))
+	fmt.Println(readings)
+	// Output: [0.3 1 NaN]
+}
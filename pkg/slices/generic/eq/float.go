@@ -0,0 +1,166 @@
+package eq
+
+import (
+	"math"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic/closures"
+)
+
+// NaNs determines whether the approximate EqualityFns, such as Float64Within,
+// consider NaN to be equal to NaN.
+type NaNs int
+
+const (
+	// NaNsUnequal considers NaN equal to nothing, not even itself, as == does.
+	// Transforms such as Distinct therefore keep every NaN.
+	NaNsUnequal NaNs = iota
+	// NaNsEqual considers every NaN equal to every other, and to nothing else,
+	// so transforms such as Distinct keep only one.
+	NaNsEqual
+)
+
+// Float64Within returns an EqualityFn that considers float64 values equal if
+// they differ by no more than epsilon, so that 0.1+0.2 is equal to 0.3 for
+// any epsilon of at least 1e-16. Infinities are equal only to themselves, and
+// NaN is compared as nans specifies. It panics if either value is not a
+// float64.
+//
+// Approximate equality is not transitive: a may be equal to b, and b to c,
+// while a is not equal to c. Transforms that group equal elements, such as
+// Distinct, may therefore give results that depend upon the order of the
+// elements; the T variants, such as DistinctT, do not.
+func Float64Within(epsilon float64, nans NaNs) closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return within(a.(float64), b.(float64), epsilon, nans)
+	}
+}
+
+// Float64WithinSafe is like Float64Within, but reports false, rather than
+// panicking, if either value is not a float64.
+func Float64WithinSafe(epsilon float64, nans NaNs) closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(float64)
+		y, ok2 := b.(float64)
+		return ok && ok2 && within(x, y, epsilon, nans)
+	}
+}
+
+// Float32Within is like Float64Within, but compares float32 values. It panics
+// if either value is not a float32.
+func Float32Within(epsilon float32, nans NaNs) closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return within(float64(a.(float32)), float64(b.(float32)), float64(epsilon), nans)
+	}
+}
+
+// Float32WithinSafe is like Float32Within, but reports false, rather than
+// panicking, if either value is not a float32.
+func Float32WithinSafe(epsilon float32, nans NaNs) closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(float32)
+		y, ok2 := b.(float32)
+		return ok && ok2 && within(float64(x), float64(y), float64(epsilon), nans)
+	}
+}
+
+// Float64ULPs returns an EqualityFn that considers float64 values equal if no
+// more than ulps representable values lie between them, counting one of the
+// two; that is, if they are at most ulps units in the last place apart. Unlike
+// an epsilon, this tolerance scales with the magnitude of the values. Zero and
+// negative zero are equal, infinities are equal only to themselves, and NaN is
+// compared as nans specifies. It panics if either value is not a float64.
+//
+// Like Float64Within, the EqualityFn is not transitive; see Float64Within.
+func Float64ULPs(ulps uint64, nans NaNs) closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return withinULPs(a.(float64), b.(float64), ulps, nans)
+	}
+}
+
+// Float64ULPsSafe is like Float64ULPs, but reports false, rather than
+// panicking, if either value is not a float64.
+func Float64ULPsSafe(ulps uint64, nans NaNs) closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(float64)
+		y, ok2 := b.(float64)
+		return ok && ok2 && withinULPs(x, y, ulps, nans)
+	}
+}
+
+// Float32ULPs is like Float64ULPs, but compares float32 values, counting the
+// float32 values between them. It panics if either value is not a float32.
+func Float32ULPs(ulps uint32, nans NaNs) closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return withinULPs32(a.(float32), b.(float32), ulps, nans)
+	}
+}
+
+// Float32ULPsSafe is like Float32ULPs, but reports false, rather than
+// panicking, if either value is not a float32.
+func Float32ULPsSafe(ulps uint32, nans NaNs) closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(float32)
+		y, ok2 := b.(float32)
+		return ok && ok2 && withinULPs32(x, y, ulps, nans)
+	}
+}
+
+// special reports whether x or y is NaN or infinite and, if so, whether they
+// are equal.
+func special(x, y float64, nans NaNs) (equal, ok bool) {
+	switch {
+	case math.IsNaN(x) || math.IsNaN(y):
+		return nans == NaNsEqual && math.IsNaN(x) && math.IsNaN(y), true
+	case math.IsInf(x, 0) || math.IsInf(y, 0):
+		return x == y, true
+	}
+	return false, false
+}
+
+func within(x, y, epsilon float64, nans NaNs) bool {
+	if equal, ok := special(x, y, nans); ok {
+		return equal
+	}
+	return math.Abs(x-y) <= epsilon
+}
+
+func withinULPs(x, y float64, ulps uint64, nans NaNs) bool {
+	if equal, ok := special(x, y, nans); ok {
+		return equal
+	}
+	ox, oy := ordinal64(x), ordinal64(y)
+	if ox < oy {
+		ox, oy = oy, ox
+	}
+	return uint64(ox)-uint64(oy) <= ulps
+}
+
+func withinULPs32(x, y float32, ulps uint32, nans NaNs) bool {
+	if equal, ok := special(float64(x), float64(y), nans); ok {
+		return equal
+	}
+	ox, oy := ordinal32(x), ordinal32(y)
+	if ox < oy {
+		ox, oy = oy, ox
+	}
+	return uint32(ox)-uint32(oy) <= ulps
+}
+
+// ordinal64 maps finite float64 values onto integers in the same order, such
+// that adjacent values map to adjacent integers, and both zeros map to 0.
+func ordinal64(f float64) int64 {
+	bits := int64(math.Float64bits(f))
+	if bits < 0 {
+		bits = math.MinInt64 - bits
+	}
+	return bits
+}
+
+// ordinal32 is like ordinal64, but maps float32 values.
+func ordinal32(f float32) int32 {
+	bits := int32(math.Float32bits(f))
+	if bits < 0 {
+		bits = math.MinInt32 - bits
+	}
+	return bits
+}
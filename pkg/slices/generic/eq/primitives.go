@@ -0,0 +1,345 @@
+package eq
+
+import "github.com/ideoterra/transforms/pkg/slices/generic/closures"
+
+// Bool returns an EqualityFn that compares bool values with ==. It panics if
+// either value is not a bool.
+func Bool() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return a.(bool) == b.(bool)
+	}
+}
+
+// BoolSafe is like Bool, but reports false, rather than panicking, if either
+// value is not a bool.
+func BoolSafe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(bool)
+		y, ok2 := b.(bool)
+		return ok && ok2 && x == y
+	}
+}
+
+// Byte returns an EqualityFn that compares byte values with ==. It panics if
+// either value is not a byte.
+func Byte() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return a.(byte) == b.(byte)
+	}
+}
+
+// ByteSafe is like Byte, but reports false, rather than panicking, if either
+// value is not a byte.
+func ByteSafe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(byte)
+		y, ok2 := b.(byte)
+		return ok && ok2 && x == y
+	}
+}
+
+// Complex64 returns an EqualityFn that compares complex64 values with ==. It
+// panics if either value is not a complex64.
+func Complex64() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return a.(complex64) == b.(complex64)
+	}
+}
+
+// Complex64Safe is like Complex64, but reports false, rather than panicking, if
+// either value is not a complex64.
+func Complex64Safe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(complex64)
+		y, ok2 := b.(complex64)
+		return ok && ok2 && x == y
+	}
+}
+
+// Complex128 returns an EqualityFn that compares complex128 values with ==. It
+// panics if either value is not a complex128.
+func Complex128() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return a.(complex128) == b.(complex128)
+	}
+}
+
+// Complex128Safe is like Complex128, but reports false, rather than panicking,
+// if either value is not a complex128.
+func Complex128Safe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(complex128)
+		y, ok2 := b.(complex128)
+		return ok && ok2 && x == y
+	}
+}
+
+// Float32 returns an EqualityFn that compares float32 values with ==. It panics
+// if either value is not a float32.
+func Float32() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return a.(float32) == b.(float32)
+	}
+}
+
+// Float32Safe is like Float32, but reports false, rather than panicking, if
+// either value is not a float32.
+func Float32Safe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(float32)
+		y, ok2 := b.(float32)
+		return ok && ok2 && x == y
+	}
+}
+
+// Float64 returns an EqualityFn that compares float64 values with ==. It panics
+// if either value is not a float64.
+func Float64() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return a.(float64) == b.(float64)
+	}
+}
+
+// Float64Safe is like Float64, but reports false, rather than panicking, if
+// either value is not a float64.
+func Float64Safe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(float64)
+		y, ok2 := b.(float64)
+		return ok && ok2 && x == y
+	}
+}
+
+// Int returns an EqualityFn that compares int values with ==. It panics if
+// either value is not an int.
+func Int() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return a.(int) == b.(int)
+	}
+}
+
+// IntSafe is like Int, but reports false, rather than panicking, if either
+// value is not an int.
+func IntSafe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(int)
+		y, ok2 := b.(int)
+		return ok && ok2 && x == y
+	}
+}
+
+// Int8 returns an EqualityFn that compares int8 values with ==. It panics if
+// either value is not an int8.
+func Int8() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return a.(int8) == b.(int8)
+	}
+}
+
+// Int8Safe is like Int8, but reports false, rather than panicking, if either
+// value is not an int8.
+func Int8Safe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(int8)
+		y, ok2 := b.(int8)
+		return ok && ok2 && x == y
+	}
+}
+
+// Int16 returns an EqualityFn that compares int16 values with ==. It panics if
+// either value is not an int16.
+func Int16() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return a.(int16) == b.(int16)
+	}
+}
+
+// Int16Safe is like Int16, but reports false, rather than panicking, if either
+// value is not an int16.
+func Int16Safe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(int16)
+		y, ok2 := b.(int16)
+		return ok && ok2 && x == y
+	}
+}
+
+// Int32 returns an EqualityFn that compares int32 values with ==. It panics if
+// either value is not an int32.
+func Int32() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return a.(int32) == b.(int32)
+	}
+}
+
+// Int32Safe is like Int32, but reports false, rather than panicking, if either
+// value is not an int32.
+func Int32Safe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(int32)
+		y, ok2 := b.(int32)
+		return ok && ok2 && x == y
+	}
+}
+
+// Int64 returns an EqualityFn that compares int64 values with ==. It panics if
+// either value is not an int64.
+func Int64() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return a.(int64) == b.(int64)
+	}
+}
+
+// Int64Safe is like Int64, but reports false, rather than panicking, if either
+// value is not an int64.
+func Int64Safe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(int64)
+		y, ok2 := b.(int64)
+		return ok && ok2 && x == y
+	}
+}
+
+// Rune returns an EqualityFn that compares rune values with ==. It panics if
+// either value is not a rune.
+func Rune() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return a.(rune) == b.(rune)
+	}
+}
+
+// RuneSafe is like Rune, but reports false, rather than panicking, if either
+// value is not a rune.
+func RuneSafe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(rune)
+		y, ok2 := b.(rune)
+		return ok && ok2 && x == y
+	}
+}
+
+// String returns an EqualityFn that compares string values with ==. It panics
+// if either value is not a string.
+func String() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return a.(string) == b.(string)
+	}
+}
+
+// StringSafe is like String, but reports false, rather than panicking, if
+// either value is not a string.
+func StringSafe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(string)
+		y, ok2 := b.(string)
+		return ok && ok2 && x == y
+	}
+}
+
+// Uint returns an EqualityFn that compares uint values with ==. It panics if
+// either value is not an uint.
+func Uint() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return a.(uint) == b.(uint)
+	}
+}
+
+// UintSafe is like Uint, but reports false, rather than panicking, if either
+// value is not an uint.
+func UintSafe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(uint)
+		y, ok2 := b.(uint)
+		return ok && ok2 && x == y
+	}
+}
+
+// Uint8 returns an EqualityFn that compares uint8 values with ==. It panics if
+// either value is not an uint8.
+func Uint8() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return a.(uint8) == b.(uint8)
+	}
+}
+
+// Uint8Safe is like Uint8, but reports false, rather than panicking, if either
+// value is not an uint8.
+func Uint8Safe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(uint8)
+		y, ok2 := b.(uint8)
+		return ok && ok2 && x == y
+	}
+}
+
+// Uint16 returns an EqualityFn that compares uint16 values with ==. It panics
+// if either value is not an uint16.
+func Uint16() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return a.(uint16) == b.(uint16)
+	}
+}
+
+// Uint16Safe is like Uint16, but reports false, rather than panicking, if
+// either value is not an uint16.
+func Uint16Safe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(uint16)
+		y, ok2 := b.(uint16)
+		return ok && ok2 && x == y
+	}
+}
+
+// Uint32 returns an EqualityFn that compares uint32 values with ==. It panics
+// if either value is not an uint32.
+func Uint32() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return a.(uint32) == b.(uint32)
+	}
+}
+
+// Uint32Safe is like Uint32, but reports false, rather than panicking, if
+// either value is not an uint32.
+func Uint32Safe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(uint32)
+		y, ok2 := b.(uint32)
+		return ok && ok2 && x == y
+	}
+}
+
+// Uint64 returns an EqualityFn that compares uint64 values with ==. It panics
+// if either value is not an uint64.
+func Uint64() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return a.(uint64) == b.(uint64)
+	}
+}
+
+// Uint64Safe is like Uint64, but reports false, rather than panicking, if
+// either value is not an uint64.
+func Uint64Safe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(uint64)
+		y, ok2 := b.(uint64)
+		return ok && ok2 && x == y
+	}
+}
+
+// Uintptr returns an EqualityFn that compares uintptr values with ==. It panics
+// if either value is not an uintptr.
+func Uintptr() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		return a.(uintptr) == b.(uintptr)
+	}
+}
+
+// UintptrSafe is like Uintptr, but reports false, rather than panicking, if
+// either value is not an uintptr.
+func UintptrSafe() closures.EqualityFn {
+	return func(a, b interface{}) bool {
+		x, ok := a.(uintptr)
+		y, ok2 := b.(uintptr)
+		return ok && ok2 && x == y
+	}
+}
@@ -0,0 +1,509 @@
+package expr
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// node is a parsed expression, or a part of one, that is evaluated against a
+// value. Nodes that may fail record the offset in the source of their name or
+// operator, so that errors may be reported there.
+type node interface {
+	eval(v any) (any, error)
+}
+
+// literal is a number, string, boolean or nil.
+type literal struct {
+	v any
+}
+
+func (n *literal) eval(any) (any, error) {
+	return n.v, nil
+}
+
+// this is the value against which the expression is evaluated, written as a
+// lone dot.
+type this struct{}
+
+func (n *this) eval(v any) (any, error) {
+	return normalize(reflect.ValueOf(v)), nil
+}
+
+// list is a list of expressions, written in square brackets.
+type list struct {
+	elems []node
+}
+
+func (n *list) eval(v any) (any, error) {
+	values := make([]any, len(n.elems))
+	for i, elem := range n.elems {
+		x, err := elem.eval(v)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = x
+	}
+	return values, nil
+}
+
+// field is a field of a struct, or the value of a map for a string key.
+type field struct {
+	pos  int
+	x    node
+	name string
+}
+
+func (n *field) eval(v any) (any, error) {
+	x, err := n.x.eval(v)
+	if err != nil || x == nil {
+		return nil, err
+	}
+	rv := reflect.ValueOf(x)
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil, errorAt(n.pos, "cannot get field %v of %v, whose keys are not strings", n.name, describeKind(x))
+		}
+		return normalize(rv.MapIndex(reflect.ValueOf(n.name).Convert(rv.Type().Key()))), nil
+	case reflect.Struct:
+		index, ok := structField(rv.Type(), n.name)
+		if !ok {
+			return nil, errorAt(n.pos, "%v has no field %v", rv.Type(), n.name)
+		}
+		f, err := rv.FieldByIndexErr(index)
+		if err != nil {
+			return nil, nil
+		}
+		return normalize(f), nil
+	}
+	return nil, errorAt(n.pos, "cannot get field %v of %v", n.name, describeKind(x))
+}
+
+// fieldIndexes caches the indexes of struct fields, keyed by fieldKey.
+var fieldIndexes sync.Map
+
+type fieldKey struct {
+	t    reflect.Type
+	name string
+}
+
+// structField returns the index of the exported field of the struct type t
+// named name or, failing that, whose JSON name is name or, failing that, whose
+// name is name without regard to case.
+func structField(t reflect.Type, name string) ([]int, bool) {
+	key := fieldKey{t, name}
+	if index, ok := fieldIndexes.Load(key); ok {
+		return index.([]int), true
+	}
+	matches := []func(f reflect.StructField) bool{
+		func(f reflect.StructField) bool { return f.Name == name },
+		func(f reflect.StructField) bool { return strings.Split(f.Tag.Get("json"), ",")[0] == name },
+		func(f reflect.StructField) bool { return strings.EqualFold(f.Name, name) },
+	}
+	fields := reflect.VisibleFields(t)
+	for _, match := range matches {
+		for _, f := range fields {
+			if f.IsExported() && !f.Anonymous && match(f) {
+				fieldIndexes.Store(key, f.Index)
+				return f.Index, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// index is an element of a list or string, or the value of a map for a key.
+type index struct {
+	pos  int
+	x, i node
+}
+
+func (n *index) eval(v any) (any, error) {
+	x, err := n.x.eval(v)
+	if err != nil {
+		return nil, err
+	}
+	i, err := n.i.eval(v)
+	if err != nil || x == nil {
+		return nil, err
+	}
+
+	rv := reflect.ValueOf(x)
+	switch rv.Kind() {
+	case reflect.Map:
+		for _, k := range rv.MapKeys() {
+			if equal(normalize(k), i) {
+				return normalize(rv.MapIndex(k)), nil
+			}
+		}
+		return nil, nil
+	case reflect.Slice, reflect.Array, reflect.String:
+		f, ok := i.(float64)
+		if !ok || f != math.Trunc(f) {
+			return nil, errorAt(n.pos, "cannot index %v with %v; indexes must be whole numbers", describeKind(x), describeKind(i))
+		}
+		if s, ok := x.(string); ok {
+			runes := []rune(s)
+			if at, ok := within(f, len(runes)); ok {
+				return string(runes[at]), nil
+			}
+			return nil, nil
+		}
+		if at, ok := within(f, rv.Len()); ok {
+			return normalize(rv.Index(at)), nil
+		}
+		return nil, nil
+	}
+	return nil, errorAt(n.pos, "cannot index %v", describeKind(x))
+}
+
+// within returns the index i of a list of length n, counting from the end if i
+// is negative, and whether it lies within the list.
+func within(i float64, n int) (int, bool) {
+	if i < 0 {
+		i += float64(n)
+	}
+	return int(i), i >= 0 && i < float64(n)
+}
+
+// unary is the negation of a boolean, or of a number.
+type unary struct {
+	pos int
+	op  string
+	x   node
+}
+
+func (n *unary) eval(v any) (any, error) {
+	x, err := n.x.eval(v)
+	if err != nil {
+		return nil, err
+	}
+	switch x := x.(type) {
+	case bool:
+		if n.op == "!" {
+			return !x, nil
+		}
+	case float64:
+		if n.op == "-" {
+			return -x, nil
+		}
+	}
+	return nil, errorAt(n.pos, "cannot apply %v to %v", n.op, describeKind(x))
+}
+
+// binary is an operator applied to two operands.
+type binary struct {
+	pos  int
+	op   string
+	x, y node
+}
+
+func (n *binary) eval(v any) (any, error) {
+	x, err := n.x.eval(v)
+	if err != nil {
+		return nil, err
+	}
+	if n.op == "&&" || n.op == "||" {
+		b, ok := x.(bool)
+		if !ok {
+			return nil, errorAt(n.pos, "cannot apply %v to %v", n.op, describeKind(x))
+		}
+		if b == (n.op == "||") {
+			return b, nil
+		}
+		y, err := n.y.eval(v)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := y.(bool); !ok {
+			return nil, errorAt(n.pos, "cannot apply %v to %v", n.op, describeKind(y))
+		}
+		return y, nil
+	}
+
+	y, err := n.y.eval(v)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "==":
+		return equal(x, y), nil
+	case "!=":
+		return !equal(x, y), nil
+	case "in":
+		return n.in(x, y)
+	}
+
+	mismatch := func() (any, error) {
+		return nil, errorAt(n.pos, "cannot apply %v to %v and %v", n.op, describeKind(x), describeKind(y))
+	}
+	if xs, ok := x.(string); ok {
+		ys, ok := y.(string)
+		if !ok {
+			return mismatch()
+		}
+		switch n.op {
+		case "<":
+			return xs < ys, nil
+		case "<=":
+			return xs <= ys, nil
+		case ">":
+			return xs > ys, nil
+		case ">=":
+			return xs >= ys, nil
+		case "+":
+			return xs + ys, nil
+		}
+		return mismatch()
+	}
+	xf, ok := x.(float64)
+	yf, ok2 := y.(float64)
+	if !ok || !ok2 {
+		return mismatch()
+	}
+	switch n.op {
+	case "<":
+		return xf < yf, nil
+	case "<=":
+		return xf <= yf, nil
+	case ">":
+		return xf > yf, nil
+	case ">=":
+		return xf >= yf, nil
+	case "+":
+		return xf + yf, nil
+	case "-":
+		return xf - yf, nil
+	case "*":
+		return xf * yf, nil
+	case "/":
+		if yf == 0 {
+			return nil, errorAt(n.pos, "division by zero")
+		}
+		return xf / yf, nil
+	case "%":
+		if yf == 0 {
+			return nil, errorAt(n.pos, "division by zero")
+		}
+		return math.Mod(xf, yf), nil
+	}
+	return mismatch()
+}
+
+// in reports whether x is an element of the list y, a key of the map y, or a
+// substring of the string y.
+func (n *binary) in(x, y any) (any, error) {
+	found, err := contains(y, x)
+	if err != nil {
+		return nil, errorAt(n.pos, "%v", err)
+	}
+	return found, nil
+}
+
+// contains reports whether x is an element of the list y, a key of the map y,
+// or a substring of the string y. Nothing is in nil.
+func contains(y, x any) (bool, error) {
+	if ys, ok := y.(string); ok {
+		xs, ok := x.(string)
+		if !ok {
+			return false, fmt.Errorf("cannot look for %v in string", describeKind(x))
+		}
+		return strings.Contains(ys, xs), nil
+	}
+	rv := reflect.ValueOf(y)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			if equal(x, normalize(rv.Index(i))) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case reflect.Map:
+		for _, k := range rv.MapKeys() {
+			if equal(x, normalize(k)) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case reflect.Invalid:
+		return false, nil
+	}
+	return false, fmt.Errorf("cannot look for a value in %v", describeKind(y))
+}
+
+// call is a call of one of the built in functions.
+type call struct {
+	pos  int
+	fn   function
+	args []node
+}
+
+func (n *call) eval(v any) (any, error) {
+	args := make([]any, len(n.args))
+	for i, arg := range n.args {
+		x, err := arg.eval(v)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = x
+	}
+	result, err := n.fn.call(args)
+	if err != nil {
+		return nil, errorAt(n.pos, "%v: %v", n.fn.name, err)
+	}
+	return result, nil
+}
+
+// function is a built in function.
+type function struct {
+	name  string
+	arity int
+	call  func(args []any) (any, error)
+}
+
+// functions holds the built in functions, by name.
+var functions = map[string]function{}
+
+func init() {
+	for _, fn := range []function{
+		{"len", 1, length},
+		{"lower", 1, stringFunction(strings.ToLower)},
+		{"upper", 1, stringFunction(strings.ToUpper)},
+		{"trim", 1, stringFunction(strings.TrimSpace)},
+		{"string", 1, func(args []any) (any, error) { return fmt.Sprint(args[0]), nil }},
+		{"contains", 2, func(args []any) (any, error) { return contains(args[0], args[1]) }},
+		{"startsWith", 2, stringsFunction(strings.HasPrefix)},
+		{"endsWith", 2, stringsFunction(strings.HasSuffix)},
+	} {
+		functions[fn.name] = fn
+	}
+}
+
+// length returns the number of characters in a string, elements in a list or
+// entries in a map. The length of nil is zero.
+func length(args []any) (any, error) {
+	switch x := args[0].(type) {
+	case nil:
+		return 0.0, nil
+	case string:
+		return float64(utf8.RuneCountInString(x)), nil
+	}
+	switch rv := reflect.ValueOf(args[0]); rv.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return float64(rv.Len()), nil
+	}
+	return nil, fmt.Errorf("cannot take the length of %v", describeKind(args[0]))
+}
+
+func stringFunction(fn func(string) string) func([]any) (any, error) {
+	return func(args []any) (any, error) {
+		s, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string, not %v", describeKind(args[0]))
+		}
+		return fn(s), nil
+	}
+}
+
+func stringsFunction(fn func(s, t string) bool) func([]any) (any, error) {
+	return func(args []any) (any, error) {
+		s, ok := args[0].(string)
+		t, ok2 := args[1].(string)
+		if !ok || !ok2 {
+			return nil, fmt.Errorf("expected two strings, not %v and %v", describeKind(args[0]), describeKind(args[1]))
+		}
+		return fn(s, t), nil
+	}
+}
+
+// normalize returns the value held by v in the form in which expressions
+// handle it: nil for nil pointers and interfaces, which are otherwise
+// followed, and a bool, float64 or string for a boolean, number or string of
+// any type. Other values are returned as they are.
+func normalize(v reflect.Value) any {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.String:
+		return v.String()
+	}
+	if !v.CanInterface() {
+		return nil
+	}
+	return v.Interface()
+}
+
+// equal reports whether the normalized values x and y are equal. Lists are
+// equal if their elements are.
+func equal(x, y any) bool {
+	switch x.(type) {
+	case nil, bool, float64, string:
+		return x == y
+	}
+	xv, yv := reflect.ValueOf(x), reflect.ValueOf(y)
+	if isList(xv) && isList(yv) {
+		if xv.Len() != yv.Len() {
+			return false
+		}
+		for i := 0; i < xv.Len(); i++ {
+			if !equal(normalize(xv.Index(i)), normalize(yv.Index(i))) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+func isList(v reflect.Value) bool {
+	return v.Kind() == reflect.Slice || v.Kind() == reflect.Array
+}
+
+// describeKind returns a description of the kind of a normalized value, for
+// use in errors.
+func describeKind(v any) string {
+	switch v.(type) {
+	case nil:
+		return "nil"
+	case bool:
+		return "bool"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	}
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.Slice, reflect.Array:
+		return "list"
+	case reflect.Map:
+		return "map"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// plural returns n and noun, with noun made plural unless n is one.
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%v %v", n, noun)
+	}
+	return fmt.Sprintf("%v %vs", n, noun)
+}
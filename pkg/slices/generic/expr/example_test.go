@@ -0,0 +1,30 @@
+package expr_test
+
+import (
+	"fmt"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic"
+	"github.com/ideoterra/transforms/pkg/slices/generic/expr"
+)
+
+func ExampleExpr_Condition() {
+	tickets := []interface{}{
+		map[string]interface{}{"id": 1, "status": "open", "tags": []string{"billing"}},
+		map[string]interface{}{"id": 2, "status": "closed", "tags": []string{}},
+		map[string]interface{}{"id": 3, "status": "open", "tags": []string{"urgent", "billing", "vip"}},
+	}
+	closed, err := expr.Compile(`.status != "open" || len(.tags) < 2`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	generic.Filter(&tickets, closed.Condition())
+	fmt.Println(generic.Map(tickets, expr.MustCompile(`.id`).Mapper()))
+	// Output: [3]
+}
+
+func ExampleCompile() {
+	_, err := expr.Compile(`.status == "open" && .amount >`)
+	fmt.Println(err)
+	// Output: expr: 1:31: unexpected end of expression, expected an operand
+}
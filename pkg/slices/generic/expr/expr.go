@@ -0,0 +1,175 @@
+// Package expr compiles a small expression language into ConditionFns,
+// mapping functions and groupers, so that transforms such as Filter, Map and
+// Group can be configured with text, from a configuration file for example,
+// rather than with Go closures.
+//
+// An expression is evaluated against a single value, written as a dot. The
+// fields of a struct, and the values of a map with string keys, are written
+// after a dot, and may be chained:
+//
+//	.status == "open" && .amount > 100
+//	.customer.address.country in ["NZ", "AU"]
+//	len(.tags) > 2 || .tags[0] == "urgent"
+//
+// A struct field is found by its name, by the name given it by a json tag, or
+// by its name without regard to case, in that order, so .status finds a field
+// Status. Pointers and interfaces are followed. A missing map key, an index
+// out of range, and any field of nil are nil, so optional data can be tested
+// with == nil; a struct field that does not exist is an error.
+//
+// Values are nil, booleans, numbers, strings, lists, maps and structs. Numbers
+// of every type are converted to float64, so 1 == 1.0, and the results of Eval
+// and Mapper are float64 wherever they are numbers. Strings may be quoted with
+// double quotes or single quotes, which accept Go's escape sequences, or with
+// backquotes.
+//
+// The operators, from lowest to highest precedence, are
+//
+//	||
+//	&&
+//	==  !=  <  <=  >  >=  in
+//	+  -
+//	*  /  %
+//	!  -  (unary)
+//	.field  [index]
+//
+// Comparisons do not chain. == and != compare values of any kind, and values
+// of different kinds are unequal. < and the like compare two numbers or two
+// strings, and + adds numbers or concatenates strings. x in y reports whether
+// x is an element of the list y, a key of the map y, or a substring of the
+// string y. A negative index counts back from the end of a list or string.
+//
+// The functions are len (of a string, in characters, or of a list or map),
+// lower, upper, trim, string (the fmt.Sprint representation of any value),
+// contains(y, x) (as x in y), startsWith and endsWith.
+//
+// Errors in an expression, whether found when it is compiled or when it is
+// evaluated, are reported as an *Error giving the line and column at which
+// the problem lies.
+package expr
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic/closures"
+)
+
+// Expr is a compiled expression. It may be evaluated concurrently.
+type Expr struct {
+	src  string
+	root node
+}
+
+// Compile parses src as an expression. If it is not valid, the error is an
+// *Error.
+func Compile(src string) (*Expr, error) {
+	root, err := parse(src)
+	if err != nil {
+		return nil, err
+	}
+	return &Expr{src: src, root: root}, nil
+}
+
+// MustCompile is like Compile, but panics if src is not a valid expression.
+func MustCompile(src string) *Expr {
+	e, err := Compile(src)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// String returns the source of the expression.
+func (e *Expr) String() string {
+	return e.src
+}
+
+// Eval evaluates the expression against v. If evaluation fails, the error is
+// an *Error.
+func (e *Expr) Eval(v interface{}) (any, error) {
+	result, err := e.root.eval(v)
+	if err != nil {
+		if err, ok := err.(*Error); ok {
+			err.locate(e.src)
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// Condition returns a ConditionFn that is met by values for which the
+// expression is true. The ConditionFn panics with an *Error if evaluation
+// fails, or if the expression is not a boolean.
+func (e *Expr) Condition() closures.ConditionFn {
+	return func(a interface{}) bool {
+		result := e.mustEval(a)
+		b, ok := result.(bool)
+		if !ok {
+			err := errorAt(0, "expression is %v, not bool", describeKind(result))
+			err.locate(e.src)
+			panic(err)
+		}
+		return b
+	}
+}
+
+// Mapper returns a function, for use with Map, that maps each value to the
+// result of the expression. The function panics with an *Error if evaluation
+// fails.
+func (e *Expr) Mapper() func(interface{}) interface{} {
+	return func(a interface{}) interface{} {
+		return e.mustEval(a)
+	}
+}
+
+// Grouper returns a grouper, for use with Group, that groups values by the
+// fmt.Sprint representation of the result of the expression. The grouper
+// panics with an *Error if evaluation fails.
+func (e *Expr) Grouper() func(interface{}) string {
+	return func(a interface{}) string {
+		return fmt.Sprint(e.mustEval(a))
+	}
+}
+
+func (e *Expr) mustEval(v interface{}) any {
+	result, err := e.Eval(v)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// Error describes a problem with an expression and where in its source the
+// problem lies.
+type Error struct {
+	Offset int // the offset of the problem in the source, in bytes
+	Line   int // the line of the problem, from 1
+	Column int // the column of the problem, in characters, from 1
+	Msg    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("expr: %v:%v: %v", e.Line, e.Column, e.Msg)
+}
+
+// newError returns an *Error at the byte offset pos of src.
+func newError(src string, pos int, format string, args ...any) *Error {
+	err := errorAt(pos, format, args...)
+	err.locate(src)
+	return err
+}
+
+// errorAt returns an *Error at the byte offset pos of a source yet to be
+// located.
+func errorAt(pos int, format string, args ...any) *Error {
+	return &Error{Offset: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// locate sets the line and column of e from its offset within src.
+func (e *Error) locate(src string) {
+	before := src[:e.Offset]
+	e.Line = strings.Count(before, "\n") + 1
+	e.Column = utf8.RuneCountInString(before[strings.LastIndex(before, "\n")+1:]) + 1
+}
@@ -0,0 +1,234 @@
+package expr_test
+
+import (
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic"
+	"github.com/ideoterra/transforms/pkg/slices/generic/expr"
+	"github.com/stretchr/testify/assert"
+)
+
+type address struct {
+	Country string
+}
+
+type customer struct {
+	Name    string
+	Address *address
+}
+
+type order struct {
+	ID       int    `json:"id"`
+	Status   string `json:"state"`
+	Amount   float32
+	Tags     []string
+	Customer customer
+	Notes    map[string]string
+	Extra    interface{}
+}
+
+var sampleOrder = order{
+	ID:       7,
+	Status:   "open",
+	Amount:   150,
+	Tags:     []string{"urgent", "gift"},
+	Customer: customer{Name: "Riley", Address: &address{Country: "NZ"}},
+	Notes:    map[string]string{"gift wrap": "yes"},
+	Extra:    int8(3),
+}
+
+var sampleMap = map[string]interface{}{
+	"status": "open",
+	"amount": 150,
+	"tags":   []interface{}{"urgent", "gift", "fragile"},
+	"owner":  nil,
+	"nested": map[string]interface{}{"n": uint(2)},
+}
+
+func TestEval(t *testing.T) {
+	type evaluation struct {
+		src   string
+		value interface{}
+		want  any
+	}
+	evaluations := []evaluation{
+		{`.status == "open" && .amount > 100`, sampleMap, true},
+		{`.status == "open" && .amount > 100`, sampleOrder, true},
+		{`.Status == 'open'`, sampleOrder, true},
+		{`.state == "open"`, sampleOrder, true},
+		{`.id + 1`, sampleOrder, 8.0},
+		{`len(.tags) > 2`, sampleMap, true},
+		{`len(.tags) > 2`, sampleOrder, false},
+		{`.tags[0]`, sampleOrder, "urgent"},
+		{`.tags[-1]`, sampleMap, "fragile"},
+		{`.tags[5]`, sampleMap, nil},
+		{`.customer.address.country in ["NZ", "AU"]`, sampleOrder, true},
+		{`.customer.name[0]`, sampleOrder, "R"},
+		{`.notes["gift wrap"]`, sampleOrder, "yes"},
+		{`"gift wrap" in .notes`, sampleOrder, true},
+		{`"gift" in .tags`, sampleOrder, true},
+		{`"pen" in .status`, sampleOrder, true},
+		{`.extra * 2`, sampleOrder, 6.0},
+		{`.nested.n == 2`, sampleMap, true},
+		{`.owner == nil && .missing == null && .owner.name == nil`, sampleMap, true},
+		{`.missing.deeper[3]`, sampleMap, nil},
+		{`!(.amount < 100) && -.amount == 0 - 150`, sampleMap, true},
+		{`1 + 2 * 3 - 4 / 2 % 3`, nil, 5.0},
+		{`(1 + 2) * 3`, nil, 9.0},
+		{`"a" + "b" < "b"`, nil, true},
+		{`1e3 == 1000.0`, nil, true},
+		{`1 == "1"`, nil, false},
+		{`[1, "a"] == [1.0, 'a']`, nil, true},
+		{`.tags == ["urgent", "gift"]`, sampleOrder, true},
+		{`.`, 3, 3.0},
+		{`. == "x"`, "x", true},
+		{`lower(.Status) + upper("x") + trim("  y ")`, sampleOrder, "openXy"},
+		{`string(.amount) + string(nil)`, sampleMap, "150<nil>"},
+		{`startsWith(.status, "op") && endsWith(.status, "en")`, sampleMap, true},
+		{`contains(.tags, "gift") && contains("abc", "b")`, sampleMap, true},
+		{`len("héllo") + len(.notes) + len(nil)`, sampleOrder, 6.0},
+		{`false && .nonexistent.field`, sampleOrder, false},
+		{`true || 1`, nil, true},
+		{"`raw\\n`", nil, `raw\n`},
+		{`"tab\t" == 'tab\t' && 'it\'s' == "it's"`, nil, true},
+		{`.[1]`, []int{4, 5}, 5.0},
+	}
+	for _, e := range evaluations {
+		compiled, err := expr.Compile(e.src)
+		if !assert.NoError(t, err, e.src) {
+			continue
+		}
+		result, err := compiled.Eval(e.value)
+		assert.NoError(t, err, e.src)
+		assert.Equal(t, e.want, result, e.src)
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	type failure struct {
+		src          string
+		line, column int
+		msg          string
+	}
+	failures := []failure{
+		{``, 1, 1, "unexpected end of expression, expected an operand"},
+		{`.status == "open" && .amount >`, 1, 31, "unexpected end of expression, expected an operand"},
+		{`.status == "open`, 1, 12, "unterminated string"},
+		{`.a == 1 == 2`, 1, 9, "comparisons cannot be chained; use && to combine them"},
+		{`status == "open"`, 1, 1, "unknown name status; fields are written .status"},
+		{`size(.tags)`, 1, 1, "unknown function size"},
+		{`len(.a, .b)`, 1, 1, "len takes 1 argument, not 2"},
+		{`startsWith(.a)`, 1, 1, "startsWith takes 2 arguments, not 1"},
+		{`len .a`, 1, 5, `unexpected ".", expected "(" to call len`},
+		{`(.a > 1`, 1, 8, `unexpected end of expression, expected ")" to close the parenthesis`},
+		{`[1, 2`, 1, 6, `unexpected end of expression, expected "," or "]" to close the list`},
+		{`.a[1`, 1, 5, `unexpected end of expression, expected "]" to close the index`},
+		{`.a.`, 1, 4, `unexpected end of expression, expected a field name after "."`},
+		{`.a.1`, 1, 4, `unexpected "1", expected a field name after "."`},
+		{`.a # 1`, 1, 4, `unexpected character '#'`},
+		{`.a > 1 "b"`, 1, 8, `unexpected string "b", expected an operator or end of expression`},
+		{".a > 1 &&\n  .b ==\n  ", 3, 3, "unexpected end of expression, expected an operand"},
+		{"\"héllo\" == ) ", 1, 12, `unexpected ")", expected an operand`},
+	}
+	for _, f := range failures {
+		_, err := expr.Compile(f.src)
+		if !assert.Error(t, err, f.src) {
+			continue
+		}
+		exprErr, ok := err.(*expr.Error)
+		if !assert.True(t, ok, f.src) {
+			continue
+		}
+		assert.Equal(t, f.line, exprErr.Line, f.src)
+		assert.Equal(t, f.column, exprErr.Column, f.src)
+		assert.Equal(t, f.msg, exprErr.Msg, f.src)
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	type failure struct {
+		src    string
+		value  interface{}
+		column int
+		msg    string
+	}
+	failures := []failure{
+		{`.amount > "100"`, sampleMap, 9, "cannot apply > to number and string"},
+		{`.statuss == "open"`, sampleOrder, 2, "expr_test.order has no field statuss"},
+		{`.status.length`, sampleMap, 9, "cannot get field length of string"},
+		{`.amount && true`, sampleMap, 9, "cannot apply && to number"},
+		{`true && .amount`, sampleMap, 6, "cannot apply && to number"},
+		{`!.status`, sampleMap, 1, "cannot apply ! to string"},
+		{`.tags[0.5]`, sampleMap, 6, "cannot index list with number; indexes must be whole numbers"},
+		{`.amount[0]`, sampleMap, 8, "cannot index number"},
+		{`.amount / 0`, sampleMap, 9, "division by zero"},
+		{`1 in .amount`, sampleMap, 3, "cannot look for a value in number"},
+		{`len(.amount)`, sampleMap, 1, "len: cannot take the length of number"},
+		{`  upper(.tags)`, sampleMap, 3, "upper: expected a string, not list"},
+	}
+	for _, f := range failures {
+		compiled := expr.MustCompile(f.src)
+		_, err := compiled.Eval(f.value)
+		exprErr, ok := err.(*expr.Error)
+		if !assert.True(t, ok, f.src) {
+			continue
+		}
+		assert.Equal(t, 1, exprErr.Line, f.src)
+		assert.Equal(t, f.column, exprErr.Column, f.src)
+		assert.Equal(t, f.msg, exprErr.Msg, f.src)
+	}
+}
+
+func TestErrorMessage(t *testing.T) {
+	_, err := expr.Compile(".a >\n  ")
+	assert.EqualError(t, err, "expr: 2:3: unexpected end of expression, expected an operand")
+	assert.Panics(t, func() { expr.MustCompile(".a >") })
+}
+
+func TestCondition(t *testing.T) {
+	orders := []interface{}{
+		map[string]interface{}{"status": "open", "amount": 50},
+		map[string]interface{}{"status": "open", "amount": 150},
+		map[string]interface{}{"status": "closed", "amount": 500},
+	}
+	generic.Filter(&orders, expr.MustCompile(`!(.status == "open" && .amount > 100)`).Condition())
+	assert.Equal(t, []interface{}{map[string]interface{}{"status": "open", "amount": 150}}, orders)
+
+	notBool := recovered(func() { expr.MustCompile(`.amount`).Condition()(orders[0]) })
+	assert.Equal(t, &expr.Error{Offset: 0, Line: 1, Column: 1, Msg: "expression is number, not bool"}, notBool)
+	mismatch := recovered(func() { expr.MustCompile(`.amount > "1"`).Condition()(orders[0]) })
+	assert.Equal(t, &expr.Error{Offset: 8, Line: 1, Column: 9, Msg: "cannot apply > to number and string"}, mismatch)
+}
+
+// recovered returns the value with which f panics.
+func recovered(f func()) (v interface{}) {
+	defer func() { v = recover() }()
+	f()
+	return nil
+}
+
+func TestMapper(t *testing.T) {
+	orders := []interface{}{sampleOrder, order{ID: 8, Status: "closed"}}
+	ids := generic.Map(orders, expr.MustCompile(`.id`).Mapper())
+	assert.Equal(t, []interface{}{7.0, 8.0}, ids)
+	assert.Panics(t, func() { expr.MustCompile(`.id / 0`).Mapper()(sampleOrder) })
+}
+
+func TestGrouper(t *testing.T) {
+	orders := []interface{}{
+		map[string]interface{}{"status": "open", "amount": 50},
+		map[string]interface{}{"status": "closed", "amount": 150},
+		map[string]interface{}{"status": "open", "amount": 500},
+	}
+	groups := generic.Group(orders, expr.MustCompile(`.amount > 100`).Grouper())
+	assert.ElementsMatch(t, generic.SliceType2{
+		[]interface{}{orders[0]},
+		[]interface{}{orders[1], orders[2]},
+	}, groups)
+	assert.Equal(t, "true", expr.MustCompile(`.amount > 100`).Grouper()(orders[1]))
+	assert.Equal(t, "open", expr.MustCompile(`.status`).Grouper()(orders[0]))
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, `.a == 1`, expr.MustCompile(`.a == 1`).String())
+}
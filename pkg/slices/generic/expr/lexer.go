@@ -0,0 +1,178 @@
+package expr
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// tokenKind distinguishes the tokens of an expression.
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenNumber
+	tokenString
+	tokenOperator
+)
+
+// token is a single token of an expression, occupying the bytes of the source
+// from offset pos up to end.
+type token struct {
+	kind     tokenKind
+	text     string // the source text, or for a string, its unquoted value
+	num      float64
+	pos, end int
+}
+
+// describe returns a description of t, for use in errors.
+func (t token) describe() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of expression"
+	case tokenString:
+		return "string " + strconv.Quote(t.text)
+	}
+	return strconv.Quote(t.text)
+}
+
+// operators lists the operators and punctuation of the language, longest
+// first, so that "<=" is scanned in preference to "<".
+var operators = []string{
+	"==", "!=", "<=", ">=", "&&", "||",
+	"<", ">", "!", "+", "-", "*", "/", "%", ".", ",", "(", ")", "[", "]",
+}
+
+// scan divides src into tokens, ending with a tokenEOF.
+func scan(src string) ([]token, error) {
+	tokens := []token{}
+	pos := 0
+	for {
+		for pos < len(src) {
+			r, size := utf8.DecodeRuneInString(src[pos:])
+			if !unicode.IsSpace(r) {
+				break
+			}
+			pos += size
+		}
+		if pos == len(src) {
+			return append(tokens, token{kind: tokenEOF, pos: pos, end: pos}), nil
+		}
+
+		t, err := scanToken(src, pos)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, t)
+		pos = t.end
+	}
+}
+
+// scanToken scans the token that begins at byte offset pos of src.
+func scanToken(src string, pos int) (token, error) {
+	rest := src[pos:]
+	r, _ := utf8.DecodeRuneInString(rest)
+	switch {
+	case r == '"' || r == '\'' || r == '`':
+		n := stringLength(rest)
+		if n < 0 {
+			return token{}, newError(src, pos, "unterminated string")
+		}
+		s, err := unquote(rest[:n])
+		if err != nil {
+			return token{}, newError(src, pos, "invalid string %v", rest[:n])
+		}
+		return token{kind: tokenString, text: s, pos: pos, end: pos + n}, nil
+	case r >= '0' && r <= '9':
+		n := numberLength(rest)
+		f, err := strconv.ParseFloat(rest[:n], 64)
+		if err != nil {
+			return token{}, newError(src, pos, "invalid number %v", rest[:n])
+		}
+		return token{kind: tokenNumber, text: rest[:n], num: f, pos: pos, end: pos + n}, nil
+	case r == '_' || unicode.IsLetter(r):
+		n := strings.IndexFunc(rest, func(r rune) bool {
+			return r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		if n < 0 {
+			n = len(rest)
+		}
+		return token{kind: tokenIdent, text: rest[:n], pos: pos, end: pos + n}, nil
+	}
+	for _, op := range operators {
+		if strings.HasPrefix(rest, op) {
+			return token{kind: tokenOperator, text: op, pos: pos, end: pos + len(op)}, nil
+		}
+	}
+	return token{}, newError(src, pos, "unexpected character %q", r)
+}
+
+// stringLength returns the length in bytes of the quoted string with which s
+// begins, or -1 if it is not terminated.
+func stringLength(s string) int {
+	quote := s[0]
+	for i := 1; i < len(s); i++ {
+		switch {
+		case s[i] == quote:
+			return i + 1
+		case s[i] == '\\' && quote != '`':
+			i++
+		case s[i] == '\n' && quote != '`':
+			return -1
+		}
+	}
+	return -1
+}
+
+// numberLength returns the length in bytes of the number with which s begins:
+// digits, optionally followed by a fraction and an exponent.
+func numberLength(s string) int {
+	digits := func(i int) int {
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		return i
+	}
+	n := digits(0)
+	if n+1 < len(s) && s[n] == '.' && s[n+1] >= '0' && s[n+1] <= '9' {
+		n = digits(n + 1)
+	}
+	if n < len(s) && (s[n] == 'e' || s[n] == 'E') {
+		m := n + 1
+		if m < len(s) && (s[m] == '+' || s[m] == '-') {
+			m++
+		}
+		if end := digits(m); end > m {
+			n = end
+		}
+	}
+	return n
+}
+
+// unquote returns the value of a string quoted with double quotes, single
+// quotes or backquotes. Double and single quoted strings may contain the escape
+// sequences of Go's interpreted string literals.
+func unquote(quoted string) (string, error) {
+	if quoted[0] != '\'' {
+		return strconv.Unquote(quoted)
+	}
+	body := quoted[1 : len(quoted)-1]
+	var b strings.Builder
+	for i := 0; i < len(body); i++ {
+		switch {
+		case body[i] == '\\' && i+1 < len(body) && body[i+1] == '\'':
+			b.WriteByte('\'')
+			i++
+		case body[i] == '\\' && i+1 < len(body):
+			b.WriteString(body[i : i+2])
+			i++
+		case body[i] == '"':
+			b.WriteString(`\"`)
+		default:
+			b.WriteByte(body[i])
+		}
+	}
+	return strconv.Unquote(`"` + b.String() + `"`)
+}
@@ -0,0 +1,279 @@
+package expr
+
+import "strconv"
+
+// parser builds the tree of nodes for an expression from its tokens, by
+// recursive descent, with one function for each level of precedence.
+type parser struct {
+	src    string
+	tokens []token
+	next   int
+}
+
+// parse parses the whole of src as an expression.
+func parse(src string) (node, error) {
+	tokens, err := scan(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{src: src, tokens: tokens}
+	n, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		if isComparison(t) {
+			return nil, p.errorf(t, "comparisons cannot be chained; use && to combine them")
+		}
+		return nil, p.errorf(t, "unexpected %v, expected an operator or end of expression", t.describe())
+	}
+	return n, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.next]
+}
+
+func (p *parser) advance() token {
+	t := p.tokens[p.next]
+	if t.kind != tokenEOF {
+		p.next++
+	}
+	return t
+}
+
+// accept advances past the next token and returns true if it is the operator
+// op.
+func (p *parser) accept(op string) bool {
+	if t := p.peek(); t.kind == tokenOperator && t.text == op {
+		p.advance()
+		return true
+	}
+	return false
+}
+
+// expect advances past the next token, which must be the operator op.
+func (p *parser) expect(op string, context string) error {
+	if !p.accept(op) {
+		t := p.peek()
+		return p.errorf(t, "unexpected %v, expected %q %v", t.describe(), op, context)
+	}
+	return nil
+}
+
+func (p *parser) errorf(t token, format string, args ...any) error {
+	return newError(p.src, t.pos, format, args...)
+}
+
+// binaryLevel parses a sequence of operands, as parsed by operand, separated
+// by any of ops, which associate to the left.
+func (p *parser) binaryLevel(operand func() (node, error), ops ...string) (node, error) {
+	x, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if t.kind != tokenOperator || !oneOf(t.text, ops...) {
+			return x, nil
+		}
+		p.advance()
+		y, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		x = &binary{pos: t.pos, op: t.text, x: x, y: y}
+	}
+}
+
+func (p *parser) or() (node, error) {
+	return p.binaryLevel(p.and, "||")
+}
+
+func (p *parser) and() (node, error) {
+	return p.binaryLevel(p.comparison, "&&")
+}
+
+// comparison parses an additive expression, optionally compared with another.
+// Comparisons do not associate, so a < b < c is an error.
+func (p *parser) comparison() (node, error) {
+	x, err := p.additive()
+	if err != nil {
+		return nil, err
+	}
+	t := p.peek()
+	if !isComparison(t) {
+		return x, nil
+	}
+	p.advance()
+	y, err := p.additive()
+	if err != nil {
+		return nil, err
+	}
+	return &binary{pos: t.pos, op: t.text, x: x, y: y}, nil
+}
+
+func isComparison(t token) bool {
+	switch {
+	case t.kind == tokenOperator:
+		return oneOf(t.text, "==", "!=", "<", "<=", ">", ">=")
+	case t.kind == tokenIdent:
+		return t.text == "in"
+	}
+	return false
+}
+
+func (p *parser) additive() (node, error) {
+	return p.binaryLevel(p.multiplicative, "+", "-")
+}
+
+func (p *parser) multiplicative() (node, error) {
+	return p.binaryLevel(p.unary, "*", "/", "%")
+}
+
+func (p *parser) unary() (node, error) {
+	t := p.peek()
+	if t.kind == tokenOperator && (t.text == "!" || t.text == "-") {
+		p.advance()
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &unary{pos: t.pos, op: t.text, x: x}, nil
+	}
+	return p.postfix()
+}
+
+// postfix parses a primary expression followed by any number of field
+// accesses and indexes.
+func (p *parser) postfix() (node, error) {
+	x, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		switch {
+		case p.accept("."):
+			name := p.advance()
+			if name.kind != tokenIdent {
+				return nil, p.errorf(name, "unexpected %v, expected a field name after \".\"", name.describe())
+			}
+			x = &field{pos: name.pos, x: x, name: name.text}
+		case p.accept("["):
+			i, err := p.or()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("]", "to close the index"); err != nil {
+				return nil, err
+			}
+			x = &index{pos: t.pos, x: x, i: i}
+		default:
+			return x, nil
+		}
+	}
+}
+
+func (p *parser) primary() (node, error) {
+	t := p.advance()
+	switch t.kind {
+	case tokenNumber:
+		return &literal{v: t.num}, nil
+	case tokenString:
+		return &literal{v: t.text}, nil
+	case tokenIdent:
+		return p.identifier(t)
+	case tokenEOF:
+		return nil, p.errorf(t, "unexpected end of expression, expected an operand")
+	}
+
+	switch t.text {
+	case ".":
+		// A lone dot is the value itself, and a dot followed by a name is a
+		// field of it. Any index is left to postfix.
+		if name := p.peek(); name.kind == tokenIdent && name.pos == t.end {
+			p.advance()
+			return &field{pos: name.pos, x: &this{}, name: name.text}, nil
+		}
+		return &this{}, nil
+	case "(":
+		x, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")", "to close the parenthesis"); err != nil {
+			return nil, err
+		}
+		return x, nil
+	case "[":
+		elems, err := p.list("]", "to close the list")
+		if err != nil {
+			return nil, err
+		}
+		return &list{elems: elems}, nil
+	}
+	return nil, p.errorf(t, "unexpected %v, expected an operand", t.describe())
+}
+
+// identifier parses a keyword or a function call beginning with t.
+func (p *parser) identifier(t token) (node, error) {
+	switch t.text {
+	case "true":
+		return &literal{v: true}, nil
+	case "false":
+		return &literal{v: false}, nil
+	case "nil", "null":
+		return &literal{v: nil}, nil
+	}
+
+	fn, ok := functions[t.text]
+	if !ok {
+		if p.peek().text == "(" {
+			return nil, p.errorf(t, "unknown function %v", t.text)
+		}
+		return nil, p.errorf(t, "unknown name %v; fields are written .%v", t.text, t.text)
+	}
+	if err := p.expect("(", "to call "+t.text); err != nil {
+		return nil, err
+	}
+	args, err := p.list(")", "to close the call of "+t.text)
+	if err != nil {
+		return nil, err
+	}
+	if len(args) != fn.arity {
+		return nil, p.errorf(t, "%v takes %v, not %v", t.text, plural(fn.arity, "argument"), len(args))
+	}
+	return &call{pos: t.pos, fn: fn, args: args}, nil
+}
+
+// list parses a comma separated list of expressions, up to and including the
+// operator end.
+func (p *parser) list(end, context string) ([]node, error) {
+	nodes := []node{}
+	if p.accept(end) {
+		return nodes, nil
+	}
+	for {
+		n, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+		if p.accept(end) {
+			return nodes, nil
+		}
+		if err := p.expect(",", "or "+strconv.Quote(end)+" "+context); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func oneOf(s string, ss ...string) bool {
+	for _, x := range ss {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
@@ -1,18 +1,22 @@
 package generic
 
 import (
+	"container/heap"
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"math/bits"
 	"sort"
+	"strings"
 	"sync"
 
+	"github.com/ideoterra/transforms/pkg/slices/generic/closures"
 	"github.com/ideoterra/transforms/pkg/slices/shared"
 )
 
 // All applies a test function to each element in the slice, and returns true if
 // the test function returns true for all items in the slice.
-func All(aa []interface{}, test func(interface{}) bool) bool {
+func All(aa []interface{}, test closures.ConditionFn) bool {
 	for _, s := range aa {
 		if !test(s) {
 			return false
@@ -21,14 +25,22 @@ func All(aa []interface{}, test func(interface{}) bool) bool {
 	return true
 }
 
+// AllS returns true if search returns zero for every element of aa, which
+// must be sorted as described by closures.SearchFn. Only the first and last
+// elements are examined, so AllS takes constant time. Like All, AllS returns
+// true if aa is empty.
+func AllS(aa []interface{}, search closures.SearchFn) bool {
+	return len(aa) == 0 || search(aa[0]) == 0 && search(aa[len(aa)-1]) == 0
+}
+
 // Any applies a test function to each element of the
 // slice and returns true if the test function returns true for at least one
 // item in the list.
 //
 // Any does not require that the source slice be sorted, and merely scans
 // the slice, returning as soon as any element passes the supplied test. For
-// a binary search, consider using sort.Search from the standard library.
-func Any(aa []interface{}, test func(interface{}) bool) bool {
+// a binary search of a sorted slice, see AnyS.
+func Any(aa []interface{}, test closures.ConditionFn) bool {
 	for _, a := range aa {
 		if test(a) {
 			return true
@@ -37,6 +49,30 @@ func Any(aa []interface{}, test func(interface{}) bool) bool {
 	return false
 }
 
+// AnyS returns true if search returns zero for at least one element of aa,
+// which must be sorted as described by closures.SearchFn. AnyS finds the
+// element by binary search, so calls search O(log n) times.
+func AnyS(aa []interface{}, search closures.SearchFn) bool {
+	i := searchFirst(aa, search)
+	return i < len(aa) && search(aa[i]) == 0
+}
+
+// searchFirst returns the index of the first element of aa for which search
+// is not negative, or len(aa) if there is none.
+func searchFirst(aa []interface{}, search closures.SearchFn) int {
+	return sort.Search(len(aa), func(i int) bool {
+		return search(aa[i]) >= 0
+	})
+}
+
+// searchEnd returns the index of the first element of aa for which search is
+// positive, or len(aa) if there is none.
+func searchEnd(aa []interface{}, search closures.SearchFn) int {
+	return sort.Search(len(aa), func(i int) bool {
+		return search(aa[i]) > 0
+	})
+}
+
 //Append adds the supplied values to the end of the slice.
 func Append(aa *[]interface{}, values ...interface{}) {
 	*aa = append(*aa, values...)
@@ -86,7 +122,7 @@ func Collect(aa []interface{}, bb []interface{}, collector func(a, b interface{}
 
 // Count applies the supplied test function to each element of the slice,
 // and returns the count of items for which the test returns true.
-func Count(aa []interface{}, test func(interface{}) bool) int64 {
+func Count(aa []interface{}, test closures.ConditionFn) int64 {
 	matches := int64(0)
 	for _, a := range aa {
 		if test(a) {
@@ -96,6 +132,14 @@ func Count(aa []interface{}, test func(interface{}) bool) int64 {
 	return matches
 }
 
+// CountS returns the count of elements of aa for which search returns zero.
+// aa must be sorted as described by closures.SearchFn, so that those elements
+// lie together, and CountS finds either end of them by binary search, calling
+// search O(log n) times.
+func CountS(aa []interface{}, search closures.SearchFn) int64 {
+	return int64(searchEnd(aa, search) - searchFirst(aa, search))
+}
+
 // Dequeue returns a []interface{} containing the head item from the source slice.
 // The head item is removed from the source slice in this operation. If the
 // source slice is initially empty, the resulting slice will also be empty.
@@ -120,37 +164,140 @@ func Dequeue(aa *[]interface{}) []interface{} {
 //   bb: [5,4,3,5]
 //   equal: func(a, b) bool {return a == b}
 //   Difference(aa, bb, equality) -> [1,2,1,5,5]
-func Difference(aa, bb []interface{}, equality func(a, b interface{}) bool) []interface{} {
-	ii := make([]bool, len(aa))
-	jj := make([]bool, len(bb))
-	for i, a := range aa {
-		for j, b := range bb {
-			if equality(a, b) {
-				ii[i] = true
-				jj[j] = true
-			}
+func Difference(aa, bb []interface{}, equality closures.EqualityFn) []interface{} {
+	aa1, bb1 := removeIntersections(aa, bb, equality)
+	return append(aa1, bb1...)
+}
+
+// DifferenceS returns the elements of aa that are not common to bb, followed
+// by those of bb that are not common to aa, as Difference does, retaining
+// duplicates. aa and bb must both be sorted in the order given by compare,
+// which is used in place of an equality function, and are merged in linear
+// time.
+func DifferenceS(aa, bb []interface{}, compare closures.Comparator) []interface{} {
+	aa1, bb1 := removeIntersectionsS(aa, bb, compare)
+	return append(aa1, bb1...)
+}
+
+// DifferenceAuto returns the same result as Difference, comparing elements
+// with closures.AutoEquality, so that elements that implement closures.Equaler
+// or closures.Comparer decide their own equality.
+func DifferenceAuto(aa, bb []interface{}) []interface{} {
+	return Difference(aa, bb, closures.AutoEquality())
+}
+
+// DifferenceH returns the same result as Difference, in the same order. Rather
+// than compare every element of aa with every element of bb, it compares each
+// element only with those whose hash, as given by the supplied hasher, is the
+// same, so it runs in linear time for a hasher that spreads elements well.
+// Elements that are equal must have the same hash.
+func DifferenceH(aa, bb []interface{}, hasher closures.Hasher, equality closures.EqualityFn) []interface{} {
+	aaIndex := newHashIndex(aa, hasher)
+	bbIndex := newHashIndex(bb, hasher)
+
+	cc := []interface{}{}
+	for _, a := range aa {
+		if !bbIndex.any(a, func(b interface{}) bool { return equality(a, b) }) {
+			cc = append(cc, a)
 		}
 	}
+	for _, b := range bb {
+		if !aaIndex.any(b, func(a interface{}) bool { return equality(a, b) }) {
+			cc = append(cc, b)
+		}
+	}
+	return cc
+}
+
+// DifferenceT returns the elements of aa that are not equal to any element of
+// bb, followed by those of bb not equal to any element of aa, as Difference
+// does. Equality may be approximate: the elements of both slices are divided
+// into clusters, as described in the package documentation, and an element is
+// returned if no element of the other slice shares its cluster.
+func DifferenceT(aa, bb []interface{}, less closures.LessFn, equality closures.EqualityFn) []interface{} {
+	cluster := clusters(append(append([]interface{}{}, aa...), bb...), less, equality)
+	inAA, inBB := map[int]bool{}, map[int]bool{}
+	for i := range aa {
+		inAA[cluster[i]] = true
+	}
+	for j := range bb {
+		inBB[cluster[len(aa)+j]] = true
+	}
 
 	cc := []interface{}{}
 	for i, a := range aa {
-		if !ii[i] {
+		if !inBB[cluster[i]] {
 			cc = append(cc, a)
 		}
 	}
-
 	for j, b := range bb {
-		if !jj[j] {
+		if !inAA[cluster[len(aa)+j]] {
 			cc = append(cc, b)
 		}
 	}
-
 	return cc
 }
 
+// clusters sorts the elements of aa by less, and divides them into clusters,
+// each made up of the least element not yet clustered and the elements after it
+// that are equal to it. It returns the cluster of each element of aa, numbered
+// in order from 0. Elements are clustered alike whatever their order in aa, so
+// long as less orders any two elements that are not equal.
+func clusters(aa []interface{}, less closures.LessFn, equality closures.EqualityFn) []int {
+	order := make([]int, len(aa))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return less(aa[order[i]], aa[order[j]])
+	})
+
+	cluster := make([]int, len(aa))
+	least, n := 0, -1
+	for _, i := range order {
+		if n < 0 || !equality(aa[least], aa[i]) {
+			least = i
+			n++
+		}
+		cluster[i] = n
+	}
+	return cluster
+}
+
+// hashIndex groups elements by their hashes, so that those equal to some other
+// value may be found without comparing every element with it.
+type hashIndex struct {
+	hasher  closures.Hasher
+	buckets map[uint64][]interface{}
+}
+
+func newHashIndex(aa []interface{}, hasher closures.Hasher) hashIndex {
+	index := hashIndex{hasher: hasher, buckets: map[uint64][]interface{}{}}
+	for _, a := range aa {
+		index.add(a)
+	}
+	return index
+}
+
+func (index hashIndex) add(a interface{}) {
+	h := index.hasher(a)
+	index.buckets[h] = append(index.buckets[h], a)
+}
+
+// any reports whether test returns true for any element with the same hash as
+// a.
+func (index hashIndex) any(a interface{}, test func(interface{}) bool) bool {
+	for _, b := range index.buckets[index.hasher(a)] {
+		if test(b) {
+			return true
+		}
+	}
+	return false
+}
+
 // Distinct removes all duplicates from the slice, using the supplied equality
 // function to determine equality.
-func Distinct(aa *[]interface{}, equality func(a, b interface{}) bool) {
+func Distinct(aa *[]interface{}, equality closures.EqualityFn) {
 	bb := []interface{}{}
 	dups := make([]bool, len(*aa))
 	for i, a := range *aa {
@@ -167,6 +314,65 @@ func Distinct(aa *[]interface{}, equality func(a, b interface{}) bool) {
 	Append(aa, bb...)
 }
 
+// DistinctS removes all duplicates from aa, as Distinct does, keeping the
+// first of each. aa must be sorted so that equal elements are adjacent, as
+// they are in any order consistent with equality, so that each element need
+// be compared only with the last that was kept.
+//
+// If aa is not so sorted, duplicates that are not adjacent are retained.
+func DistinctS(aa *[]interface{}, equality closures.EqualityFn) {
+	bb := []interface{}{}
+	for _, a := range *aa {
+		if len(bb) == 0 || !equality(bb[len(bb)-1], a) {
+			bb = append(bb, a)
+		}
+	}
+	Clear(aa)
+	Append(aa, bb...)
+}
+
+// DistinctAuto removes all duplicates from the slice, as Distinct does,
+// comparing elements with closures.AutoEquality.
+func DistinctAuto(aa *[]interface{}) {
+	Distinct(aa, closures.AutoEquality())
+}
+
+// DistinctH removes all duplicates from the slice, as Distinct does, keeping the
+// first of each. Each element is compared, using the supplied equality
+// function, only with those whose hash, as given by the supplied hasher, is the
+// same. Elements that are equal must have the same hash.
+func DistinctH(aa *[]interface{}, hasher closures.Hasher, equality closures.EqualityFn) {
+	bb := []interface{}{}
+	kept := newHashIndex(nil, hasher)
+	for _, a := range *aa {
+		if !kept.any(a, func(b interface{}) bool { return equality(b, a) }) {
+			kept.add(a)
+			bb = append(bb, a)
+		}
+	}
+	Clear(aa)
+	Append(aa, bb...)
+}
+
+// DistinctT removes all duplicates from the slice, keeping the first element of
+// each cluster, as described in the package documentation, in the order in
+// which they appear. Unlike Distinct, which may keep a, b and c where a is
+// equal to b, and b to c, but a is not equal to c, the elements kept do not
+// depend upon the order of the slice.
+func DistinctT(aa *[]interface{}, less closures.LessFn, equality closures.EqualityFn) {
+	cluster := clusters(*aa, less, equality)
+	kept := map[int]bool{}
+	bb := []interface{}{}
+	for i, a := range *aa {
+		if !kept[cluster[i]] {
+			kept[cluster[i]] = true
+			bb = append(bb, a)
+		}
+	}
+	Clear(aa)
+	Append(aa, bb...)
+}
+
 // Empty returns true if the length of the slice is zero.
 func Empty(aa []interface{}) bool {
 	return len(aa) == 0
@@ -204,7 +410,7 @@ func Expand(aa []interface{}, expansion func(interface{}) []interface{}) []inter
 
 // Filter removes all items from the slice for which the supplied test function
 // returns true.
-func Filter(aa *[]interface{}, test func(interface{}) bool) {
+func Filter(aa *[]interface{}, test closures.ConditionFn) {
 	for i := len(*aa) - 1; i >= 0; i-- {
 		if test((*aa)[i]) {
 			RemoveAt(aa, int64(i))
@@ -214,7 +420,7 @@ func Filter(aa *[]interface{}, test func(interface{}) bool) {
 
 // FindIndex returns the index of the first element in the slice for which the
 // supplied test function returns true. If no matches are found, -1 is returned.
-func FindIndex(aa []interface{}, test func(interface{}) bool) int64 {
+func FindIndex(aa []interface{}, test closures.ConditionFn) int64 {
 	for i, a := range aa {
 		if test(a) {
 			return int64(i)
@@ -223,9 +429,21 @@ func FindIndex(aa []interface{}, test func(interface{}) bool) int64 {
 	return -1
 }
 
+// FindIndexS returns the index of the first element of aa for which search
+// returns zero, or -1 if there is none. aa must be sorted as described by
+// closures.SearchFn, and is searched by binary search, calling search
+// O(log n) times.
+func FindIndexS(aa []interface{}, search closures.SearchFn) int64 {
+	i := searchFirst(aa, search)
+	if i == len(aa) || search(aa[i]) != 0 {
+		return -1
+	}
+	return int64(i)
+}
+
 // First returns a []interface{} containing the first element in the slice for which
 // the supplied test function returns true.
-func First(aa []interface{}, test func(interface{}) bool) []interface{} {
+func First(aa []interface{}, test closures.ConditionFn) []interface{} {
 	bb := []interface{}{}
 	for _, a := range aa {
 		if test(a) {
@@ -236,11 +454,24 @@ func First(aa []interface{}, test func(interface{}) bool) []interface{} {
 	return bb
 }
 
-// Flatten takes each slice of a [][]interface{} and appends it to a new slice.
+// FirstS returns a []interface{} containing the first element of aa for which
+// search returns zero, or an empty []interface{} if there is none. aa must be
+// sorted as described by closures.SearchFn, and is searched by binary search,
+// calling search O(log n) times.
+func FirstS(aa []interface{}, search closures.SearchFn) []interface{} {
+	i := FindIndexS(aa, search)
+	if i < 0 {
+		return []interface{}{}
+	}
+	return []interface{}{aa[i]}
+}
+
+// Flatten takes each slice of a [][]interface{} and appends its elements to a
+// new slice.
 func Flatten(aa [][]interface{}) []interface{} {
 	bb := []interface{}{}
 	for _, a := range aa {
-		Append(&bb, a)
+		Append(&bb, a...)
 	}
 	return bb
 }
@@ -352,10 +583,32 @@ func ForEachR(aa []interface{}, fn func(interface{}) shared.Continue) {
 // The grouper function is expected to return a hash value (in the form of a
 // string) which Group will use to determine into which bucket each element
 // will be placed.
-func Group(aa []interface{}, grouper func(interface{}) string) []interface{} {
+func Group(aa []interface{}, grouper func(interface{}) string) SliceType2 {
 	return GroupI(aa, func(_ int64, a interface{}) string { return grouper(a) })
 }
 
+// GroupS consolidates like-items into groups according to the supplied
+// grouper function, as Group does. aa must be sorted so that the elements of
+// each group are adjacent, so that GroupS need only start a new group each
+// time the grouper's result changes. Unlike those of Group, the groups are
+// returned in the order of aa.
+//
+// If aa is not so sorted, elements of the same group that are not adjacent
+// are placed in separate groups.
+func GroupS(aa []interface{}, grouper func(interface{}) string) SliceType2 {
+	group := SliceType2{}
+	key := ""
+	for i, a := range aa {
+		if k := grouper(a); i == 0 || k != key {
+			key = k
+			group = append(group, []interface{}{a})
+		} else {
+			group[len(group)-1] = append(group[len(group)-1].([]interface{}), a)
+		}
+	}
+	return group
+}
+
 // GroupByTrait compares each item (a[i]) in the slice to every other item
 // (a[n]) using the supplied trait function. Every item a[n] who shares a trait
 // with a[i] is added to a slice that represents a group of items that express a
@@ -376,8 +629,8 @@ func Group(aa []interface{}, grouper func(interface{}) string) []interface{} {
 //			 [cat],
 //			 [dogs, dog],
 //			]
-func GroupByTrait(aa []interface{}, trait func(ai, an interface{}) bool, equality func(a, b interface{}) bool) []interface{} {
-	establishedTraits := []interface{}{}
+func GroupByTrait(aa []interface{}, trait func(ai, an interface{}) bool, equality closures.EqualityFn) SliceType2 {
+	establishedTraits := SliceType2{}
 	for _, ai := range aa {
 		potentialTrait := []interface{}{}
 		for _, an := range aa {
@@ -409,7 +662,7 @@ func GroupByTrait(aa []interface{}, trait func(ai, an interface{}) bool, equalit
 // string) which Group will use to determine into which bucket each element
 // will be placed. For convenience the index value from aa is also passed into
 // the grouper function.
-func GroupI(aa []interface{}, grouper func(int64, interface{}) string) []interface{} {
+func GroupI(aa []interface{}, grouper func(int64, interface{}) string) SliceType2 {
 	groupMap := map[string][]interface{}{}
 	for i, a := range aa {
 		hash := grouper(int64(i), a)
@@ -419,7 +672,7 @@ func GroupI(aa []interface{}, grouper func(int64, interface{}) string) []interfa
 			groupMap[hash] = []interface{}{a}
 		}
 	}
-	group := []interface{}{}
+	group := SliceType2{}
 	for _, bb := range groupMap {
 		group = append(group, bb)
 	}
@@ -438,7 +691,7 @@ func Head(aa []interface{}) []interface{} {
 // InsertAfter inserts an element in aa after the first element for which the
 // supplied test function returns true. If none of the tests return true, the
 // element is appended to the end of the aa.
-func InsertAfter(aa *[]interface{}, b interface{}, test func(interface{}) bool) {
+func InsertAfter(aa *[]interface{}, b interface{}, test closures.ConditionFn) {
 	var i int
 	var a interface{}
 	for i, a = range *aa {
@@ -452,7 +705,7 @@ func InsertAfter(aa *[]interface{}, b interface{}, test func(interface{}) bool)
 // InsertBefore inserts an element in aa before the first element for which the
 // supplied test function returns true. If none of the tests return true,
 // the element is inserted at the head of aa.
-func InsertBefore(aa *[]interface{}, b interface{}, test func(interface{}) bool) {
+func InsertBefore(aa *[]interface{}, b interface{}, test closures.ConditionFn) {
 	var i int
 	var a interface{}
 	for i, a = range *aa {
@@ -482,7 +735,7 @@ func InsertAt(aa *[]interface{}, a interface{}, i int64) {
 // Intersection compares each element of aa to bb using the supplied equal
 // function, and returns a []interface{} containing the elements which are common
 // to both aa and bb. Duplicates are removed in this operation.
-func Intersection(aa, bb []interface{}, equality func(a, b interface{}) bool) []interface{} {
+func Intersection(aa, bb []interface{}, equality closures.EqualityFn) []interface{} {
 	cc := []interface{}{}
 	ForEach(aa, func(a interface{}) shared.Continue {
 		ForEach(bb, func(b interface{}) shared.Continue {
@@ -496,63 +749,223 @@ func Intersection(aa, bb []interface{}, equality func(a, b interface{}) bool) []
 	return cc
 }
 
+// IntersectionS returns a []interface{} containing the elements which are
+// common to both aa and bb, as Intersection does, with duplicates removed. aa
+// and bb must both be sorted in the order given by compare, which is used in
+// place of an equality function, and are merged in linear time.
+func IntersectionS(aa, bb []interface{}, compare closures.Comparator) []interface{} {
+	cc := []interface{}{}
+	for i, j := 0, 0; i < len(aa) && j < len(bb); {
+		switch c := compare(aa[i], bb[j]); {
+		case c < 0:
+			i++
+		case c > 0:
+			j++
+		default:
+			if len(cc) == 0 || compare(cc[len(cc)-1], aa[i]) != 0 {
+				cc = append(cc, aa[i])
+			}
+			i++
+		}
+	}
+	return cc
+}
+
+// IntersectionAuto returns the same result as Intersection, comparing elements
+// with closures.AutoEquality.
+func IntersectionAuto(aa, bb []interface{}) []interface{} {
+	return Intersection(aa, bb, closures.AutoEquality())
+}
+
+// IntersectionH returns the same result as Intersection, in the same order,
+// comparing each element, using the supplied equality function, only with
+// those whose hash, as given by the supplied hasher, is the same. Elements that
+// are equal must have the same hash.
+func IntersectionH(aa, bb []interface{}, hasher closures.Hasher, equality closures.EqualityFn) []interface{} {
+	bbIndex := newHashIndex(bb, hasher)
+	ccIndex := newHashIndex(nil, hasher)
+	cc := []interface{}{}
+	for _, a := range aa {
+		if bbIndex.any(a, func(b interface{}) bool { return equality(a, b) }) &&
+			!ccIndex.any(a, func(c interface{}) bool { return equality(a, c) }) {
+			ccIndex.add(a)
+			cc = append(cc, a)
+		}
+	}
+	return cc
+}
+
+// IntersectionT returns the elements of aa that are equal to some element of
+// bb, without duplicates, as Intersection does. Equality may be approximate:
+// the elements of both slices are divided into clusters, as described in the
+// package documentation, and the first element of aa in each cluster that
+// holds an element of bb is returned.
+func IntersectionT(aa, bb []interface{}, less closures.LessFn, equality closures.EqualityFn) []interface{} {
+	cluster := clusters(append(append([]interface{}{}, aa...), bb...), less, equality)
+	inBB := map[int]bool{}
+	for j := range bb {
+		inBB[cluster[len(aa)+j]] = true
+	}
+
+	kept := map[int]bool{}
+	cc := []interface{}{}
+	for i, a := range aa {
+		if inBB[cluster[i]] && !kept[cluster[i]] {
+			kept[cluster[i]] = true
+			cc = append(cc, a)
+		}
+	}
+	return cc
+}
+
 // IsProperSubset returns true if aa is a proper subset of bb.
 // aa is considered a proper subset if all of its elements exist within bb, but
 // bb also contains some elements that do not exist within aa.
 // Note: This operation does not enforce that each element be unique, thus, it
 // is possible for a subset to be larger than its superset. Use the Distinct
 // operations to enforce uniqueness, if that is necessary.
-func IsProperSubset(aa, bb []interface{}, equality func(a, b interface{}) bool) bool {
+func IsProperSubset(aa, bb []interface{}, equality closures.EqualityFn) bool {
 	aa1, bb1 := removeIntersections(aa, bb, equality)
 	return len(aa1) == 0 && len(bb1) > 0
 }
 
+// IsProperSubsetS returns true if aa is a proper subset of bb, as
+// IsProperSubset does. aa and bb must both be sorted in the order given by
+// compare, which is used in place of an equality function, and are merged in
+// linear time.
+func IsProperSubsetS(aa, bb []interface{}, compare closures.Comparator) bool {
+	aa1, bb1 := removeIntersectionsS(aa, bb, compare)
+	return len(aa1) == 0 && len(bb1) > 0
+}
+
 // IsProperSuperset returns true if aa is a proper superset of bb.
 // aa is considered a proper superset if it contains all of bb's elements, but
 // aa also contains some elements that do not exist within bb.
 // Note: This operation does not enforce that each element be unique, thus, it
 // is possible for a superset to be smaller than its subset. Use the Distinct
 // operations to enforce uniqueness, if that is necessary.
-func IsProperSuperset(aa, bb []interface{}, equality func(a, b interface{}) bool) bool {
+func IsProperSuperset(aa, bb []interface{}, equality closures.EqualityFn) bool {
 	aa1, bb1 := removeIntersections(aa, bb, equality)
 	return len(aa1) > 0 && len(bb1) == 0
 }
 
+// IsProperSupersetS returns true if aa is a proper superset of bb, as
+// IsProperSuperset does. aa and bb must both be sorted in the order given by
+// compare, which is used in place of an equality function, and are merged in
+// linear time.
+func IsProperSupersetS(aa, bb []interface{}, compare closures.Comparator) bool {
+	aa1, bb1 := removeIntersectionsS(aa, bb, compare)
+	return len(aa1) > 0 && len(bb1) == 0
+}
+
 // IsSubset returns true if aa is a subset of bb.
 // aa is considered a subset if all of its elements exist within bb.
 // Note: This operation does not enforce that each element be unique, thus, it
 // is possible for a subset to be larger than its superset. Use the Distinct
 // operations to enforce uniqueness, if that is necessary.
-func IsSubset(aa, bb []interface{}, equality func(a, b interface{}) bool) bool {
+func IsSubset(aa, bb []interface{}, equality closures.EqualityFn) bool {
 	aa1, bb1 := removeIntersections(aa, bb, equality)
 	return len(aa1) == 0 && len(bb1) >= 0
 }
 
+// IsSubsetS returns true if aa is a subset of bb, as IsSubset does. aa and bb
+// must both be sorted in the order given by compare, which is used in place
+// of an equality function, and are merged in linear time.
+func IsSubsetS(aa, bb []interface{}, compare closures.Comparator) bool {
+	aa1, _ := removeIntersectionsS(aa, bb, compare)
+	return len(aa1) == 0
+}
+
+// IsSubsetH returns true if aa is a subset of bb, as IsSubset does, comparing
+// each element of aa, using the supplied equality function, only with those
+// elements of bb whose hash, as given by the supplied hasher, is the same.
+// Elements that are equal must have the same hash.
+func IsSubsetH(aa, bb []interface{}, hasher closures.Hasher, equality closures.EqualityFn) bool {
+	bbIndex := newHashIndex(bb, hasher)
+	for _, a := range aa {
+		if !bbIndex.any(a, func(b interface{}) bool { return equality(a, b) }) {
+			return false
+		}
+	}
+	return true
+}
+
 // IsSuperset returns true if aa is a superset of bb.
 // aa is considered a superset if all of bb's elements exist within aa.
 // Note: This operation does not enforce that each element be unique, thus, it
 // is possible for a superset to be smaller than its subset. Use the Distinct
 // operations to enforce uniqueness, if that is necessary.
-func IsSuperset(aa, bb []interface{}, equality func(a, b interface{}) bool) bool {
+func IsSuperset(aa, bb []interface{}, equality closures.EqualityFn) bool {
 	aa1, bb1 := removeIntersections(aa, bb, equality)
 	return len(aa1) >= 0 && len(bb1) == 0
 }
 
-func removeIntersections(aa, bb []interface{}, equality func(a, b interface{}) bool) ([]interface{}, []interface{}) {
-	aa1 := Clone(aa)
-	bb1 := Clone(bb)
-	for ai := int64(len(aa1)) - 1; ai >= 0; ai-- {
-		intersectionFound := false
-		for bi := int64(len(bb1)) - 1; bi >= 0; bi-- {
-			if equality((aa1)[ai], (bb1)[bi]) {
-				intersectionFound = true
-				RemoveAt(&bb1, bi)
+// IsSupersetS returns true if aa is a superset of bb, as IsSuperset does. aa
+// and bb must both be sorted in the order given by compare, which is used in
+// place of an equality function, and are merged in linear time.
+func IsSupersetS(aa, bb []interface{}, compare closures.Comparator) bool {
+	_, bb1 := removeIntersectionsS(aa, bb, compare)
+	return len(bb1) == 0
+}
+
+// removeIntersections returns the elements of aa that are not equal to any
+// element of bb, and the elements of bb that are not equal to any element of
+// aa.
+func removeIntersections(aa, bb []interface{}, equality closures.EqualityFn) ([]interface{}, []interface{}) {
+	ii := make([]bool, len(aa))
+	jj := make([]bool, len(bb))
+	for i, a := range aa {
+		for j, b := range bb {
+			if equality(a, b) {
+				ii[i] = true
+				jj[j] = true
 			}
 		}
-		if intersectionFound {
-			RemoveAt(&aa1, ai)
+	}
+
+	aa1 := []interface{}{}
+	for i, a := range aa {
+		if !ii[i] {
+			aa1 = append(aa1, a)
+		}
+	}
+	bb1 := []interface{}{}
+	for j, b := range bb {
+		if !jj[j] {
+			bb1 = append(bb1, b)
+		}
+	}
+	return aa1, bb1
+}
+
+// removeIntersectionsS returns what removeIntersections does, for aa and bb
+// sorted in the order given by compare, by merging them. Each run of
+// elements of either slice that compare equal to an element of the other is
+// skipped as a whole.
+func removeIntersectionsS(aa, bb []interface{}, compare closures.Comparator) ([]interface{}, []interface{}) {
+	aa1 := []interface{}{}
+	bb1 := []interface{}{}
+	i, j := 0, 0
+	for i < len(aa) && j < len(bb) {
+		switch c := compare(aa[i], bb[j]); {
+		case c < 0:
+			aa1 = append(aa1, aa[i])
+			i++
+		case c > 0:
+			bb1 = append(bb1, bb[j])
+			j++
+		default:
+			common := aa[i]
+			for i < len(aa) && compare(aa[i], common) == 0 {
+				i++
+			}
+			for j < len(bb) && compare(bb[j], common) == 0 {
+				j++
+			}
 		}
 	}
+	aa1 = append(aa1, aa[i:]...)
+	bb1 = append(bb1, bb[j:]...)
 	return aa1, bb1
 }
 
@@ -587,7 +1000,7 @@ func ItemFuzzy(aa []interface{}, i int64) []interface{} {
 // Last applies a test function to each element in aa, and returns a []interface{}
 // containing the last element for which the test returned true. If no elements
 // pass the supplied test, the resulting []interface{} will be empty.
-func Last(aa []interface{}, test func(interface{}) bool) []interface{} {
+func Last(aa []interface{}, test closures.ConditionFn) []interface{} {
 	bb := []interface{}{}
 	ForEachR(aa, func(a interface{}) shared.Continue {
 		if test(a) {
@@ -599,6 +1012,18 @@ func Last(aa []interface{}, test func(interface{}) bool) []interface{} {
 	return bb
 }
 
+// LastS returns a []interface{} containing the last element of aa for which
+// search returns zero, or an empty []interface{} if there is none. aa must be
+// sorted as described by closures.SearchFn, and is searched by binary search,
+// calling search O(log n) times.
+func LastS(aa []interface{}, search closures.SearchFn) []interface{} {
+	i := searchEnd(aa, search) - 1
+	if i < 0 || search(aa[i]) != 0 {
+		return []interface{}{}
+	}
+	return []interface{}{aa[i]}
+}
+
 // Len returns the length of aa.
 func Len(aa []interface{}) int {
 	return len(aa)
@@ -618,12 +1043,181 @@ func Map(aa []interface{}, convertFn func(interface{}) interface{}) []interface{
 	return bb
 }
 
+// MergeOption modifies the merge performed by MergeSortedWith. Options are
+// combined with |, and the zero MergeOption selects none of them.
+type MergeOption int
+
+const (
+	// MergeStable places elements that sort alike in the order of the slices
+	// from which they came, and, within each slice, in their order there.
+	MergeStable MergeOption = 1 << iota
+	// MergeDistinct keeps only the first of each run of elements that sort
+	// alike, as DistinctS does, so that each is merged only once. Which of
+	// them is first is determined by MergeStable if it is also given, and is
+	// otherwise unspecified.
+	MergeDistinct
+)
+
+// MergeSorted merges slices, each of which must be sorted using the supplied
+// less function, into a single sorted slice. It takes O(n log k) time for n
+// elements in k slices, using a heap that holds the next element of each, so
+// is much faster than appending the slices to one another and sorting the
+// result. The order of elements that sort alike is unspecified; see
+// MergeSortedWith. None of the slices is modified.
+func MergeSorted(less closures.LessFn, slices ...[]interface{}) []interface{} {
+	return MergeSortedWith(less, 0, slices...)
+}
+
+// MergeSortedWith merges slices as MergeSorted does, modified by options.
+func MergeSortedWith(less closures.LessFn, options MergeOption, slices ...[]interface{}) []interface{} {
+	h := &mergeHeap{less: less, stable: options&MergeStable != 0}
+	n := 0
+	for i, aa := range slices {
+		n += len(aa)
+		if len(aa) > 0 {
+			h.cursors = append(h.cursors, mergeCursor{aa: aa, slice: i})
+		}
+	}
+	heap.Init(h)
+
+	bb := make([]interface{}, 0, n)
+	for h.Len() > 0 {
+		c := &h.cursors[0]
+		a := c.aa[0]
+		if options&MergeDistinct == 0 || len(bb) == 0 || less(bb[len(bb)-1], a) {
+			bb = append(bb, a)
+		}
+		if c.aa = c.aa[1:]; len(c.aa) == 0 {
+			heap.Pop(h)
+		} else {
+			heap.Fix(h, 0)
+		}
+	}
+	return bb
+}
+
+// mergeCursor holds the elements of one of the slices given to MergeSortedWith
+// that are yet to be merged, along with the position of that slice.
+type mergeCursor struct {
+	aa    []interface{}
+	slice int
+}
+
+// mergeHeap is a heap of mergeCursors whose root holds the element to be
+// merged next: the least of their first elements, and if stable, the one from
+// the earliest slice among those that sort alike.
+type mergeHeap struct {
+	less    closures.LessFn
+	stable  bool
+	cursors []mergeCursor
+}
+
+func (h *mergeHeap) Len() int      { return len(h.cursors) }
+func (h *mergeHeap) Swap(i, j int) { h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i] }
+func (h *mergeHeap) Push(x any)    { h.cursors = append(h.cursors, x.(mergeCursor)) }
+
+func (h *mergeHeap) Less(i, j int) bool {
+	a, b := h.cursors[i].aa[0], h.cursors[j].aa[0]
+	if h.less(a, b) {
+		return true
+	}
+	return h.stable && !h.less(b, a) && h.cursors[i].slice < h.cursors[j].slice
+}
+
+func (h *mergeHeap) Pop() any {
+	c := h.cursors[len(h.cursors)-1]
+	h.cursors = h.cursors[:len(h.cursors)-1]
+	return c
+}
+
 // None applies a test function to each element in aa, and returns true if
 // the test function returns false for all items.
-func None(aa []interface{}, test func(interface{}) bool) bool {
+func None(aa []interface{}, test closures.ConditionFn) bool {
 	return !Any(aa, test)
 }
 
+// NoneS returns true if search returns zero for no element of aa, which must
+// be sorted as described by closures.SearchFn. NoneS is the negation of AnyS,
+// and likewise calls search O(log n) times.
+func NoneS(aa []interface{}, search closures.SearchFn) bool {
+	return !AnyS(aa, search)
+}
+
+// NthElement rearranges aa so that aa[n] holds the element that would be
+// there were aa sorted using the supplied less function, no element before it
+// sorts after it, and no element after it sorts before it. The elements on
+// either side are otherwise in no particular order. If n < 0 or
+// n >= len(aa), NthElement does nothing.
+//
+// NthElement uses introselect, taking linear time on average. It partitions
+// aa around pivots chosen as the median of three elements, and should it
+// partition aa more than about 2*log2(len(aa)) times, as it might given
+// adversarial data, sorts the part of aa that remains instead, so it never
+// takes more than O(n log n) time. Unlike Sort, it is not stable.
+func NthElement(aa *[]interface{}, n int64, less closures.LessFn) {
+	if n < 0 || n >= int64(len(*aa)) {
+		return
+	}
+	introselect(*aa, int(n), less)
+}
+
+// introselect rearranges aa as NthElement does, for n within aa.
+func introselect(aa []interface{}, n int, less closures.LessFn) {
+	lo, hi := 0, len(aa)
+	for budget := 2 * bits.Len(uint(len(aa))); hi-lo > 1; budget-- {
+		if budget == 0 {
+			rest := aa[lo:hi]
+			sort.Slice(rest, func(i, j int) bool {
+				return less(rest[i], rest[j])
+			})
+			return
+		}
+		lt, gt := partition3(aa, lo, hi, less)
+		switch {
+		case n < lt:
+			hi = lt
+		case n >= gt:
+			lo = gt
+		default:
+			return
+		}
+	}
+}
+
+// partition3 partitions aa[lo:hi] about a pivot chosen as the median of its
+// first, middle and last elements, and returns the bounds of the elements
+// equal to the pivot: aa[lo:lt] sort before it, aa[lt:gt] are equal to it, and
+// aa[gt:hi] sort after it.
+func partition3(aa []interface{}, lo, hi int, less closures.LessFn) (lt, gt int) {
+	x, y, z := aa[lo], aa[lo+(hi-lo)/2], aa[hi-1]
+	if less(y, x) {
+		x, y = y, x
+	}
+	if less(z, y) {
+		y = z
+		if less(y, x) {
+			y = x
+		}
+	}
+	pivot := y
+
+	lt, gt = lo, hi
+	for i := lo; i < gt; {
+		switch {
+		case less(aa[i], pivot):
+			aa[lt], aa[i] = aa[i], aa[lt]
+			lt++
+			i++
+		case less(pivot, aa[i]):
+			gt--
+			aa[i], aa[gt] = aa[gt], aa[i]
+		default:
+			i++
+		}
+	}
+	return lt, gt
+}
+
 // Pairwise threads a transform function through aa, passing to the transform
 // successive two-element pairs, aa[i-1] && aa[i]. For the first pairing
 // the supplied init value is supplied as the initial element in the pair.
@@ -651,13 +1245,90 @@ func Pairwise(aa []interface{}, init interface{}, xform func(a, b interface{}) i
 	return bb
 }
 
+// PartialSort rearranges aa so that its first k elements are the k that sort
+// first using the supplied less function, in the order in which Sort would
+// place them, and are followed by the remaining elements in their original
+// order. It takes O(n log k) time, using a heap of at most k elements, so is
+// much faster than Sort where k is small. If k <= 0, PartialSort does nothing,
+// and if k >= len(aa), it sorts aa as Sort does.
+func PartialSort(aa *[]interface{}, k int64, less closures.LessFn) {
+	if k <= 0 {
+		return
+	}
+	least := leastIndexes(*aa, k, less)
+	chosen := make([]bool, len(*aa))
+	bb := make([]interface{}, 0, len(*aa))
+	for _, i := range least {
+		chosen[i] = true
+		bb = append(bb, (*aa)[i])
+	}
+	for i, a := range *aa {
+		if !chosen[i] {
+			bb = append(bb, a)
+		}
+	}
+	copy(*aa, bb)
+}
+
+// leastIndexes returns the indexes of the k elements of aa that sort first
+// using less, in the order in which a stable sort would place them.
+func leastIndexes(aa []interface{}, k int64, less closures.LessFn) []int {
+	h := &leastHeap{aa: aa, less: less}
+	for i := range aa {
+		switch {
+		case int64(len(h.ii)) < k:
+			heap.Push(h, i)
+		case h.after(h.ii[0], i):
+			h.ii[0] = i
+			heap.Fix(h, 0)
+		}
+	}
+	ii := make([]int, len(h.ii))
+	for j := len(ii) - 1; j >= 0; j-- {
+		ii[j] = heap.Pop(h).(int)
+	}
+	return ii
+}
+
+// leastHeap is a heap of indexes of aa whose root is the index of the element
+// that sorts last, as a stable sort using less would order them. It holds the
+// least elements yet found by leastIndexes, with the root the first to be
+// displaced.
+type leastHeap struct {
+	aa   []interface{}
+	less closures.LessFn
+	ii   []int
+}
+
+func (h *leastHeap) Len() int           { return len(h.ii) }
+func (h *leastHeap) Less(i, j int) bool { return h.after(h.ii[i], h.ii[j]) }
+func (h *leastHeap) Swap(i, j int)      { h.ii[i], h.ii[j] = h.ii[j], h.ii[i] }
+func (h *leastHeap) Push(x any)         { h.ii = append(h.ii, x.(int)) }
+
+func (h *leastHeap) Pop() any {
+	i := h.ii[len(h.ii)-1]
+	h.ii = h.ii[:len(h.ii)-1]
+	return i
+}
+
+// after reports whether a stable sort would place aa[i] after aa[j].
+func (h *leastHeap) after(i, j int) bool {
+	switch {
+	case h.less(h.aa[j], h.aa[i]):
+		return true
+	case h.less(h.aa[i], h.aa[j]):
+		return false
+	}
+	return i > j
+}
+
 // Partition applies a test function to each element in aa, and returns
 // a [][]interface{} where [][]interface{}[0] contains a []interface{} with all elements for
 // whom the test function returned true, and where [][]interface{}[1] contains a
 // []interface{} with all elements for whom the test function returned false.
 //
 // Partition is a special case of the Group function.
-func Partition(aa []interface{}, test func(interface{}) bool) []interface{} {
+func Partition(aa []interface{}, test closures.ConditionFn) SliceType2 {
 	grouper := func(a interface{}) string {
 		if test(a) {
 			return "1"
@@ -667,6 +1338,23 @@ func Partition(aa []interface{}, test func(interface{}) bool) []interface{} {
 	return Group(aa, grouper)
 }
 
+// PartitionS divides aa, as Partition does, into a [][]interface{} whose first
+// []interface{} holds the elements for which the test function returns true,
+// and whose second holds those for which it returns false. aa must be sorted
+// so that every element for which the test returns false comes before every
+// element for which it returns true, as with sort.Search, so that PartitionS
+// can find the boundary between them by binary search, calling the test
+// O(log n) times. Unlike Partition, PartitionS always returns both
+// []interface{}s, either of which may be empty.
+func PartitionS(aa []interface{}, test closures.ConditionFn) SliceType2 {
+	i := sort.Search(len(aa), func(i int) bool {
+		return test(aa[i])
+	})
+	passed := append([]interface{}{}, aa[i:]...)
+	failed := append([]interface{}{}, aa[:i]...)
+	return SliceType2{passed, failed}
+}
+
 // Permutable returns true if the number of permutations for aa exceeds
 // MaxInt64.
 func Permutable(aa []interface{}) bool {
@@ -694,21 +1382,21 @@ func Permutations(aa []interface{}) *big.Int {
 //
 // Permute is implemented using Heap's algorithm.
 // https://en.wikipedia.org/wiki/Heap%27s_algorithm
-func Permute(aa []interface{}) []interface{} {
+func Permute(aa []interface{}) SliceType2 {
 	if Empty(aa) {
-		return []interface{}{}
+		return SliceType2{}
 	}
 
 	if !Permutable(aa) {
 		panic(fmt.Sprintf("The number of permutations for this list (%v) exceeeds MaxInt64.", Permutations(aa)))
 	}
 
-	acc := []interface{}{}
+	acc := SliceType2{}
 	generate(int64(len(aa)), aa, &acc)
 	return acc
 }
 
-func generate(n int64, aa []interface{}, acc *[]interface{}) {
+func generate(n int64, aa []interface{}, acc *SliceType2) {
 	if n == 1 {
 		*acc = append(*acc, aa)
 		return
@@ -764,7 +1452,7 @@ func Reduce(aa []interface{}, reducer func(a, acc interface{}) interface{}) []in
 
 // Remove applies a test function to each item in the list, and removes any item
 // for which the test returns true.
-func Remove(aa *[]interface{}, test func(interface{}) bool) {
+func Remove(aa *[]interface{}, test closures.ConditionFn) {
 	for i := int64(len(*aa)) - 1; i >= 0; i-- {
 		if test((*aa)[i]) {
 			RemoveAt(aa, i)
@@ -792,6 +1480,19 @@ func Reverse(aa *[]interface{}) {
 	}
 }
 
+// Select returns a []interface{} containing the element that would be at
+// aa[n] were aa sorted using the supplied less function, without sorting, or
+// otherwise changing, aa. If n < 0 or n >= len(aa), the resulting slice will
+// be empty. Select copies aa, and applies NthElement to the copy.
+func Select(aa []interface{}, n int64, less closures.LessFn) []interface{} {
+	if n < 0 || n >= int64(len(aa)) {
+		return []interface{}{}
+	}
+	bb := append([]interface{}{}, aa...)
+	introselect(bb, int(n), less)
+	return []interface{}{bb[n]}
+}
+
 // Skip removes the first n elements from aa.
 //
 // Note that Skip(aa, len(aa)) will remove all items from the list, but does not
@@ -809,7 +1510,7 @@ func Skip(aa *[]interface{}, n int64) {
 // elements from aa while the test function returns true.
 // SkipWhile stops removing any further items from aa after the first test that
 // returns false.
-func SkipWhile(aa *[]interface{}, test func(interface{}) bool) {
+func SkipWhile(aa *[]interface{}, test closures.ConditionFn) {
 	// find the first index where the test would evaluate to false and skip
 	// everything up to that index.
 	findTest := func(a interface{}) bool { return !test(a) }
@@ -819,19 +1520,27 @@ func SkipWhile(aa *[]interface{}, test func(interface{}) bool) {
 // Sort sorts aa, using the supplied less function to determine order.
 // Sort is a convenience wrapper around the stdlib sort.SliceStable
 // function.
-func Sort(aa *[]interface{}, less func(a, b interface{}) bool) {
+func Sort(aa *[]interface{}, less closures.LessFn) {
 	lessI := func(i, j int) bool {
 		return less((*aa)[i], (*aa)[j])
 	}
 	sort.SliceStable(*aa, lessI)
 }
 
+// SortAuto sorts aa, as Sort does, ordering elements with closures.AutoLess,
+// so that elements that implement closures.Lesser or closures.Comparer decide
+// their own order, and others are ordered naturally. It panics if the elements
+// cannot be so ordered.
+func SortAuto(aa *[]interface{}) {
+	Sort(aa, closures.AutoLess())
+}
+
 // SplitAfter finds the first element b for which a test function returns true,
 // and returns a [][]interface{} where [][]interface{}[0] contains the first half of aa
 // and [][]interface{}[1] contains the second half of aa. Element b will be included
 // in [][]interface{}[0]. If the no element can be found for which the test returns
 // true, [][]interface{}[0] will contain aa, and [][]interface{}[1] will be empty.
-func SplitAfter(aa []interface{}, test func(interface{}) bool) []interface{} {
+func SplitAfter(aa []interface{}, test closures.ConditionFn) SliceType2 {
 	return SplitAt(aa, FindIndex(aa, test)+1)
 }
 
@@ -841,9 +1550,9 @@ func SplitAfter(aa []interface{}, test func(interface{}) bool) []interface{} {
 // be empty. Conversly, if i >= len(aa), all of aa will be placed in
 // [][]interface{}[1] and [][]interface{}[0] will be empty. If aa is nil or empty,
 // [][]interface{} will contain two empty slices.
-func SplitAt(aa []interface{}, i int64) []interface{} {
+func SplitAt(aa []interface{}, i int64) SliceType2 {
 	if len(aa) == 0 {
-		return []interface{}{
+		return SliceType2{
 			[]interface{}{},
 			[]interface{}{},
 		}
@@ -851,7 +1560,7 @@ func SplitAt(aa []interface{}, i int64) []interface{} {
 	if i < 0 {
 		i = 0
 	}
-	return []interface{}{
+	return SliceType2{
 		aa[:i],
 		aa[i:],
 	}
@@ -861,7 +1570,7 @@ func SplitAt(aa []interface{}, i int64) []interface{} {
 // and returns a [][]interface{} where [][]interface{}[0] contains the first half of aa
 // and [][]interface{}[1] contains the second half of aa. Element b will be included
 // in [][]interface{}[1]
-func SplitBefore(aa []interface{}, test func(interface{}) bool) []interface{} {
+func SplitBefore(aa []interface{}, test closures.ConditionFn) SliceType2 {
 	return SplitAt(aa, FindIndex(aa, test))
 }
 
@@ -870,8 +1579,19 @@ func SplitBefore(aa []interface{}, test func(interface{}) bool) []interface{} {
 // informational, and should not be relied upon to formally serialize a
 // []interface{}.
 func String(aa []interface{}) string {
-	jsonBytes, _ := json.Marshal(aa)
-	return string(jsonBytes)
+	// Elements are encoded individually, as not every element type can be
+	// marshaled (complex numbers, for example), and a []byte would otherwise
+	// be encoded as a base64 string.
+	elements := []string{}
+	for _, a := range aa {
+		jsonBytes, err := json.Marshal(a)
+		if err != nil {
+			elements = append(elements, fmt.Sprint(a))
+			continue
+		}
+		elements = append(elements, string(jsonBytes))
+	}
+	return "[" + strings.Join(elements, ",") + "]"
 }
 
 // SwapIndex swaps the elements at the specified indices. If either i or j is
@@ -904,13 +1624,30 @@ func Take(aa *[]interface{}, n int64) {
 // elements of aa so long as the test function returns true. As soon as the test
 // function returns false, take stops evaluating any further, and abandons the
 // rest of the slice.
-func TakeWhile(aa *[]interface{}, test func(interface{}) bool) {
+func TakeWhile(aa *[]interface{}, test closures.ConditionFn) {
 	find := func(a interface{}) bool {
 		return !test(a)
 	}
 	Take(aa, FindIndex(*aa, find))
 }
 
+// TopK returns the k elements of aa that sort first using the supplied less
+// function, in the order in which Sort would place them, without changing aa.
+// To find the k greatest elements, supply a less function that places the
+// greatest first. TopK takes O(n log k) time, using a heap of at most k
+// elements. If k <= 0, the resulting slice will be empty, and if
+// k >= len(aa), it holds every element of aa, sorted.
+func TopK(aa []interface{}, k int64, less closures.LessFn) []interface{} {
+	bb := []interface{}{}
+	if k <= 0 {
+		return bb
+	}
+	for _, i := range leastIndexes(aa, k, less) {
+		bb = append(bb, aa[i])
+	}
+	return bb
+}
+
 // Union appends slice bb to slice aa.
 // Note: This operation does not remove any duplicates from the slice, as a
 // similar operation would when operating on a formal Set.
@@ -918,9 +1655,25 @@ func Union(aa *[]interface{}, bb []interface{}) {
 	Append(aa, bb...)
 }
 
+// UnionDistinct appends slice bb to slice aa, and then removes all duplicates
+// from the result, as Distinct does, keeping the first of each. Unlike Union,
+// this matches the union of formal Sets.
+func UnionDistinct(aa *[]interface{}, bb []interface{}, equality closures.EqualityFn) {
+	Union(aa, bb)
+	Distinct(aa, equality)
+}
+
+// UnionDistinctH returns the same result as UnionDistinct, removing the
+// duplicates as DistinctH does. Elements that are equal must have the same
+// hash.
+func UnionDistinctH(aa *[]interface{}, bb []interface{}, hasher closures.Hasher, equality closures.EqualityFn) {
+	Union(aa, bb)
+	DistinctH(aa, hasher, equality)
+}
+
 // Unzip splits aa into a [][]interface{}, such that [][]interface{}[0] contains all odd
 // indices from aa, and [][]interface{}[1] contains all even indices from aa.
-func Unzip(aa []interface{}) []interface{} {
+func Unzip(aa []interface{}) SliceType2 {
 	odds := []interface{}{}
 	evens := []interface{}{}
 	for i, a := range aa {
@@ -930,7 +1683,7 @@ func Unzip(aa []interface{}) []interface{} {
 			evens = append(evens, a)
 		}
 	}
-	return []interface{}{odds, evens}
+	return SliceType2{odds, evens}
 }
 
 // WindowCentered applies a windowing function across the aa, using a centered
@@ -1020,37 +1773,12 @@ func WindowRight(aa []interface{}, windowSize int64, windowFn func(window []inte
 // simply append the remaining values for the longer of the two slices to the
 // end of the result slice.
 func Zip(aa, bb []interface{}) []interface{} {
-	if len(aa) == 0 {
-		return bb
-	}
-	if len(bb) == 0 {
-		return aa
-	}
-
 	cc := []interface{}{}
-	aaEndReached, bbEndReached := false, false
-	for i := 0; aaEndReached == false && bbEndReached == false; i++ {
-		if i >= len(aa) {
-			aaEndReached = true
-		}
-		if i >= len(bb) {
-			bbEndReached = true
-		}
-		if i%2 != 0 {
-			if !aaEndReached {
-				Append(&cc, aa[i])
-			}
-			if !bbEndReached {
-				Append(&cc, bb[i])
-			}
-		} else {
-			if !bbEndReached {
-				Append(&cc, bb[i])
-			}
-			if !aaEndReached {
-				Append(&cc, aa[i])
-			}
-		}
+	i := 0
+	for ; i < len(aa) && i < len(bb); i++ {
+		Append(&cc, aa[i], bb[i])
 	}
+	Append(&cc, aa[i:]...)
+	Append(&cc, bb[i:]...)
 	return cc
 }
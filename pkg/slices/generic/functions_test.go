@@ -1,10 +1,14 @@
 package generic_test
 
 import (
+	"encoding/json"
 	"fmt"
-	"strconv"
+	"math/big"
+	"math/rand"
+	"reflect"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -13,1312 +17,2040 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-type Behavior struct {
-	Description string
-	Expectation func(t *testing.T)
+// The specifications make no reference to any particular element type. Every
+// slice is built from primitiveSamples, and every closure compares elements by
+// their position within primitiveSamples, so the same specifications are
+// generated for, and must hold for, every typed package. Behavior that depends
+// upon a particular element type is tested in elements_test.go instead.
+
+// samples returns the first n of primitiveSamples, skipping t if there are
+// fewer than n.
+func samples(t *testing.T, n int) []interface{} {
+	if len(primitiveSamples) < n {
+		t.Skipf("%v samples are required, but only %v are available.", n, len(primitiveSamples))
+	}
+	return primitiveSamples[:n]
+}
+
+// sampleIndex returns the index of a within primitiveSamples, or -1 if a is
+// not a sample.
+func sampleIndex(a interface{}) int {
+	for i, sample := range primitiveSamples {
+		if reflect.DeepEqual(a, sample) {
+			return i
+		}
+	}
+	return -1
+}
+
+func sampleEqual(a, b interface{}) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+func sampleLess(a, b interface{}) bool {
+	return sampleIndex(a) < sampleIndex(b)
+}
+
+func sampleMax(a, b interface{}) interface{} {
+	if sampleLess(a, b) {
+		return b
+	}
+	return a
+}
+
+// sampleGroup returns a grouping key for a, which differs for each sample.
+func sampleGroup(a interface{}) string {
+	return strings.Repeat("x", sampleIndex(a)+1)
+}
+
+// sampleHash returns a hash of a, which differs for each sample.
+func sampleHash(a interface{}) uint64 {
+	return uint64(sampleIndex(a) + 1)
+}
+
+// sampleNear reports whether a and b are adjacent samples, which makes for an
+// approximate equality that is not transitive.
+func sampleNear(a, b interface{}) bool {
+	d := sampleIndex(a) - sampleIndex(b)
+	return d >= -1 && d <= 1
+}
+
+// sampleCompare orders samples as they are ordered in primitiveSamples.
+func sampleCompare(a, b interface{}) int {
+	return sampleIndex(a) - sampleIndex(b)
+}
+
+// sampleSeek returns a search for b within samples sorted in the order of
+// primitiveSamples.
+func sampleSeek(b interface{}) func(interface{}) int {
+	return func(a interface{}) int {
+		return sampleCompare(a, b)
+	}
+}
+
+// randomSortedSamples returns 20 slices of random samples, each sorted, and
+// all of their elements, sorted, using a source seeded with seed.
+func randomSortedSamples(seed int64) ([][]interface{}, []interface{}) {
+	r := rand.New(rand.NewSource(seed))
+	slices := [][]interface{}{}
+	all := []interface{}{}
+	for i := 0; i < 20; i++ {
+		aa := []interface{}{}
+		for j := r.Intn(50); j > 0; j-- {
+			aa = append(aa, primitiveSamples[r.Intn(len(primitiveSamples))])
+		}
+		generic.Sort(&aa, sampleLess)
+		slices = append(slices, aa)
+		all = append(all, aa...)
+	}
+	generic.Sort(&all, sampleLess)
+	return slices, all
+}
+
+// collide returns the same hash for every value.
+func collide(interface{}) uint64 {
+	return 0
+}
+
+func equalTo(b interface{}) func(interface{}) bool {
+	return func(a interface{}) bool {
+		return sampleEqual(a, b)
+	}
+}
+
+func always(interface{}) bool {
+	return true
 }
 
-type Specification struct {
-	FunctionName    string
-	StandardPath    Behavior
-	AlternativePath Behavior
-	EdgeCases       []Behavior
+func never(interface{}) bool {
+	return false
 }
 
 var Specifications = []Specification{
 	Specification{
 		FunctionName: "All",
 		StandardPath: Behavior{
-			Description: "Returns true if all elements pass test.",
+			Description: "True if all elements pass the test.",
 			Expectation: func(t *testing.T) {
-				s := []interface{}{1, 2, 3, 4}
-				test := func(p interface{}) bool {
-					return p.(int) < 5
-				}
-				assert.True(t, generic.All(s, test))
+				s := samples(t, 1)
+				aa := []interface{}{s[0], s[0]}
+				assert.True(t, generic.All(aa, equalTo(s[0])))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Returns false if not all elements pass test.",
+			Description: "False if any element fails the test.",
 			Expectation: func(t *testing.T) {
-				s := []interface{}{1, 2, 3, 4, 5}
-				test := func(p interface{}) bool {
-					return p.(int) < 5
-				}
-				assert.False(t, generic.All(s, test))
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				assert.False(t, generic.All(aa, equalTo(s[0])))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "AllS",
+		StandardPath: Behavior{
+			Description: "True if every element of the sorted slice is sought.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 1)
+				aa := []interface{}{s[0], s[0]}
+				assert.True(t, generic.AllS(aa, sampleSeek(s[0])))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "False if any element of the sorted slice is not sought.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				assert.False(t, generic.AllS(aa, sampleSeek(s[0])))
+				assert.False(t, generic.AllS(aa, sampleSeek(s[1])))
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "True for an empty slice.",
+				Expectation: func(t *testing.T) {
+					s := samples(t, 1)
+					assert.True(t, generic.AllS([]interface{}{}, sampleSeek(s[0])))
+				},
 			},
 		},
 	},
 	Specification{
 		FunctionName: "Any",
 		StandardPath: Behavior{
-			Description: "Returns true if any of the elements match.",
+			Description: "True if any element passes the test.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				test := func(a interface{}) bool {
-					return a.(int) == 2
-				}
-				assert.True(t, generic.Any(aa, test))
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				assert.True(t, generic.Any(aa, equalTo(s[1])))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Returns false if none of the elements match.",
+			Description: "False if no element passes the test.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				test := func(a interface{}) bool {
-					return a.(int) == 5
-				}
-				assert.False(t, generic.Any(aa, test))
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0]}
+				assert.False(t, generic.Any(aa, equalTo(s[1])))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "AnyS",
+		StandardPath: Behavior{
+			Description: "True if the sorted slice holds a sought element.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				assert.True(t, generic.AnyS(aa, sampleSeek(s[0])))
+				assert.True(t, generic.AnyS(aa, sampleSeek(s[1])))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "False if the sorted slice holds no sought element.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0]}
+				assert.False(t, generic.AnyS(aa, sampleSeek(s[1])))
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "The search is called O(log n) times.",
+				Expectation: func(t *testing.T) {
+					s := samples(t, 2)
+					aa := []interface{}{}
+					for i := 0; i < 1024; i++ {
+						aa = append(aa, s[i/700])
+					}
+					calls := 0
+					search := func(a interface{}) int {
+						calls++
+						return sampleCompare(a, s[1])
+					}
+					assert.True(t, generic.AnyS(aa, search))
+					assert.True(t, calls <= 12)
+				},
 			},
 		},
 	},
 	Specification{
 		FunctionName: "Append",
 		StandardPath: Behavior{
-			Description: "Values are added to the end of the slice.",
+			Description: "Appends values to the end of the slice.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				bb := []interface{}{5, 6, 7, 8}
-				generic.Append(&aa, bb...)
-				assertSlicesEqual(t, []interface{}{1, 2, 3, 4, 5, 6, 7, 8}, aa)
+				s := samples(t, 2)
+				aa := []interface{}{s[0]}
+				generic.Append(&aa, s[1], s[0])
+				assert.Equal(t, []interface{}{s[0], s[1], s[0]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "No values supplied makes no change.",
+			Description: "Appending nothing has no effect.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				bb := []interface{}{}
-				generic.Append(&aa, bb...)
-				assertSlicesEqual(t, []interface{}{1, 2, 3, 4}, aa)
+				s := samples(t, 1)
+				aa := []interface{}{s[0]}
+				generic.Append(&aa)
+				assert.Equal(t, []interface{}{s[0]}, aa)
 			},
 		},
 		EdgeCases: []Behavior{
 			Behavior{
-				Description: "Nil passed as aa appends bb",
+				Description: "Appending to a nil slice appends the values.",
 				Expectation: func(t *testing.T) {
+					s := samples(t, 2)
 					var aa []interface{}
-					bb := []interface{}{5, 6, 7, 8}
-					generic.Append(&aa, bb...)
-					assertSlicesEqual(t, []interface{}{5, 6, 7, 8}, aa)
+					generic.Append(&aa, s[0], s[1])
+					assert.Equal(t, []interface{}{s[0], s[1]}, aa)
 				},
 			},
 		},
 	},
-
+	Specification{
+		FunctionName: "Apply",
+		StandardPath: Behavior{
+			Description: "Replaces each element with the result of the transform.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[0]}
+				generic.Apply(&aa, func(a interface{}) interface{} { return s[1] })
+				assert.Equal(t, []interface{}{s[1], s[1], s[1]}, aa)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "An empty slice is unchanged.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 1)
+				aa := []interface{}{}
+				generic.Apply(&aa, func(a interface{}) interface{} { return s[0] })
+				assert.Empty(t, aa)
+			},
+		},
+	},
 	Specification{
 		FunctionName: "Clear",
 		StandardPath: Behavior{
-			Description: "The slice is set to nil",
+			Description: "Removes every element.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
+				s := samples(t, 1)
+				aa := []interface{}{s[0], s[0]}
 				generic.Clear(&aa)
-				assert.Nil(t, aa)
+				assert.Empty(t, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "An already nil slice can be cleared.",
+			Description: "An empty slice remains empty.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
+				aa := []interface{}{}
 				generic.Clear(&aa)
-				generic.Append(&aa, 6, 7, 8)
-				assertSlicesEqual(t, []interface{}{6, 7, 8}, aa)
+				assert.Empty(t, aa)
 			},
 		},
 	},
 	Specification{
 		FunctionName: "Clone",
 		StandardPath: Behavior{
-			Description: "A new identical slice is allocated in memory.",
+			Description: "Returns an independent copy of the slice.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0]}
 				bb := generic.Clone(aa)
-				if &aa == &bb {
-					t.Error("Slices aa and bb should not have the same address")
-				}
-				assertSlicesEqual(t, aa, bb)
+				bb[0] = s[1]
+				assert.Equal(t, []interface{}{s[0], s[0]}, aa)
+				assert.Equal(t, []interface{}{s[1], s[0]}, bb)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Slices are not deep cloned in this operation",
+			Description: "Cloning an empty slice returns an empty slice.",
 			Expectation: func(t *testing.T) {
-				value := 1
-				aa := []interface{}{&value}
-				bb := generic.Clone(aa)
-				a := aa[0].(*int)
-				b := bb[0].(*int)
-				if a != b {
-					t.Error("Expected aa[0] and bb[0] to have the same address")
-				}
+				assert.Empty(t, generic.Clone([]interface{}{}))
 			},
 		},
 	},
 	Specification{
 		FunctionName: "Collect",
 		StandardPath: Behavior{
-			Description: "Values are concatenated as expected.",
+			Description: "Collects the result of each pairing of aa and bb.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{"A", "B"}
-				bb := []interface{}{"Y", "Z"}
-				collector := func(a, b interface{}) interface{} {
-					return a.(string) + b.(string)
-				}
-				cc := generic.Collect(aa, bb, collector)
-				dd := []interface{}{"AY", "AZ", "BY", "BZ"}
-				assertSlicesEqual(t, cc, dd)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				bb := []interface{}{s[0], s[1]}
+				cc := generic.Collect(aa, bb, sampleMax)
+				assert.Equal(t, []interface{}{s[0], s[1], s[1], s[1]}, cc)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "Nothing is collected if either slice is empty.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				s := samples(t, 1)
+				aa := []interface{}{s[0]}
+				assert.Empty(t, generic.Collect(aa, []interface{}{}, sampleMax))
+				assert.Empty(t, generic.Collect([]interface{}{}, aa, sampleMax))
 			},
 		},
 	},
 	Specification{
 		FunctionName: "Count",
 		StandardPath: Behavior{
-			Description: "Returns the correct count",
+			Description: "Counts the elements passing the test.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4, 5}
-				test := func(a interface{}) bool {
-					return a.(int)%2 == 0
-				}
-				assert.Equal(t, int64(2), generic.Count(aa, test))
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[0]}
+				assert.Equal(t, int64(2), generic.Count(aa, equalTo(s[0])))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Returns 0 if no matches",
+			Description: "Zero if no element passes the test.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4, 5}
-				test := func(a interface{}) bool {
-					return a.(int) == 6
-				}
-				assert.Equal(t, int64(0), generic.Count(aa, test))
+				s := samples(t, 1)
+				aa := []interface{}{s[0]}
+				assert.Equal(t, int64(0), generic.Count(aa, never))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "CountS",
+		StandardPath: Behavior{
+			Description: "Counts the sought elements of the sorted slice.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[1]}
+				assert.Equal(t, int64(2), generic.CountS(aa, sampleSeek(s[1])))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Zero if no element is sought.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0]}
+				assert.Equal(t, int64(0), generic.CountS(aa, sampleSeek(s[1])))
 			},
 		},
 	},
 	Specification{
 		FunctionName: "Dequeue",
 		StandardPath: Behavior{
-			Description: "Removes and returns head.",
+			Description: "Removes and returns the head of the slice.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3}
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
 				bb := generic.Dequeue(&aa)
-				assert.Equal(t, 1, bb[0])
-				cc := []interface{}{2, 3}
-				assertSlicesEqual(t, aa, cc)
+				assert.Equal(t, []interface{}{s[0]}, bb)
+				assert.Equal(t, []interface{}{s[1]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "If source slice is empty, empty slice is returned.",
+			Description: "Dequeueing an empty slice returns nothing.",
 			Expectation: func(t *testing.T) {
 				aa := []interface{}{}
-				bb := generic.Dequeue(&aa)
-				if len(bb) != 0 {
-					t.Error("Expected bb to be empty.")
-				}
+				assert.Empty(t, generic.Dequeue(&aa))
+				assert.Empty(t, aa)
 			},
 		},
 	},
 	Specification{
 		FunctionName: "Difference",
 		StandardPath: Behavior{
-			Description: "Returns the difference between two slices",
+			Description: "Returns the elements not common to both slices.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				bb := []interface{}{5, 4, 3}
-				equality := func(a, b interface{}) bool {
-					return a.(int) == b.(int)
-				}
-				cc := generic.Difference(aa, bb, equality)
-				dd := []interface{}{1, 2, 5}
-				assertSlicesEqual(t, cc, dd)
+				s := samples(t, 3)
+				aa := []interface{}{s[0], s[1]}
+				bb := []interface{}{s[1], s[2]}
+				cc := generic.Difference(aa, bb, sampleEqual)
+				assertSlicesEqual(t, []interface{}{s[0], s[2]}, cc)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: `Duplicates are handled.
-						  Those from aa appear first in the result. bb appear
-						  second. Order and duplicates should be maintained.`,
+			Description: "The difference of equal slices is empty.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 3, 1, 4}
-				bb := []interface{}{5, 4, 3, 5}
-				equality := func(a, b interface{}) bool {
-					return a.(int) == b.(int)
-				}
-				cc := generic.Difference(aa, bb, equality)
-				dd := []interface{}{1, 2, 1, 5, 5}
-				assertSlicesEqual(t, cc, dd)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				bb := []interface{}{s[1], s[0]}
+				assert.Empty(t, generic.Difference(aa, bb, sampleEqual))
 			},
 		},
 	},
 	Specification{
-		FunctionName: "Distinct",
+		FunctionName: "DifferenceS",
 		StandardPath: Behavior{
-			Description: "Duplicates are removed from the slice, mutating the original",
+			Description: "Returns the elements not common to both sorted slices.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{"Dani", "Riley", "Dani", "Tori", "Janice"}
-				equality := func(a, b interface{}) bool {
-					return a.(string) == b.(string)
-				}
-				generic.Distinct(&aa, equality)
-				bb := []interface{}{"Dani", "Riley", "Tori", "Janice"}
-				assertSlicesEqual(t, aa, bb)
+				s := samples(t, 3)
+				aa := []interface{}{s[0], s[0], s[1]}
+				bb := []interface{}{s[1], s[2]}
+				assert.Equal(t, []interface{}{s[0], s[0], s[2]}, generic.DifferenceS(aa, bb, sampleCompare))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "Equal slices have no difference.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				assert.Empty(t, generic.DifferenceS(aa, aa, sampleCompare))
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "The difference with an empty slice is the other slice.",
+				Expectation: func(t *testing.T) {
+					s := samples(t, 2)
+					aa := []interface{}{s[0], s[1], s[1]}
+					assert.Equal(t, aa, generic.DifferenceS(aa, []interface{}{}, sampleCompare))
+					assert.Equal(t, aa, generic.DifferenceS([]interface{}{}, aa, sampleCompare))
+				},
 			},
 		},
 	},
 	Specification{
-		FunctionName: "Empty",
+		FunctionName: "DifferenceAuto",
 		StandardPath: Behavior{
-			Description: "Returns true if slice is empty",
+			Description: "Returns the elements not common to both slices.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{}
-				assert.True(t, generic.Empty(aa))
+				s := samples(t, 3)
+				aa := []interface{}{s[0], s[1], s[0]}
+				bb := []interface{}{s[1], s[2]}
+				assert.Equal(t, []interface{}{s[0], s[0], s[2]}, generic.DifferenceAuto(aa, bb))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Returns false if slice is not empty",
+			Description: "Returns an empty slice for equal slices.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1}
-				assert.False(t, generic.Empty(aa))
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				assert.Equal(t, []interface{}{}, generic.DifferenceAuto(aa, []interface{}{s[1], s[0]}))
 			},
 		},
 	},
 	Specification{
-		FunctionName: "End",
+		FunctionName: "DifferenceH",
 		StandardPath: Behavior{
-			Description: "Returns a slice with the last element.",
+			Description: "Returns the elements not common to both slices, as Difference does.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3}
-				bb := generic.End(aa)
-				assert.Equal(t, 3, bb[0])
+				s := samples(t, 3)
+				aa := []interface{}{s[0], s[1], s[0]}
+				bb := []interface{}{s[1], s[2]}
+				cc := generic.DifferenceH(aa, bb, sampleHash, sampleEqual)
+				assert.Equal(t, []interface{}{s[0], s[0], s[2]}, cc)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "An empty slice returns an empty slice.",
+			Description: "Elements whose hashes collide are compared for equality.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{}
-				bb := generic.End(aa)
-				assert.True(t, generic.Empty(bb))
+				s := samples(t, 3)
+				aa := []interface{}{s[0], s[1]}
+				bb := []interface{}{s[1], s[2]}
+				cc := generic.DifferenceH(aa, bb, collide, sampleEqual)
+				assert.Equal(t, []interface{}{s[0], s[2]}, cc)
 			},
 		},
 	},
 	Specification{
-		FunctionName: "Enqueue",
+		FunctionName: "DifferenceT",
 		StandardPath: Behavior{
-			Description: "The value is added to the head of the slice.",
+			Description: "Returns the elements not common to both slices, as Difference does.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3}
-				generic.Enqueue(&aa, 4)
-				bb := []interface{}{4, 1, 2, 3}
-				assertSlicesEqual(t, aa, bb)
+				s := samples(t, 3)
+				aa := []interface{}{s[0], s[1], s[0]}
+				bb := []interface{}{s[1], s[2]}
+				cc := generic.DifferenceT(aa, bb, sampleLess, sampleEqual)
+				assert.Equal(t, []interface{}{s[0], s[0], s[2]}, cc)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "Elements are compared by cluster.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				s := samples(t, 3)
+				aa := []interface{}{s[2], s[0]}
+				bb := []interface{}{s[1]}
+				cc := generic.DifferenceT(aa, bb, sampleLess, sampleNear)
+				assert.Equal(t, []interface{}{s[2]}, cc)
 			},
 		},
 	},
 	Specification{
-		FunctionName: "Expand",
+		FunctionName: "Distinct",
 		StandardPath: Behavior{
-			Description: "Expands the supplied list according to the expansion",
+			Description: "Removes duplicates, keeping the first occurrence.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{"AB", "CD", "EF"}
-				expansion := func(a interface{}) []interface{} {
-					b := string(a.(string)[0])
-					c := string(a.(string)[1])
-					return []interface{}{b, c}
-				}
-				bb := generic.Expand(aa, expansion)
-				cc := []interface{}{"A", "B", "C", "D", "E", "F"}
-				assertSlicesEqual(t, bb, cc)
+				s := samples(t, 2)
+				aa := []interface{}{s[1], s[0], s[1], s[0]}
+				generic.Distinct(&aa, sampleEqual)
+				assert.Equal(t, []interface{}{s[1], s[0]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "A slice without duplicates is unchanged.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				generic.Distinct(&aa, sampleEqual)
+				assert.Equal(t, []interface{}{s[0], s[1]}, aa)
 			},
 		},
 	},
 	Specification{
-		FunctionName: "Filter",
+		FunctionName: "DistinctS",
 		StandardPath: Behavior{
-			Description: "Items are removed for which the test function returns true.",
+			Description: "Removes adjacent duplicates.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				test := func(a interface{}) bool {
-					return a.(int)%2 == 0
-				}
-				generic.Filter(&aa, test)
-				bb := []interface{}{1, 3}
-				assertSlicesEqual(t, aa, bb)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0], s[1], s[1]}
+				generic.DistinctS(&aa, sampleEqual)
+				assert.Equal(t, []interface{}{s[0], s[1]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "A slice without duplicates is unchanged.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				generic.DistinctS(&aa, sampleEqual)
+				assert.Equal(t, []interface{}{s[0], s[1]}, aa)
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "Duplicates that are not adjacent are retained.",
+				Expectation: func(t *testing.T) {
+					s := samples(t, 2)
+					aa := []interface{}{s[0], s[1], s[0]}
+					generic.DistinctS(&aa, sampleEqual)
+					assert.Equal(t, []interface{}{s[0], s[1], s[0]}, aa)
+				},
 			},
 		},
 	},
 	Specification{
-		FunctionName: "FindIndex",
+		FunctionName: "DistinctAuto",
 		StandardPath: Behavior{
-			Description: "The first matching element is returned.",
+			Description: "Removes duplicates, keeping the first occurrence.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				test := func(a interface{}) bool {
-					return a.(int) >= 3
-				}
-				n := generic.FindIndex(aa, test)
-				assert.Equal(t, int64(2), n)
+				s := samples(t, 2)
+				aa := []interface{}{s[1], s[0], s[1], s[0]}
+				generic.DistinctAuto(&aa)
+				assert.Equal(t, []interface{}{s[1], s[0]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "If no match is found, -1 is returned.",
+			Description: "A slice without duplicates is unchanged.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				test := func(a interface{}) bool {
-					return a.(int) >= 5
-				}
-				n := generic.FindIndex(aa, test)
-				assert.Equal(t, int64(-1), n)
+				s := samples(t, 2)
+				aa := []interface{}{s[1], s[0]}
+				generic.DistinctAuto(&aa)
+				assert.Equal(t, []interface{}{s[1], s[0]}, aa)
 			},
 		},
 	},
 	Specification{
-		FunctionName: "First",
+		FunctionName: "DistinctH",
 		StandardPath: Behavior{
-			Description: "The first matching element is returned.",
+			Description: "Removes duplicates, keeping the first occurrence.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				test := func(a interface{}) bool {
-					return a.(int) >= 2
-				}
-				bb := generic.First(aa, test)
-				cc := []interface{}{2}
-				assertSlicesEqual(t, bb, cc)
+				s := samples(t, 2)
+				aa := []interface{}{s[1], s[0], s[1], s[0]}
+				generic.DistinctH(&aa, sampleHash, sampleEqual)
+				assert.Equal(t, []interface{}{s[1], s[0]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "An empty slice is returned if there are no matches.",
+			Description: "Elements whose hashes collide are compared for equality.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				test := func(a interface{}) bool {
-					return a.(int) >= 5
-				}
-				bb := generic.First(aa, test)
-				cc := []interface{}{}
-				assertSlicesEqual(t, bb, cc)
+				s := samples(t, 3)
+				aa := []interface{}{s[2], s[0], s[2], s[1]}
+				generic.DistinctH(&aa, collide, sampleEqual)
+				assert.Equal(t, []interface{}{s[2], s[0], s[1]}, aa)
 			},
 		},
 	},
 	Specification{
-		FunctionName: "Fold",
+		FunctionName: "DistinctT",
 		StandardPath: Behavior{
-			Description: "Fold accumulates values properly",
+			Description: "Removes duplicates, keeping the first occurrence.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				folder := func(a, acc interface{}) interface{} {
-					return a.(int) + acc.(int)
-				}
-				bb := generic.Fold(aa, 1, folder)
-				assert.Equal(t, 11, bb[0].(int))
+				s := samples(t, 2)
+				aa := []interface{}{s[1], s[0], s[1], s[0]}
+				generic.DistinctT(&aa, sampleLess, sampleEqual)
+				assert.Equal(t, []interface{}{s[1], s[0]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "Keeps the first element of each cluster.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				s := samples(t, 3)
+				aa := []interface{}{s[2], s[1], s[0]}
+				generic.DistinctT(&aa, sampleLess, sampleNear)
+				assert.Equal(t, []interface{}{s[2], s[1]}, aa)
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "Elements are kept or removed as the equality function determines.",
+				Expectation: func(t *testing.T) {
+					s := samples(t, 2)
+					aa := []interface{}{s[0], s[1], s[0]}
+					generic.DistinctT(&aa, sampleLess, func(a, b interface{}) bool { return false })
+					assert.Len(t, aa, 3)
+					aa = []interface{}{s[0], s[1], s[0]}
+					generic.DistinctT(&aa, sampleLess, sampleEqual)
+					assert.Equal(t, []interface{}{s[0], s[1]}, aa)
+				},
 			},
 		},
 	},
 	Specification{
-		FunctionName: "FoldI",
+		FunctionName: "Empty",
 		StandardPath: Behavior{
-			Description: "FoldI accumulates values properly",
+			Description: "True if the slice has no elements.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{"A", "B", "C"}
-				folder := func(i int64, a, acc interface{}) interface{} {
-					return fmt.Sprintf("%v%v%v",
-						acc.(string),
-						strconv.Itoa(int(i)),
-						a.(string))
-				}
-				bb := generic.FoldI(aa, "X", folder)
-				assert.Equal(t, "X0A1B2C", bb[0].(string))
+				assert.True(t, generic.Empty([]interface{}{}))
+				assert.True(t, generic.Empty(nil))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "False if the slice has elements.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				s := samples(t, 1)
+				assert.False(t, generic.Empty([]interface{}{s[0]}))
 			},
 		},
 	},
 	Specification{
-		FunctionName: "ForEach",
+		FunctionName: "End",
 		StandardPath: Behavior{
-			Description: "Each element of the list is applied to the function",
+			Description: "Returns the last element.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{"A", "B", "C"}
-				result := ""
-				fn := func(a interface{}) shared.Continue {
-					result = result + a.(string)
-					return shared.ContinueYes
-				}
-				generic.ForEach(aa, fn)
-				assert.Equal(t, "ABC", result)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				assert.Equal(t, []interface{}{s[1]}, generic.End(aa))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "The iterator stops if false is returned.",
+			Description: "Returns nothing for an empty slice.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{"A", "B", "C"}
-				result := ""
-				fn := func(a interface{}) shared.Continue {
-					result = result + a.(string)
-					return a.(string) != "B"
-				}
-				generic.ForEach(aa, fn)
-				assert.Equal(t, "AB", result)
+				assert.Empty(t, generic.End([]interface{}{}))
 			},
 		},
 	},
 	Specification{
-		FunctionName: "ForEachC",
+		FunctionName: "Enqueue",
 		StandardPath: Behavior{
-			Description: "Each element of the list is applied to the function",
+			Description: "Places the value at the head of the slice.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{"A", "B", "C"}
-				mu := new(sync.Mutex)
-				result := ""
-				fn := func(a interface{}, cancelPending func() bool) shared.Continue {
-					mu.Lock()
-					defer mu.Unlock()
-					result = result + a.(string)
-					return shared.ContinueYes
-				}
-				generic.ForEachC(aa, 1, fn)
-				bb := []interface{}{"ABC", "BAC", "CAB", "ACB", "BCA", "CBA"}
-				if !generic.Any(bb, func(b interface{}) bool {
-					return b.(string) == result
-				}) {
-					t.Errorf("Expected a variant of 'ABC', but got '%v'", result)
-				}
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0]}
+				generic.Enqueue(&aa, s[1])
+				assert.Equal(t, []interface{}{s[1], s[0], s[0]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "The function panic if a negative pool size is specified.",
+			Description: "Enqueueing to an empty slice.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{"A", "B", "C"}
-				mu := new(sync.Mutex)
-				result := ""
-				fn := func(a interface{}, cancelPending func() bool) shared.Continue {
-					mu.Lock()
-					defer mu.Unlock()
-					result = result + a.(string)
-					return shared.ContinueYes
-				}
-				assert.PanicsWithValue(t,
-					"ForEachC: The concurrency pool size (c) must be non-negative.",
-					func() { generic.ForEachC(aa, -1, fn) })
-			},
-		},
-		EdgeCases: []Behavior{
-			Behavior{
-				Description: `Long running operations wind down when a 
-							  cancellation is broadcast`,
-				Expectation: func(t *testing.T) {
-					// Elements "A" and "B" will spawn infinitely loops, which
-					// check for pending cancellation on each iteration.
-					// Element "C" will spawn an operation that will wait until
-					// "A" and "B" are both running, at which point "C" will
-					// cancel further iterations.
-					// "A" and "B" will then identify the cancellation, and
-					// will halt.
-					//
-					// If this test is failing, it should time out. This can
-					// be verified by removing the "halt = true" assignment and
-					// manually confirming that the test would time out in that
-					// case.
-					aa := []interface{}{"A", "B", "C"}
-					mu := new(sync.RWMutex)
-					aIsRunning := false
-					bIsRunning := false
-					fn := func(a interface{}, cancelPending func() bool) shared.Continue {
-						if a.(string) == "A" || a.(string) == "B" {
-							mu.Lock()
-							if a.(string) == "A" {
-								aIsRunning = true
-							} else {
-								bIsRunning = true
-							}
-							mu.Unlock()
-							for cancelPending() == false {
-							}
-							return shared.ContinueYes
-						} else {
-							halt := false
-							for {
-								mu.RLock()
-								if aIsRunning && bIsRunning {
-									halt = true
-								}
-								mu.RUnlock()
-								if halt {
-									return shared.ContinueNo
-								}
-							}
-						}
-					}
-					generic.ForEachC(aa, 3, fn)
-				},
-			},
-			Behavior{
-				Description: `Upon cancellation, active goroutines are allowed
-							  to wind down before the function returns.`,
-				Expectation: func(t *testing.T) {
-					// Elements "A" and "B" will spawn infinitely loops, which
-					// check for pending cancellation on each iteration.
-					// Element "C" will spawn an operation that will wait until
-					// "A" and "B" are both running, at which point "C" will
-					// cancel further iterations.
-					// "A" and "B" will then identify the cancellation, and
-					// will halt.
-					//
-					// "A", and "B" will each write out a value upon
-					// cancellation, which is used to verify that the function
-					// blocked until all goroutines exited cleanly.
-					aa := []interface{}{"A", "B", "C"}
-					mu := new(sync.RWMutex)
-					aIsRunning := false
-					bIsRunning := false
-					aExitedCleanly := false
-					bExitedCleanly := false
-					fn := func(a interface{}, cancelPending func() bool) shared.Continue {
-						if a.(string) == "A" || a.(string) == "B" {
-							mu.Lock()
-							if a.(string) == "A" {
-								aIsRunning = true
-							} else {
-								bIsRunning = true
-							}
-							mu.Unlock()
-							for cancelPending() == false {
-							}
-							mu.Lock()
-							if a.(string) == "A" {
-								aExitedCleanly = true
-							} else {
-								bExitedCleanly = true
-							}
-							mu.Unlock()
-							return shared.ContinueYes
-						} else {
-							halt := false
-							for {
-								mu.RLock()
-								if aIsRunning && bIsRunning {
-									halt = true
-								}
-								mu.RUnlock()
-								if halt {
-									return shared.ContinueNo
-								}
-							}
-						}
-					}
-					generic.ForEachC(aa, 3, fn)
-					assert.True(t, aExitedCleanly)
-					assert.True(t, bExitedCleanly)
-				},
-			},
-			Behavior{
-				Description: `Upon cancellation, no previously backlogged work
-							  will be scheduled.`,
-				Expectation: func(t *testing.T) {
-					// Elements "A" and "B" will spawn loops, which
-					// check for pending cancellation on each iteration.
-					//
-					// Element "C" will spawn an operation that will wait until
-					// "A" and "B" are both running, at which point "C" will
-					// cancel further iterations. In response "A" and "B" will
-					// then identify the cancellation, and will halt.
-					//
-					// Element "D" spawns an operation that will wait a couple
-					// seconds, ensuring that the goroutine pool stays maxed
-					// at 3 while A, B, and C wind down.
-					//
-					// "E" will write out a value upon initialization, but
-					// because the pool size is only 3, A, B, C, and D will fill
-					// the pool, causing E to be backlogged. As such, E should
-					// never be marshalled to a goroutine if C requests a
-					// cancellation.
-					aa := []interface{}{"A", "B", "C", "D", "E"}
-					mu := new(sync.RWMutex)
-					aIsRunning := false
-					bIsRunning := false
-					eStarted := false
-					fn := func(a interface{}, cancelPending func() bool) shared.Continue {
-						if a.(string) == "A" || a.(string) == "B" {
-							mu.Lock()
-							if a.(string) == "A" {
-								aIsRunning = true
-							} else {
-								bIsRunning = true
-							}
-							mu.Unlock()
-							for cancelPending() == false {
-							}
-							return shared.ContinueYes
-						} else if a.(string) == "C" {
-							halt := false
-							for {
-								mu.RLock()
-								if aIsRunning && bIsRunning {
-									halt = true
-								}
-								mu.RUnlock()
-								if halt {
-									return shared.ContinueNo
-								}
-							}
-						} else if a.(string) == "D" {
-							time.Sleep(2 * time.Second)
-							for cancelPending() == false {
-							}
-							return shared.ContinueNo
-						} else if a.(string) == "E" {
-							mu.Lock()
-							eStarted = true
-							mu.Unlock()
-						}
-						return shared.ContinueYes
-					}
-					generic.ForEachC(aa, 3, fn)
-					assert.False(t, eStarted)
-				},
+				s := samples(t, 1)
+				aa := []interface{}{}
+				generic.Enqueue(&aa, s[0])
+				assert.Equal(t, []interface{}{s[0]}, aa)
 			},
 		},
 	},
 	Specification{
-		FunctionName: "ForEachR",
+		FunctionName: "Expand",
 		StandardPath: Behavior{
-			Description: `Each element of the list is applied to the function
-						  in reverse order`,
+			Description: "Concatenates the expansion of each element.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{"A", "B", "C"}
-				result := ""
-				fn := func(a interface{}) shared.Continue {
-					result = result + a.(string)
-					return true
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				expansion := func(a interface{}) []interface{} {
+					return []interface{}{a, a}
 				}
-				generic.ForEachR(aa, fn)
-				assert.Equal(t, "CBA", result)
+				bb := generic.Expand(aa, expansion)
+				assert.Equal(t, []interface{}{s[0], s[0], s[1], s[1]}, bb)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: `The iterator stops when the function return true.`,
+			Description: "Empty expansions contribute nothing.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{"A", "B", "C"}
-				result := ""
-				fn := func(a interface{}) shared.Continue {
-					result = result + a.(string)
-					return a.(string) != "B"
-				}
-				generic.ForEachR(aa, fn)
-				assert.Equal(t, "CB", result)
+				s := samples(t, 1)
+				aa := []interface{}{s[0]}
+				bb := generic.Expand(aa, func(interface{}) []interface{} { return nil })
+				assert.Empty(t, bb)
 			},
 		},
 	},
 	Specification{
-		FunctionName: "Group",
+		FunctionName: "Filter",
 		StandardPath: Behavior{
-			Description: "Elements are grouped as expected.",
-			Expectation: func(t *testing.T) {
-				aa := []interface{}{"A", "B", "C", "D", "E", "F"}
-				grouper := func(a interface{}) string {
-					switch {
-					case a.(string) == "A" || a.(string) == "B":
-						return "1"
-					case a.(string) == "C" || a.(string) == "D":
-						return "2"
-					default:
-						return "3"
-					}
-				}
-				bb := generic.Group(aa, grouper)
-				cc := generic.SliceType{
-					generic.SliceType{"A", "B"},
-					generic.SliceType{"C", "D"},
-					generic.SliceType{"E", "F"},
-				}
-				assertSlicesEqual(t, bb, cc)
+			Description: "Removes the elements passing the test.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[0]}
+				generic.Filter(&aa, equalTo(s[0]))
+				assert.Equal(t, []interface{}{s[1]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "The slice is unchanged if no element passes the test.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				generic.Filter(&aa, never)
+				assert.Equal(t, []interface{}{s[0], s[1]}, aa)
 			},
 		},
 	},
 	Specification{
-		FunctionName: "GroupByTrait",
+		FunctionName: "FindIndex",
 		StandardPath: Behavior{
-			Description: "Normally groups by trait.",
+			Description: "Returns the index of the first element passing the test.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{"pigdog", "pigs", "dog", "pigdogs", "cat", "dogs", "pig"}
-				trait := func(ai, an interface{}) bool {
-					return strings.Index(an.(string), ai.(string)) == 0
-				}
-				equality := func(a, b interface{}) bool {
-					return a.(string) == b.(string)
-				}
-				bb := generic.GroupByTrait(aa, trait, equality)
-				cc := generic.SliceType{
-					generic.SliceType{"dog", "dogs"},
-					generic.SliceType{"cat"},
-					generic.SliceType{"pigdog", "pigs", "pigdogs", "pig"},
-				}
-				assertSlicesEqual(t, bb, cc)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[1]}
+				assert.Equal(t, int64(1), generic.FindIndex(aa, equalTo(s[1])))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "Returns -1 if no element passes the test.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				s := samples(t, 1)
+				aa := []interface{}{s[0]}
+				assert.Equal(t, int64(-1), generic.FindIndex(aa, never))
 			},
 		},
 	},
 	Specification{
-		FunctionName: "GroupI",
+		FunctionName: "FindIndexS",
 		StandardPath: Behavior{
-			Description: "Elements are grouped as expected.",
-			Expectation: func(t *testing.T) {
-				aa := []interface{}{"A", "B", "C", "D", "E", "F"}
-				grouper := func(i int64, a interface{}) string {
-					switch {
-					case i <= 1:
-						return "1"
-					case i <= 3:
-						return "2"
-					default:
-						return "3"
-					}
-				}
-				bb := generic.GroupI(aa, grouper)
-				cc := generic.SliceType{
-					generic.SliceType{"A", "B"},
-					generic.SliceType{"C", "D"},
-					generic.SliceType{"E", "F"},
-				}
-				assertSlicesEqual(t, bb, cc)
+			Description: "Returns the index of the first sought element.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[1]}
+				assert.Equal(t, int64(1), generic.FindIndexS(aa, sampleSeek(s[1])))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "Returns -1 if no element is sought.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				s := samples(t, 2)
+				aa := []interface{}{s[0]}
+				assert.Equal(t, int64(-1), generic.FindIndexS(aa, sampleSeek(s[1])))
 			},
 		},
 	},
 	Specification{
-		FunctionName: "Head",
+		FunctionName: "First",
 		StandardPath: Behavior{
-			Description: "Returns the first item from the slice.",
+			Description: "Returns the first element passing the test.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3}
-				bb := generic.Head(aa)
-				assertSlicesEqual(t, bb, []interface{}{1})
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[0]}
+				assert.Equal(t, []interface{}{s[0]}, generic.First(aa, always))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Returns an empty slice if the source slice is empty.",
+			Description: "Returns nothing if no element passes the test.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{}
-				bb := generic.Head(aa)
-				if len(bb) != 0 {
-					t.Error("Expected bb to be empty.")
-				}
+				s := samples(t, 1)
+				aa := []interface{}{s[0]}
+				assert.Empty(t, generic.First(aa, never))
 			},
 		},
 	},
 	Specification{
-		FunctionName: "InsertAfter",
+		FunctionName: "FirstS",
 		StandardPath: Behavior{
-			Description: "Inserts after the first element passing the test",
+			Description: "Returns the first sought element.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3}
-				test := func(a interface{}) bool {
-					return a.(int)%2 != 0
-				}
-				generic.InsertAfter(&aa, 9, test)
-				bb := []interface{}{1, 9, 2, 3}
-				assertSlicesEqual(t, aa, bb)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				assert.Equal(t, []interface{}{s[1]}, generic.FirstS(aa, sampleSeek(s[1])))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "No tests pass, inserts at end.",
+			Description: "Returns nothing if no element is sought.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3}
-				test := func(a interface{}) bool {
-					return a.(int) > 10
-				}
-				generic.InsertAfter(&aa, 9, test)
-				bb := []interface{}{1, 2, 3, 9}
-				assertSlicesEqual(t, aa, bb)
+				s := samples(t, 2)
+				aa := []interface{}{s[1]}
+				assert.Empty(t, generic.FirstS(aa, sampleSeek(s[0])))
 			},
 		},
 	},
 	Specification{
-		FunctionName: "InsertBefore",
+		FunctionName: "Flatten",
 		StandardPath: Behavior{
-			Description: "Inserts before the first element passing the test.",
+			Description: "Concatenates the slices, in order.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				test := func(a interface{}) bool {
-					return a.(int)%2 == 0
+				s := samples(t, 2)
+				aa := [][]interface{}{
+					[]interface{}{s[0], s[1]},
+					[]interface{}{},
+					[]interface{}{s[1]},
 				}
-				generic.InsertBefore(&aa, 9, test)
-				bb := []interface{}{1, 9, 2, 3, 4}
-				assertSlicesEqual(t, aa, bb)
+				assert.Equal(t, []interface{}{s[0], s[1], s[1]}, generic.Flatten(aa))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Inserts at head if no tests pass",
+			Description: "Flattening nothing returns an empty slice.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				test := func(a interface{}) bool {
-					return a.(int) == 10
-				}
-				generic.InsertBefore(&aa, 9, test)
-				bb := []interface{}{9, 1, 2, 3, 4}
-				assertSlicesEqual(t, aa, bb)
+				assert.Empty(t, generic.Flatten([][]interface{}{}))
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "SliceTypes may be flattened along with other slices.",
+				Expectation: func(t *testing.T) {
+					s := samples(t, 2)
+					aa := [][]interface{}{
+						generic.SliceType{s[0], s[1]},
+						[]interface{}{s[1]},
+						nil,
+					}
+					assert.Equal(t, []interface{}{s[0], s[1], s[1]}, generic.Flatten(aa))
+				},
 			},
 		},
 	},
 	Specification{
-		FunctionName: "InsertAt",
+		FunctionName: "Fold",
 		StandardPath: Behavior{
-			Description: "Inserts properly in middle of list.",
+			Description: "Accumulates the elements from the initial value.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3}
-				generic.InsertAt(&aa, 9, 2)
-				bb := []interface{}{1, 2, 9, 3}
-				assertSlicesEqual(t, aa, bb)
+				s := samples(t, 3)
+				aa := []interface{}{s[1], s[0]}
+				assert.Equal(t, []interface{}{s[1]}, generic.Fold(aa, s[0], sampleMax))
+				assert.Equal(t, []interface{}{s[2]}, generic.Fold(aa, s[2], sampleMax))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Inserts into an empty list.",
+			Description: "Folding an empty slice returns the initial value.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{}
-				generic.InsertAt(&aa, 9, 0)
-				bb := []interface{}{9}
-				assertSlicesEqual(t, aa, bb)
+				s := samples(t, 1)
+				assert.Equal(t, []interface{}{s[0]}, generic.Fold([]interface{}{}, s[0], sampleMax))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "FoldI",
+		StandardPath: Behavior{
+			Description: "Accumulates the elements, along with their indices.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0], s[0]}
+				indices := []int64{}
+				folder := func(i int64, a, acc interface{}) interface{} {
+					indices = append(indices, i)
+					return sampleMax(a, acc)
+				}
+				assert.Equal(t, []interface{}{s[1]}, generic.FoldI(aa, s[1], folder))
+				assert.Equal(t, []int64{0, 1, 2}, indices)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Folding an empty slice returns the initial value.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 1)
+				folder := func(i int64, a, acc interface{}) interface{} { return a }
+				assert.Equal(t, []interface{}{s[0]}, generic.FoldI([]interface{}{}, s[0], folder))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "ForEach",
+		StandardPath: Behavior{
+			Description: "Visits each element in order.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[0]}
+				bb := []interface{}{}
+				generic.ForEach(aa, func(a interface{}) shared.Continue {
+					bb = append(bb, a)
+					return shared.ContinueYes
+				})
+				assert.Equal(t, aa, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Stops when the function returns ContinueNo.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[0]}
+				bb := []interface{}{}
+				generic.ForEach(aa, func(a interface{}) shared.Continue {
+					bb = append(bb, a)
+					return shared.Continue(!sampleEqual(a, s[1]))
+				})
+				assert.Equal(t, []interface{}{s[0], s[1]}, bb)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "ForEachC",
+		StandardPath: Behavior{
+			Description: "Visits each element concurrently.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[0], s[1]}
+				bb := []interface{}{}
+				mutex := sync.Mutex{}
+				generic.ForEachC(aa, 2, func(a interface{}, _ func() bool) shared.Continue {
+					mutex.Lock()
+					defer mutex.Unlock()
+					bb = append(bb, a)
+					return shared.ContinueYes
+				})
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Panics if the pool size is negative.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 1)
+				aa := []interface{}{s[0]}
+				assert.Panics(t, func() {
+					generic.ForEachC(aa, -1, func(interface{}, func() bool) shared.Continue {
+						return shared.ContinueYes
+					})
+				})
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "Long running operations wind down when a cancellation is broadcast.",
+				Expectation: func(t *testing.T) {
+					// The first two samples loop until cancellation is pending,
+					// which the third requests once both are running.
+					s := samples(t, 3)
+					aa := []interface{}{s[0], s[1], s[2]}
+					running := int64(0)
+					generic.ForEachC(aa, 3, func(a interface{}, cancelPending func() bool) shared.Continue {
+						if sampleEqual(a, s[2]) {
+							for atomic.LoadInt64(&running) < 2 {
+							}
+							return shared.ContinueNo
+						}
+						atomic.AddInt64(&running, 1)
+						for !cancelPending() {
+						}
+						return shared.ContinueYes
+					})
+				},
+			},
+			Behavior{
+				Description: "Upon cancellation, active goroutines wind down before the function returns.",
+				Expectation: func(t *testing.T) {
+					s := samples(t, 3)
+					aa := []interface{}{s[0], s[1], s[2]}
+					running := int64(0)
+					exited := int64(0)
+					generic.ForEachC(aa, 3, func(a interface{}, cancelPending func() bool) shared.Continue {
+						if sampleEqual(a, s[2]) {
+							for atomic.LoadInt64(&running) < 2 {
+							}
+							return shared.ContinueNo
+						}
+						atomic.AddInt64(&running, 1)
+						for !cancelPending() {
+						}
+						atomic.AddInt64(&exited, 1)
+						return shared.ContinueYes
+					})
+					assert.Equal(t, int64(2), atomic.LoadInt64(&exited))
+				},
+			},
+			Behavior{
+				Description: "Upon cancellation, no backlogged work is scheduled.",
+				Expectation: func(t *testing.T) {
+					// The fourth sample keeps the pool full while the first two
+					// wind down, so that the fifth is still backlogged when the
+					// third requests cancellation.
+					s := samples(t, 5)
+					aa := []interface{}{s[0], s[1], s[2], s[3], s[4]}
+					running := int64(0)
+					lastStarted := int64(0)
+					generic.ForEachC(aa, 3, func(a interface{}, cancelPending func() bool) shared.Continue {
+						switch sampleIndex(a) {
+						case 0, 1:
+							atomic.AddInt64(&running, 1)
+							for !cancelPending() {
+							}
+						case 2:
+							for atomic.LoadInt64(&running) < 2 {
+							}
+							return shared.ContinueNo
+						case 3:
+							time.Sleep(100 * time.Millisecond)
+							for !cancelPending() {
+							}
+							return shared.ContinueNo
+						default:
+							atomic.StoreInt64(&lastStarted, 1)
+						}
+						return shared.ContinueYes
+					})
+					assert.Zero(t, atomic.LoadInt64(&lastStarted))
+				},
+			},
+		},
+	},
+	Specification{
+		FunctionName: "ForEachR",
+		StandardPath: Behavior{
+			Description: "Visits each element in reverse order.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[1]}
+				bb := []interface{}{}
+				generic.ForEachR(aa, func(a interface{}) shared.Continue {
+					bb = append(bb, a)
+					return shared.ContinueYes
+				})
+				assert.Equal(t, []interface{}{s[1], s[1], s[0]}, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Stops when the function returns ContinueNo.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[1]}
+				bb := []interface{}{}
+				generic.ForEachR(aa, func(a interface{}) shared.Continue {
+					bb = append(bb, a)
+					return shared.ContinueNo
+				})
+				assert.Equal(t, []interface{}{s[1]}, bb)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Group",
+		StandardPath: Behavior{
+			Description: "Groups elements by key, preserving their order.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[0]}
+				bb := generic.Group(aa, sampleGroup)
+				cc := generic.SliceType2{
+					[]interface{}{s[0], s[0]},
+					[]interface{}{s[1]},
+				}
+				assertGroupsEqual(t, cc, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Grouping an empty slice returns no groups.",
+			Expectation: func(t *testing.T) {
+				assert.Empty(t, generic.Group([]interface{}{}, sampleGroup))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "GroupS",
+		StandardPath: Behavior{
+			Description: "Groups adjacent elements by key, in order.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0], s[1]}
+				bb := generic.GroupS(aa, sampleGroup)
+				cc := generic.SliceType2{
+					[]interface{}{s[0], s[0]},
+					[]interface{}{s[1]},
+				}
+				assert.Equal(t, cc, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Grouping an empty slice returns no groups.",
+			Expectation: func(t *testing.T) {
+				assert.Empty(t, generic.GroupS([]interface{}{}, sampleGroup))
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "Elements of a group that are not adjacent are grouped separately.",
+				Expectation: func(t *testing.T) {
+					s := samples(t, 2)
+					aa := []interface{}{s[0], s[0], s[1], s[0]}
+					bb := generic.GroupS(aa, sampleGroup)
+					cc := generic.SliceType2{
+						[]interface{}{s[0], s[0]},
+						[]interface{}{s[1]},
+						[]interface{}{s[0]},
+					}
+					assert.Equal(t, cc, bb)
+				},
+			},
+		},
+	},
+	Specification{
+		FunctionName: "GroupByTrait",
+		StandardPath: Behavior{
+			Description: "Groups elements sharing a trait.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[0]}
+				trait := func(ai, an interface{}) bool {
+					return sampleEqual(ai, an)
+				}
+				bb := generic.GroupByTrait(aa, trait, sampleEqual)
+				cc := generic.SliceType2{
+					[]interface{}{s[0], s[0]},
+					[]interface{}{s[1]},
+				}
+				assertGroupsEqual(t, cc, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Traits subsumed by another trait are not grouped separately.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				trait := func(ai, an interface{}) bool {
+					return !sampleLess(an, ai)
+				}
+				bb := generic.GroupByTrait(aa, trait, sampleEqual)
+				cc := generic.SliceType2{
+					[]interface{}{s[0], s[1]},
+				}
+				assertGroupsEqual(t, cc, bb)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "GroupI",
+		StandardPath: Behavior{
+			Description: "Groups elements by a key derived from their index.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[1], s[0]}
+				grouper := func(i int64, _ interface{}) string {
+					return sampleGroup(primitiveSamples[i%2])
+				}
+				bb := generic.GroupI(aa, grouper)
+				cc := generic.SliceType2{
+					[]interface{}{s[0], s[1]},
+					[]interface{}{s[1], s[0]},
+				}
+				assertGroupsEqual(t, cc, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Grouping an empty slice returns no groups.",
+			Expectation: func(t *testing.T) {
+				grouper := func(int64, interface{}) string { return "" }
+				assert.Empty(t, generic.GroupI([]interface{}{}, grouper))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Head",
+		StandardPath: Behavior{
+			Description: "Returns the first element.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				assert.Equal(t, []interface{}{s[0]}, generic.Head(aa))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Returns nothing for an empty slice.",
+			Expectation: func(t *testing.T) {
+				assert.Empty(t, generic.Head([]interface{}{}))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "InsertAfter",
+		StandardPath: Behavior{
+			Description: "Inserts after the first element passing the test.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0]}
+				generic.InsertAfter(&aa, s[1], always)
+				assert.Equal(t, []interface{}{s[0], s[1], s[0]}, aa)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Inserts at the end if no element passes the test.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0]}
+				generic.InsertAfter(&aa, s[1], never)
+				assert.Equal(t, []interface{}{s[0], s[0], s[1]}, aa)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "InsertBefore",
+		StandardPath: Behavior{
+			Description: "Inserts before the first element passing the test.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0]}
+				generic.InsertBefore(&aa, s[1], always)
+				assert.Equal(t, []interface{}{s[1], s[0], s[0]}, aa)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Inserts at the head if no element passes the test.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0]}
+				generic.InsertBefore(&aa, s[1], never)
+				assert.Equal(t, []interface{}{s[1], s[0], s[0]}, aa)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "InsertAt",
+		StandardPath: Behavior{
+			Description: "Inserts at the specified index.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0]}
+				generic.InsertAt(&aa, s[1], 1)
+				assert.Equal(t, []interface{}{s[0], s[1], s[0]}, aa)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Inserts into an empty slice.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 1)
+				aa := []interface{}{}
+				generic.InsertAt(&aa, s[0], 0)
+				assert.Equal(t, []interface{}{s[0]}, aa)
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "Inserts at the head if the index is negative.",
+				Expectation: func(t *testing.T) {
+					s := samples(t, 2)
+					aa := []interface{}{s[0], s[0]}
+					generic.InsertAt(&aa, s[1], -2)
+					assert.Equal(t, []interface{}{s[1], s[0], s[0]}, aa)
+				},
+			},
+			Behavior{
+				Description: "Inserts at the end if the index is beyond the end.",
+				Expectation: func(t *testing.T) {
+					s := samples(t, 2)
+					aa := []interface{}{s[0], s[0]}
+					generic.InsertAt(&aa, s[1], 10)
+					assert.Equal(t, []interface{}{s[0], s[0], s[1]}, aa)
+				},
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Intersection",
+		StandardPath: Behavior{
+			Description: "Returns the distinct elements common to both slices.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 3)
+				aa := []interface{}{s[0], s[1], s[1]}
+				bb := []interface{}{s[1], s[2]}
+				assert.Equal(t, []interface{}{s[1]}, generic.Intersection(aa, bb, sampleEqual))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Nothing intersects an empty slice.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 1)
+				aa := []interface{}{s[0]}
+				assert.Empty(t, generic.Intersection(aa, []interface{}{}, sampleEqual))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "IntersectionS",
+		StandardPath: Behavior{
+			Description: "Returns the distinct elements common to both sorted slices.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 3)
+				aa := []interface{}{s[0], s[1], s[1]}
+				bb := []interface{}{s[1], s[1], s[2]}
+				assert.Equal(t, []interface{}{s[1]}, generic.IntersectionS(aa, bb, sampleCompare))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Nothing intersects an empty slice.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 1)
+				aa := []interface{}{s[0]}
+				assert.Empty(t, generic.IntersectionS(aa, []interface{}{}, sampleCompare))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "IntersectionAuto",
+		StandardPath: Behavior{
+			Description: "Returns the distinct elements common to both slices.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 3)
+				aa := []interface{}{s[2], s[1], s[1], s[0]}
+				bb := []interface{}{s[1], s[2]}
+				assert.Equal(t, []interface{}{s[2], s[1]}, generic.IntersectionAuto(aa, bb))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Returns an empty slice if no elements are common.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				assert.Equal(t, []interface{}{}, generic.IntersectionAuto([]interface{}{s[0]}, []interface{}{s[1]}))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "IntersectionH",
+		StandardPath: Behavior{
+			Description: "Returns the distinct elements common to both slices.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 3)
+				aa := []interface{}{s[2], s[1], s[1], s[2]}
+				bb := []interface{}{s[1], s[2]}
+				assert.Equal(t, []interface{}{s[2], s[1]}, generic.IntersectionH(aa, bb, sampleHash, sampleEqual))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Elements whose hashes collide are compared for equality.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 3)
+				aa := []interface{}{s[0], s[1]}
+				bb := []interface{}{s[1], s[2]}
+				assert.Equal(t, []interface{}{s[1]}, generic.IntersectionH(aa, bb, collide, sampleEqual))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "IntersectionT",
+		StandardPath: Behavior{
+			Description: "Returns the distinct elements common to both slices.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 3)
+				aa := []interface{}{s[2], s[1], s[1], s[2]}
+				bb := []interface{}{s[1], s[2]}
+				assert.Equal(t, []interface{}{s[2], s[1]}, generic.IntersectionT(aa, bb, sampleLess, sampleEqual))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Elements are compared by cluster.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 3)
+				aa := []interface{}{s[2], s[0]}
+				bb := []interface{}{s[1]}
+				assert.Equal(t, []interface{}{s[0]}, generic.IntersectionT(aa, bb, sampleLess, sampleNear))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "IsProperSubset",
+		StandardPath: Behavior{
+			Description: "True if aa is a subset of, but not equal to, bb.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0]}
+				bb := []interface{}{s[0], s[1]}
+				assert.True(t, generic.IsProperSubset(aa, bb, sampleEqual))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "False if aa equals bb.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				bb := []interface{}{s[1], s[0]}
+				assert.False(t, generic.IsProperSubset(aa, bb, sampleEqual))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "IsProperSubsetS",
+		StandardPath: Behavior{
+			Description: "True if aa is within bb, and bb has more.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0]}
+				bb := []interface{}{s[0], s[1]}
+				assert.True(t, generic.IsProperSubsetS(aa, bb, sampleCompare))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "False if the slices hold the same elements.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				assert.False(t, generic.IsProperSubsetS(aa, aa, sampleCompare))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "IsProperSuperset",
+		StandardPath: Behavior{
+			Description: "True if aa is a superset of, but not equal to, bb.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				bb := []interface{}{s[0]}
+				assert.True(t, generic.IsProperSuperset(aa, bb, sampleEqual))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "False if aa equals bb.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				bb := []interface{}{s[1], s[0]}
+				assert.False(t, generic.IsProperSuperset(aa, bb, sampleEqual))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "IsProperSupersetS",
+		StandardPath: Behavior{
+			Description: "True if bb is within aa, and aa has more.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				bb := []interface{}{s[1]}
+				assert.True(t, generic.IsProperSupersetS(aa, bb, sampleCompare))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "False if the slices hold the same elements.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				assert.False(t, generic.IsProperSupersetS(aa, aa, sampleCompare))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "IsSubset",
+		StandardPath: Behavior{
+			Description: "True if every element of aa is in bb.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0]}
+				bb := []interface{}{s[0], s[1]}
+				assert.True(t, generic.IsSubset(aa, bb, sampleEqual))
+				assert.True(t, generic.IsSubset(bb, bb, sampleEqual))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "False if some element of aa is not in bb.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				bb := []interface{}{s[0]}
+				assert.False(t, generic.IsSubset(aa, bb, sampleEqual))
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "Duplicates in aa need only one equal element in bb.",
+				Expectation: func(t *testing.T) {
+					s := samples(t, 2)
+					aa := []interface{}{s[0], s[0]}
+					bb := []interface{}{s[0]}
+					assert.True(t, generic.IsSubset(aa, bb, sampleEqual))
+					assert.True(t, generic.IsSubset(aa, aa, sampleEqual))
+					assert.True(t, generic.IsProperSubset(aa, []interface{}{s[0], s[0], s[1]}, sampleEqual))
+					assert.True(t, generic.IsSuperset(bb, aa, sampleEqual))
+				},
+			},
+		},
+	},
+	Specification{
+		FunctionName: "IsSubsetS",
+		StandardPath: Behavior{
+			Description: "True if every element of aa is in bb.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[1], s[1]}
+				bb := []interface{}{s[0], s[1]}
+				assert.True(t, generic.IsSubsetS(aa, bb, sampleCompare))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "False if some element of aa is not in bb.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				bb := []interface{}{s[0]}
+				assert.False(t, generic.IsSubsetS(aa, bb, sampleCompare))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "IsSubsetH",
+		StandardPath: Behavior{
+			Description: "True if every element of aa is in bb.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0]}
+				bb := []interface{}{s[0], s[1]}
+				assert.True(t, generic.IsSubsetH(aa, bb, sampleHash, sampleEqual))
+				assert.True(t, generic.IsSubsetH(bb, bb, collide, sampleEqual))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "False if some element of aa is not in bb.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				bb := []interface{}{s[0]}
+				assert.False(t, generic.IsSubsetH(aa, bb, sampleHash, sampleEqual))
+				assert.False(t, generic.IsSubsetH(aa, bb, collide, sampleEqual))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "IsSuperset",
+		StandardPath: Behavior{
+			Description: "True if every element of bb is in aa.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				bb := []interface{}{s[0]}
+				assert.True(t, generic.IsSuperset(aa, bb, sampleEqual))
+				assert.True(t, generic.IsSuperset(aa, aa, sampleEqual))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "False if some element of bb is not in aa.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0]}
+				bb := []interface{}{s[0], s[1]}
+				assert.False(t, generic.IsSuperset(aa, bb, sampleEqual))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "IsSupersetS",
+		StandardPath: Behavior{
+			Description: "True if every element of bb is in aa.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				bb := []interface{}{s[0], s[0]}
+				assert.True(t, generic.IsSupersetS(aa, bb, sampleCompare))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "False if some element of bb is not in aa.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0]}
+				bb := []interface{}{s[0], s[1]}
+				assert.False(t, generic.IsSupersetS(aa, bb, sampleCompare))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Item",
+		StandardPath: Behavior{
+			Description: "Returns the element at the specified index.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				assert.Equal(t, []interface{}{s[1]}, generic.Item(aa, 1))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Returns nothing if the index is out of range.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				assert.Empty(t, generic.Item(aa, -1))
+				assert.Empty(t, generic.Item(aa, 2))
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "Returns nothing if the index is negative.",
+				Expectation: func(t *testing.T) {
+					s := samples(t, 1)
+					assert.Equal(t, []interface{}{}, generic.Item([]interface{}{s[0]}, -1))
+				},
+			},
+			Behavior{
+				Description: "Returns nothing if the index is beyond the end.",
+				Expectation: func(t *testing.T) {
+					s := samples(t, 1)
+					assert.Equal(t, []interface{}{}, generic.Item([]interface{}{s[0]}, 10))
+				},
+			},
+		},
+	},
+	Specification{
+		FunctionName: "ItemFuzzy",
+		StandardPath: Behavior{
+			Description: "Returns the element at the specified index.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				assert.Equal(t, []interface{}{s[1]}, generic.ItemFuzzy(aa, 1))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Returns nothing for an empty slice, whatever the index.",
+			Expectation: func(t *testing.T) {
+				for i := int64(-1); i <= 1; i++ {
+					assert.Empty(t, generic.ItemFuzzy([]interface{}{}, i))
+				}
 			},
 		},
 		EdgeCases: []Behavior{
 			Behavior{
-				Description: "Negative index inserted at 0",
+				Description: "Returns the head if the index is negative.",
 				Expectation: func(t *testing.T) {
-					aa := []interface{}{1, 2, 3}
-					generic.InsertAt(&aa, 9, -2)
-					bb := []interface{}{9, 1, 2, 3}
-					assertSlicesEqual(t, aa, bb)
+					s := samples(t, 2)
+					aa := []interface{}{s[0], s[1]}
+					assert.Equal(t, []interface{}{s[0]}, generic.ItemFuzzy(aa, -1))
 				},
 			},
 			Behavior{
-				Description: "Index greater than length appended to end.",
+				Description: "Returns the end if the index is beyond the end.",
 				Expectation: func(t *testing.T) {
-					aa := []interface{}{1, 2, 3}
-					generic.InsertAt(&aa, 9, 99)
-					bb := []interface{}{1, 2, 3, 9}
-					assertSlicesEqual(t, aa, bb)
+					s := samples(t, 2)
+					aa := []interface{}{s[0], s[1]}
+					assert.Equal(t, []interface{}{s[1]}, generic.ItemFuzzy(aa, 10))
 				},
 			},
 		},
 	},
 	Specification{
-		FunctionName: "Intersection",
+		FunctionName: "Last",
 		StandardPath: Behavior{
-			Description: "Returns a slice of the commmon items.",
+			Description: "Returns the last element passing the test.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 4, 2, 5}
-				bb := []interface{}{4, 3, 7, 2}
-				equality := func(a, b interface{}) bool {
-					return a.(int) == b.(int)
-				}
-				cc := generic.Intersection(aa, bb, equality)
-				dd := []interface{}{4, 2}
-				assertSlicesEqual(t, cc, dd)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[0]}
+				assert.Equal(t, []interface{}{s[1]}, generic.Last(aa, equalTo(s[1])))
+				assert.Equal(t, []interface{}{s[0]}, generic.Last(aa, always))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Duplicates are not retained",
+			Description: "Returns nothing if no element passes the test.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 4, 2, 2, 2, 5, 4}
-				bb := []interface{}{4, 3, 2, 7, 2}
-				equality := func(a, b interface{}) bool {
-					return a.(int) == b.(int)
-				}
-				cc := generic.Intersection(aa, bb, equality)
-				dd := []interface{}{4, 2}
-				assertSlicesEqual(t, cc, dd)
+				s := samples(t, 1)
+				aa := []interface{}{s[0]}
+				assert.Empty(t, generic.Last(aa, never))
 			},
 		},
 	},
 	Specification{
-		FunctionName: "IsProperSubset",
+		FunctionName: "LastS",
 		StandardPath: Behavior{
-			Description: "Returns true if aa is a proper subset of bb",
+			Description: "Returns the last sought element.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				bb := []interface{}{1, 2, 3, 4, 5}
-				equality := func(a, b interface{}) bool {
-					return a.(int) == b.(int)
-				}
-				result := generic.IsProperSubset(aa, bb, equality)
-				assert.True(t, result)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0], s[1]}
+				assert.Equal(t, []interface{}{s[0]}, generic.LastS(aa, sampleSeek(s[0])))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Returns false if aa is not a proper subset of bb",
+			Description: "Returns nothing if no element is sought.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4, 5}
-				bb := []interface{}{1, 2, 3, 4, 5}
-				equality := func(a, b interface{}) bool {
-					return a.(int) == b.(int)
-				}
-				result := generic.IsProperSubset(aa, bb, equality)
-				assert.False(t, result)
+				s := samples(t, 2)
+				aa := []interface{}{s[0]}
+				assert.Empty(t, generic.LastS(aa, sampleSeek(s[1])))
 			},
 		},
 	},
 	Specification{
-		FunctionName: "IsProperSuperset",
+		FunctionName: "Len",
 		StandardPath: Behavior{
-			Description: "Returns true if aa is a proper superset of bb",
+			Description: "Returns the number of elements.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4, 5}
-				bb := []interface{}{1, 2, 3, 4}
-				equality := func(a, b interface{}) bool {
-					return a.(int) == b.(int)
-				}
-				result := generic.IsProperSuperset(aa, bb, equality)
-				assert.True(t, result)
+				s := samples(t, 1)
+				assert.Equal(t, 2, generic.Len([]interface{}{s[0], s[0]}))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Returns false if aa is not a proper superset of bb",
+			Description: "A nil slice has no elements.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4, 5}
-				bb := []interface{}{1, 2, 3, 4, 5}
-				equality := func(a, b interface{}) bool {
-					return a.(int) == b.(int)
-				}
-				result := generic.IsProperSuperset(aa, bb, equality)
-				assert.False(t, result)
+				assert.Equal(t, 0, generic.Len(nil))
 			},
 		},
 	},
 	Specification{
-		FunctionName: "IsSubset",
+		FunctionName: "Map",
 		StandardPath: Behavior{
-			Description: "Returns true if aa is a subset of bb",
+			Description: "Returns the result of converting each element.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				bb := []interface{}{1, 2, 3, 4}
-				equality := func(a, b interface{}) bool {
-					return a.(int) == b.(int)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				convertFn := func(a interface{}) interface{} {
+					return primitiveSamples[1-sampleIndex(a)]
 				}
-				result := generic.IsSubset(aa, bb, equality)
-				assert.True(t, result)
+				bb := generic.Map(aa, convertFn)
+				assert.Equal(t, []interface{}{s[1], s[0]}, bb)
+				assert.Equal(t, []interface{}{s[0], s[1]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Returns false if aa is not a subset of bb",
+			Description: "Mapping an empty slice returns an empty slice.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{6, 7, 8, 9, 0}
-				bb := []interface{}{1, 2, 3, 4, 5}
-				equality := func(a, b interface{}) bool {
-					return a.(int) == b.(int)
-				}
-				result := generic.IsSubset(aa, bb, equality)
-				assert.False(t, result)
+				convertFn := func(a interface{}) interface{} { return a }
+				assert.Empty(t, generic.Map([]interface{}{}, convertFn))
 			},
 		},
 	},
 	Specification{
-		FunctionName: "IsSuperset",
+		FunctionName: "MergeSorted",
 		StandardPath: Behavior{
-			Description: "Returns true if aa is a superset of bb",
+			Description: "Merges sorted slices into one sorted slice.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				bb := []interface{}{1, 2, 3, 4}
-				equality := func(a, b interface{}) bool {
-					return a.(int) == b.(int)
-				}
-				result := generic.IsSuperset(aa, bb, equality)
-				assert.True(t, result)
+				s := samples(t, 3)
+				aa := []interface{}{s[0], s[2]}
+				bb := []interface{}{s[1], s[2]}
+				cc := generic.MergeSorted(sampleLess, aa, bb)
+				assert.Equal(t, []interface{}{s[0], s[1], s[2], s[2]}, cc)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Returns false if aa is not a superset of bb",
+			Description: "Empty slices are merged, and merging nothing returns an empty slice.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4, 5}
-				bb := []interface{}{6, 7, 8, 9, 0}
-				equality := func(a, b interface{}) bool {
-					return a.(int) == b.(int)
-				}
-				result := generic.IsSuperset(aa, bb, equality)
-				assert.False(t, result)
+				s := samples(t, 1)
+				aa := generic.MergeSorted(sampleLess, []interface{}{}, []interface{}{s[0]}, nil)
+				assert.Equal(t, []interface{}{s[0]}, aa)
+				assert.Equal(t, []interface{}{}, generic.MergeSorted(sampleLess))
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "The result matches sorting the concatenated slices.",
+				Expectation: func(t *testing.T) {
+					slices, all := randomSortedSamples(1)
+					assert.Equal(t, all, generic.MergeSorted(sampleLess, slices...))
+				},
 			},
 		},
 	},
 	Specification{
-		FunctionName: "Item",
+		FunctionName: "MergeSortedWith",
 		StandardPath: Behavior{
-			Description: "Element at i is returned.",
+			Description: "With MergeStable, elements that sort alike keep the order of their slices.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3}
-				bb := generic.Item(aa, 1)
-				cc := []interface{}{2}
-				assertSlicesEqual(t, bb, cc)
+				s := samples(t, 3)
+				aa := []interface{}{s[2], s[0]}
+				bb := []interface{}{s[1]}
+				alike := func(a, b interface{}) bool { return false }
+				cc := generic.MergeSortedWith(alike, generic.MergeStable, aa, bb)
+				assert.Equal(t, []interface{}{s[2], s[0], s[1]}, cc)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "aa is empty, returns empty for any index",
+			Description: "With MergeDistinct, equal elements are merged once.",
 			Expectation: func(t *testing.T) {
-				for i := int64(-1); i <= 1; i++ {
-					aa := []interface{}{}
-					bb := generic.ItemFuzzy(aa, i)
-					cc := []interface{}{}
-					assertSlicesEqual(t, bb, cc)
-				}
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				bb := []interface{}{s[0], s[1], s[1]}
+				cc := generic.MergeSortedWith(sampleLess, generic.MergeStable|generic.MergeDistinct, aa, bb)
+				assert.Equal(t, []interface{}{s[0], s[1]}, cc)
 			},
 		},
 		EdgeCases: []Behavior{
 			Behavior{
-				Description: "i < 0 returns empty",
+				Description: "With no options, the merge is that of MergeSorted.",
 				Expectation: func(t *testing.T) {
-					aa := []interface{}{1, 2, 3}
-					bb := generic.Item(aa, -1)
-					cc := []interface{}{}
-					assertSlicesEqual(t, bb, cc)
+					s := samples(t, 3)
+					aa := []interface{}{s[0], s[2]}
+					bb := []interface{}{s[1], s[2]}
+					assert.Equal(t, []interface{}{s[0], s[1], s[2], s[2]}, generic.MergeSortedWith(sampleLess, 0, aa, bb))
+					assert.Equal(t, []interface{}{}, generic.MergeSortedWith(sampleLess, generic.MergeStable))
 				},
 			},
 			Behavior{
-				Description: "i >= len(aa) returns empty",
+				Description: "With MergeDistinct, the result matches sorting the concatenated slices and removing duplicates.",
 				Expectation: func(t *testing.T) {
-					aa := []interface{}{1, 2, 3}
-					bb := generic.Item(aa, 10)
-					cc := []interface{}{}
-					assertSlicesEqual(t, bb, cc)
+					slices, all := randomSortedSamples(2)
+					generic.DistinctS(&all, sampleEqual)
+					assert.Equal(t, all, generic.MergeSortedWith(sampleLess, generic.MergeDistinct, slices...))
 				},
 			},
 		},
 	},
 	Specification{
-		FunctionName: "ItemFuzzy",
+		FunctionName: "None",
 		StandardPath: Behavior{
-			Description: "Element at i is returned.",
+			Description: "True if no element passes the test.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3}
-				bb := generic.ItemFuzzy(aa, 1)
-				cc := []interface{}{2}
-				assertSlicesEqual(t, bb, cc)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0]}
+				assert.True(t, generic.None(aa, equalTo(s[1])))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "aa is empty, returns empty for any index",
+			Description: "False if any element passes the test.",
 			Expectation: func(t *testing.T) {
-				for i := int64(-1); i <= 1; i++ {
-					aa := []interface{}{}
-					bb := generic.ItemFuzzy(aa, i)
-					cc := []interface{}{}
-					assertSlicesEqual(t, bb, cc)
-				}
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				assert.False(t, generic.None(aa, equalTo(s[1])))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "NthElement",
+		StandardPath: Behavior{
+			Description: "Places the nth element as it would be were the slice sorted.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 3)
+				aa := []interface{}{s[2], s[0], s[1]}
+				generic.NthElement(&aa, 1, sampleLess)
+				assert.Equal(t, s[1], aa[1])
+				assert.ElementsMatch(t, []interface{}{s[0], s[1], s[2]}, aa)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Does nothing if n is out of range.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[1], s[0]}
+				generic.NthElement(&aa, 2, sampleLess)
+				assert.Equal(t, []interface{}{s[1], s[0]}, aa)
 			},
 		},
 		EdgeCases: []Behavior{
 			Behavior{
-				Description: "i < 0 returns head",
+				Description: "Every element is selected as Sort would place it, however the slice is ordered.",
 				Expectation: func(t *testing.T) {
-					aa := []interface{}{1, 2, 3}
-					bb := generic.ItemFuzzy(aa, -1)
-					cc := []interface{}{1}
-					assertSlicesEqual(t, bb, cc)
+					s := samples(t, 3)
+					sorted := []interface{}{s[0], s[0], s[1], s[2]}
+					for _, aa := range generic.Permute(sorted) {
+						for n := range sorted {
+							bb := append([]interface{}{}, aa.([]interface{})...)
+							generic.NthElement(&bb, int64(n), sampleLess)
+							assert.Equal(t, sorted[n], bb[n])
+							assert.ElementsMatch(t, sorted, bb)
+						}
+					}
 				},
 			},
 			Behavior{
-				Description: "i >= len(aa) returns end",
+				Description: "The less function is called O(n) times on average.",
 				Expectation: func(t *testing.T) {
-					aa := []interface{}{1, 2, 3}
-					bb := generic.ItemFuzzy(aa, 10)
-					cc := []interface{}{3}
-					assertSlicesEqual(t, bb, cc)
+					r := rand.New(rand.NewSource(1))
+					aa := []interface{}{}
+					for i := 0; i < 10000; i++ {
+						aa = append(aa, primitiveSamples[r.Intn(len(primitiveSamples))])
+					}
+					calls := 0
+					generic.NthElement(&aa, 5000, func(a, b interface{}) bool {
+						calls++
+						return sampleLess(a, b)
+					})
+					assert.True(t, calls < 10*10000, "%v calls", calls)
 				},
 			},
 		},
 	},
 	Specification{
-		FunctionName: "Last",
+		FunctionName: "NoneS",
 		StandardPath: Behavior{
-			Description: "Returns the last that matches the expectation.",
+			Description: "True if the sorted slice holds no sought element.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4, 5, 6, 7, 8}
-				test := func(a interface{}) bool {
-					return a.(int)%2 != 0
-				}
-				bb := generic.Last(aa, test)
-				cc := []interface{}{7}
-				assertSlicesEqual(t, bb, cc)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0]}
+				assert.True(t, generic.NoneS(aa, sampleSeek(s[1])))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "False if the sorted slice holds a sought element.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				assert.False(t, generic.NoneS(aa, sampleSeek(s[1])))
 			},
 		},
 	},
 	Specification{
-		FunctionName: "Len",
+		FunctionName: "Pairwise",
 		StandardPath: Behavior{
-			Description: "Returns the length of the slice",
+			Description: "Transforms each element with its predecessor.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4, 5, 6, 7, 8}
-				assert.Equal(t, 8, generic.Len(aa))
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[0]}
+				xform := func(a, b interface{}) interface{} {
+					return a
+				}
+				bb := generic.Pairwise(aa, s[1], xform)
+				assert.Equal(t, []interface{}{s[1], s[0], s[1]}, bb)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "Returns nothing for an empty slice.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				s := samples(t, 1)
+				assert.Empty(t, generic.Pairwise([]interface{}{}, s[0], sampleMax))
 			},
 		},
 	},
 	Specification{
-		FunctionName: "Apply",
+		FunctionName: "PartialSort",
 		StandardPath: Behavior{
-			Description: "Applies the transform to each element",
+			Description: "Sorts the first k positions, leaving the rest in order.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3}
-				mapFn := func(a interface{}) interface{} {
-					return a.(int) * 2
-				}
-				generic.Apply(&aa, mapFn)
-				bb := []interface{}{2, 4, 6}
-				assertSlicesEqual(t, aa, bb)
+				s := samples(t, 3)
+				aa := []interface{}{s[2], s[1], s[0], s[1]}
+				generic.PartialSort(&aa, 2, sampleLess)
+				assert.Equal(t, []interface{}{s[0], s[1], s[2], s[1]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
-			Expectation: func(t *testing.T) {
-				t.Skip()
-			},
-		},
-	},
-	Specification{
-		FunctionName: "None",
-		StandardPath: Behavior{
-			Description: "Returns true if the test fails for all items",
+			Description: "Does nothing if k is not positive.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3}
-				test := func(a interface{}) bool {
-					return a.(int) == 4
-				}
-				assert.True(t, generic.None(aa, test))
+				s := samples(t, 2)
+				aa := []interface{}{s[1], s[0]}
+				generic.PartialSort(&aa, 0, sampleLess)
+				assert.Equal(t, []interface{}{s[1], s[0]}, aa)
 			},
 		},
-		AlternativePath: Behavior{
-			Description: "Returns false if the test passes for any item",
-			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3}
-				test := func(a interface{}) bool {
-					return a.(int) == 2
-				}
-				assert.False(t, generic.None(aa, test))
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "Elements that sort alike keep their order.",
+				Expectation: func(t *testing.T) {
+					s := samples(t, 3)
+					aa := []interface{}{s[1], s[0], s[2]}
+					generic.PartialSort(&aa, 2, func(a, b interface{}) bool { return false })
+					assert.Equal(t, []interface{}{s[1], s[0], s[2]}, aa)
+				},
 			},
 		},
 	},
 	Specification{
-		FunctionName: "Pairwise",
+		FunctionName: "Partition",
 		StandardPath: Behavior{
-			Description: "Processes elements pairwise",
+			Description: "Splits the elements by the result of the test.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{"W", "X", "Y", "Z"}
-				xform := func(a, b interface{}) interface{} {
-					return a.(string) + b.(string)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[0]}
+				bb := generic.Partition(aa, equalTo(s[0]))
+				cc := generic.SliceType2{
+					[]interface{}{s[0], s[0]},
+					[]interface{}{s[1]},
 				}
-				bb := generic.Pairwise(aa, "V", xform)
-				cc := []interface{}{"VW", "WX", "XY", "YZ"}
-				assertSlicesEqual(t, bb, cc)
+				assertGroupsEqual(t, cc, bb)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Returns empty slice if aa is empty",
+			Description: "Partitioning an empty slice returns no partitions.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{}
-				xform := func(a, b interface{}) interface{} {
-					return a.(string) + b.(string)
-				}
-				bb := generic.Pairwise(aa, "V", xform)
-				cc := []interface{}{}
-				assertSlicesEqual(t, bb, cc)
+				assert.Empty(t, generic.Partition([]interface{}{}, always))
 			},
 		},
 	},
 	Specification{
-		FunctionName: "Partition",
+		FunctionName: "PartitionS",
 		StandardPath: Behavior{
-			Description: "Parition splits the slice as expected",
+			Description: "Splits the sorted elements where the test begins to pass.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4, 5, 6}
-				test := func(a interface{}) bool {
-					return a.(int)%2 == 0
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0], s[1]}
+				bb := generic.PartitionS(aa, equalTo(s[1]))
+				cc := generic.SliceType2{
+					[]interface{}{s[1]},
+					[]interface{}{s[0], s[0]},
 				}
-				bb := generic.Partition(aa, test)
-				cc := generic.SliceType{generic.SliceType{2, 4, 6}, []interface{}{1, 3, 5}}
-				assertSlicesEqual(t, bb, cc)
+				assert.Equal(t, cc, bb)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "Partitioning an empty slice returns two empty partitions.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				bb := generic.PartitionS([]interface{}{}, always)
+				assert.Equal(t, generic.SliceType2{[]interface{}{}, []interface{}{}}, bb)
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "The partitions do not share the slice's memory.",
+				Expectation: func(t *testing.T) {
+					s := samples(t, 2)
+					aa := []interface{}{s[0], s[1], s[1]}
+					bb := generic.PartitionS(aa, equalTo(s[1]))
+					bb[0].([]interface{})[0] = s[0]
+					bb[1] = append(bb[1].([]interface{}), s[1])
+					assert.Equal(t, []interface{}{s[0], s[1], s[1]}, aa)
+				},
 			},
 		},
 	},
 	Specification{
 		FunctionName: "Permutable",
 		StandardPath: Behavior{
-			Description: "Returns true if the slice has less than MaxInt64 permutations.",
+			Description: "True if the permutations can be enumerated.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{}
-				for i := 0; i < 20; i++ {
-					generic.Append(&aa, i)
-				}
-				assert.True(t, generic.Permutable(aa))
+				s := samples(t, 2)
+				assert.True(t, generic.Permutable([]interface{}{s[0], s[1]}))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Returns if the slice has more than MaxInt64 permutations.",
+			Description: "False if there are more than MaxInt64 permutations.",
 			Expectation: func(t *testing.T) {
+				s := samples(t, 1)
 				aa := []interface{}{}
 				for i := 0; i < 21; i++ {
-					generic.Append(&aa, i)
+					aa = append(aa, s[0])
 				}
 				assert.False(t, generic.Permutable(aa))
 			},
@@ -1327,67 +2059,59 @@ var Specifications = []Specification{
 	Specification{
 		FunctionName: "Permutations",
 		StandardPath: Behavior{
-			Description: "Returns the correct number of possible permutations.",
+			Description: "Returns the number of permutations.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4, 5, 6}
-				p := generic.Permutations(aa)
-				assert.Equal(t, int64(720), p.Int64())
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[0]}
+				assert.Equal(t, big.NewInt(6), generic.Permutations(aa))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "An empty slice has one permutation.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				assert.Equal(t, big.NewInt(1), generic.Permutations([]interface{}{}))
 			},
 		},
 	},
 	Specification{
 		FunctionName: "Permute",
 		StandardPath: Behavior{
-			Description: "Creates permutations.",
+			Description: "Returns every permutation.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{"A", "B", "C"}
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
 				bb := generic.Permute(aa)
-				cc := generic.SliceType{
-					generic.SliceType{"A", "B", "C"},
-					generic.SliceType{"B", "A", "C"},
-					generic.SliceType{"C", "A", "B"},
-					generic.SliceType{"A", "C", "B"},
-					generic.SliceType{"B", "C", "A"},
-					generic.SliceType{"C", "B", "A"},
+				cc := generic.SliceType2{
+					[]interface{}{s[0], s[1]},
+					[]interface{}{s[1], s[0]},
 				}
-				assertSlicesEqual(t, bb, cc)
+				assertGroupsEqual(t, cc, bb)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Original slice is unaltered.",
+			Description: "An empty slice has no permutations to return.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{"A", "B", "C"}
-				generic.Permute(aa)
-				cc := []interface{}{"A", "B", "C"}
-				assertSlicesEqual(t, aa, cc)
+				assert.Empty(t, generic.Permute([]interface{}{}))
 			},
 		},
 		EdgeCases: []Behavior{
 			Behavior{
-				Description: `Permute should panic if the number of permutations
-						  would exceed MaxInt64`,
+				Description: "Panics if the number of permutations would exceed MaxInt64.",
 				Expectation: func(t *testing.T) {
+					s := samples(t, 1)
 					aa := []interface{}{}
 					for n := 0; n < 21; n++ {
-						generic.Append(&aa, n)
+						generic.Append(&aa, s[0])
 					}
 					assert.Panics(t, func() { generic.Permute(aa) })
 				},
 			},
 			Behavior{
-				Description: `If source is empty, empty slice should be returned.`,
+				Description: "A single element has a single permutation.",
 				Expectation: func(t *testing.T) {
-					aa := []interface{}{}
-					bb := generic.Permute(aa)
-					if len(bb) != 0 {
-						t.Error("Expected bb to be empty, but it was not.")
-					}
+					s := samples(t, 1)
+					bb := generic.Permute([]interface{}{s[0]})
+					assert.Equal(t, generic.SliceType2{[]interface{}{s[0]}}, bb)
 				},
 			},
 		},
@@ -1395,82 +2119,66 @@ var Specifications = []Specification{
 	Specification{
 		FunctionName: "Pop",
 		StandardPath: Behavior{
-			Description: "Returns the head element from the slice, removing it from the slice.",
+			Description: "Removes and returns the head of the slice.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3}
-				bb := generic.Pop(&aa)
-				cc := []interface{}{2, 3}
-				dd := []interface{}{1}
-				assertSlicesEqual(t, aa, cc)
-				assertSlicesEqual(t, bb, dd)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				assert.Equal(t, []interface{}{s[0]}, generic.Pop(&aa))
+				assert.Equal(t, []interface{}{s[1]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Slice is empty, returns an empty slice.",
+			Description: "Popping an empty slice returns nothing.",
 			Expectation: func(t *testing.T) {
 				aa := []interface{}{}
-				bb := generic.Pop(&aa)
-				if len(bb) > 0 {
-					t.Error("Expected bb to be empty.")
-				}
+				assert.Empty(t, generic.Pop(&aa))
+				assert.Empty(t, aa)
 			},
 		},
 	},
 	Specification{
 		FunctionName: "Push",
 		StandardPath: Behavior{
-			Description: "Inserts a new element at the head of the slice.",
+			Description: "Places the value at the head of the slice.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3}
-				generic.Push(&aa, 9)
-				bb := []interface{}{9, 1, 2, 3}
-				assertSlicesEqual(t, aa, bb)
+				s := samples(t, 2)
+				aa := []interface{}{s[0]}
+				generic.Push(&aa, s[1])
+				assert.Equal(t, []interface{}{s[1], s[0]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "Pushing to an empty slice.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				s := samples(t, 1)
+				aa := []interface{}{}
+				generic.Push(&aa, s[0])
+				assert.Equal(t, []interface{}{s[0]}, aa)
 			},
 		},
 	},
 	Specification{
 		FunctionName: "Reduce",
 		StandardPath: Behavior{
-			Description: "Slice is reduced as expected.",
+			Description: "Accumulates the elements from the first.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				reducer := func(a, acc interface{}) interface{} {
-					return a.(int) + acc.(int)
-				}
-				bb := generic.Reduce(aa, reducer)
-				cc := []interface{}{10}
-				assertSlicesEqual(t, bb, cc)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[0]}
+				assert.Equal(t, []interface{}{s[1]}, generic.Reduce(aa, sampleMax))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Reducing empty slice returns an empty slice.",
+			Description: "Reducing an empty slice returns nothing.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{}
-				reducer := func(a, acc interface{}) interface{} {
-					return a.(int) + acc.(int)
-				}
-				bb := generic.Reduce(aa, reducer)
-				cc := []interface{}{}
-				assertSlicesEqual(t, bb, cc)
+				assert.Empty(t, generic.Reduce([]interface{}{}, sampleMax))
 			},
 		},
 		EdgeCases: []Behavior{
 			Behavior{
-				Description: "Reducing a single element slice returns a single element slice.",
+				Description: "Reducing a single element slice returns that element.",
 				Expectation: func(t *testing.T) {
-					aa := []interface{}{1}
-					reducer := func(a, acc interface{}) interface{} {
-						return a.(int) + acc.(int)
-					}
-					bb := generic.Reduce(aa, reducer)
-					cc := []interface{}{1}
-					assertSlicesEqual(t, bb, cc)
+					s := samples(t, 1)
+					assert.Equal(t, []interface{}{s[0]}, generic.Reduce([]interface{}{s[0]}, sampleMax))
 				},
 			},
 		},
@@ -1478,53 +2186,48 @@ var Specifications = []Specification{
 	Specification{
 		FunctionName: "Remove",
 		StandardPath: Behavior{
-			Description: "Removes the items that pass the test.",
+			Description: "Removes the elements passing the test.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4, 5, 6}
-				test := func(a interface{}) bool {
-					return a.(int)%2 == 0
-				}
-				generic.Remove(&aa, test)
-				bb := []interface{}{1, 3, 5}
-				assertSlicesEqual(t, aa, bb)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[0]}
+				generic.Remove(&aa, equalTo(s[0]))
+				assert.Equal(t, []interface{}{s[1]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Does nothing if no items satisfy test.",
+			Description: "The slice is unchanged if no element passes the test.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4, 5, 6}
-				test := func(a interface{}) bool {
-					return a.(int) == 10
-				}
-				generic.Remove(&aa, test)
-				bb := []interface{}{1, 2, 3, 4, 5, 6}
-				assertSlicesEqual(t, aa, bb)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				generic.Remove(&aa, never)
+				assert.Equal(t, []interface{}{s[0], s[1]}, aa)
 			},
 		},
 	},
 	Specification{
 		FunctionName: "RemoveAt",
 		StandardPath: Behavior{
-			Description: "Removes the item at the specified index.",
+			Description: "Removes the element at the specified index.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				generic.RemoveAt(&aa, 2)
-				bb := []interface{}{1, 2, 4}
-				assertSlicesEqual(t, aa, bb)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[0]}
+				generic.RemoveAt(&aa, 1)
+				assert.Equal(t, []interface{}{s[0], s[0]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Does nothing if slice is empty.",
+			Description: "The slice is unchanged if the index is out of range.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{}
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
 				generic.RemoveAt(&aa, 2)
-				bb := []interface{}{}
-				assertSlicesEqual(t, aa, bb)
+				generic.RemoveAt(&aa, -1)
+				assert.Equal(t, []interface{}{s[0], s[1]}, aa)
 			},
 		},
 		EdgeCases: []Behavior{
 			Behavior{
-				Description: "Does nothing if slice is nil",
+				Description: "Does nothing if the slice is nil.",
 				Expectation: func(t *testing.T) {
 					var aa []interface{}
 					generic.RemoveAt(&aa, 2)
@@ -1532,21 +2235,21 @@ var Specifications = []Specification{
 				},
 			},
 			Behavior{
-				Description: "Does nothing if index is negative",
+				Description: "Does nothing if the index is negative.",
 				Expectation: func(t *testing.T) {
-					aa := []interface{}{1, 2, 3, 4}
+					s := samples(t, 2)
+					aa := []interface{}{s[0], s[1]}
 					generic.RemoveAt(&aa, -1)
-					bb := []interface{}{1, 2, 3, 4}
-					assertSlicesEqual(t, aa, bb)
+					assert.Equal(t, []interface{}{s[0], s[1]}, aa)
 				},
 			},
 			Behavior{
-				Description: "Does nothing if index greater than max",
+				Description: "Does nothing if the index is beyond the end.",
 				Expectation: func(t *testing.T) {
-					aa := []interface{}{1, 2, 3, 4}
+					s := samples(t, 2)
+					aa := []interface{}{s[0], s[1]}
 					generic.RemoveAt(&aa, 10)
-					bb := []interface{}{1, 2, 3, 4}
-					assertSlicesEqual(t, aa, bb)
+					assert.Equal(t, []interface{}{s[0], s[1]}, aa)
 				},
 			},
 		},
@@ -1554,26 +2257,25 @@ var Specifications = []Specification{
 	Specification{
 		FunctionName: "Reverse",
 		StandardPath: Behavior{
-			Description: "Reverses slice",
+			Description: "Reverses the order of the elements.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[1]}
 				generic.Reverse(&aa)
-				bb := []interface{}{4, 3, 2, 1}
-				assertSlicesEqual(t, aa, bb)
+				assert.Equal(t, []interface{}{s[1], s[1], s[0]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Empty slice has no effect",
+			Description: "An empty slice is unchanged.",
 			Expectation: func(t *testing.T) {
 				aa := []interface{}{}
 				generic.Reverse(&aa)
-				bb := []interface{}{}
-				assertSlicesEqual(t, aa, bb)
+				assert.Empty(t, aa)
 			},
 		},
 		EdgeCases: []Behavior{
 			Behavior{
-				Description: "Nil slice has no effect",
+				Description: "A nil slice is left nil.",
 				Expectation: func(t *testing.T) {
 					var aa []interface{}
 					generic.Reverse(&aa)
@@ -1582,38 +2284,57 @@ var Specifications = []Specification{
 			},
 		},
 	},
+	Specification{
+		FunctionName: "Select",
+		StandardPath: Behavior{
+			Description: "Returns the element that would be at aa[n] were aa sorted.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 3)
+				aa := []interface{}{s[2], s[0], s[1]}
+				assert.Equal(t, []interface{}{s[2]}, generic.Select(aa, 2, sampleLess))
+				assert.Equal(t, []interface{}{s[2], s[0], s[1]}, aa)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Returns nothing if n is out of range.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 1)
+				aa := []interface{}{s[0]}
+				assert.Empty(t, generic.Select(aa, 1, sampleLess))
+			},
+		},
+	},
 	Specification{
 		FunctionName: "Skip",
 		StandardPath: Behavior{
-			Description: "Skips the first n elements",
+			Description: "Removes the first n elements.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0], s[1]}
 				generic.Skip(&aa, 2)
-				bb := []interface{}{3, 4}
-				assertSlicesEqual(t, aa, bb)
+				assert.Equal(t, []interface{}{s[1]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Empties the list if n >= len(aa)",
+			Description: "Skipping every element leaves the slice empty.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				generic.Skip(&aa, 4)
-				bb := []interface{}{}
-				assertSlicesEqual(t, aa, bb)
+				s := samples(t, 1)
+				aa := []interface{}{s[0], s[0]}
+				generic.Skip(&aa, 2)
+				assert.Empty(t, aa)
 			},
 		},
 		EdgeCases: []Behavior{
 			Behavior{
-				Description: "Empty list does nothing",
+				Description: "Skipping from an empty slice does nothing.",
 				Expectation: func(t *testing.T) {
 					aa := []interface{}{}
 					generic.Skip(&aa, 4)
-					bb := []interface{}{}
-					assertSlicesEqual(t, aa, bb)
+					assert.Empty(t, aa)
 				},
 			},
 			Behavior{
-				Description: "Nil list does nothing",
+				Description: "Skipping from a nil slice does nothing.",
 				Expectation: func(t *testing.T) {
 					var aa []interface{}
 					generic.Skip(&aa, 4)
@@ -1621,14 +2342,12 @@ var Specifications = []Specification{
 				},
 			},
 			Behavior{
-				Description: "n <= 0 does nothing",
+				Description: "Skipping n <= 0 elements from an empty slice does nothing.",
 				Expectation: func(t *testing.T) {
-					nn := []int64{-1, 0}
-					for _, n := range nn {
+					for _, n := range []int64{-1, 0} {
 						aa := []interface{}{}
 						generic.Skip(&aa, n)
-						bb := []interface{}{}
-						assertSlicesEqual(t, aa, bb)
+						assert.Empty(t, aa)
 					}
 				},
 			},
@@ -1637,145 +2356,154 @@ var Specifications = []Specification{
 	Specification{
 		FunctionName: "SkipWhile",
 		StandardPath: Behavior{
-			Description: "",
+			Description: "Removes elements until one fails the test.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				test := func(a interface{}) bool {
-					return a.(int) < 3
-				}
-				generic.SkipWhile(&aa, test)
-				bb := []interface{}{3, 4}
-				assertSlicesEqual(t, aa, bb)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0], s[1], s[0]}
+				generic.SkipWhile(&aa, equalTo(s[0]))
+				assert.Equal(t, []interface{}{s[1], s[0]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Test never satisfied, does nothing",
+			Description: "The slice is unchanged if the first element fails the test.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				test := func(a interface{}) bool {
-					return a.(int) > 10
-				}
-				generic.SkipWhile(&aa, test)
-				bb := []interface{}{1, 2, 3, 4}
-				assertSlicesEqual(t, aa, bb)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				generic.SkipWhile(&aa, never)
+				assert.Equal(t, []interface{}{s[0], s[1]}, aa)
 			},
 		},
 	},
 	Specification{
 		FunctionName: "Sort",
 		StandardPath: Behavior{
-			Description: "Sorts",
+			Description: "Sorts the elements.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{6, 3, 4, 2, 5}
-				less := func(a, b interface{}) bool {
-					return a.(int) < b.(int)
-				}
-				generic.Sort(&aa, less)
-				bb := []interface{}{2, 3, 4, 5, 6}
-				assertSlicesEqual(t, aa, bb)
+				s := samples(t, 2)
+				aa := []interface{}{s[1], s[0], s[1], s[0]}
+				generic.Sort(&aa, sampleLess)
+				assert.Equal(t, []interface{}{s[0], s[0], s[1], s[1]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "Sorts every sample.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				aa := generic.Clone(primitiveSamples)
+				generic.Reverse(&aa)
+				generic.Sort(&aa, sampleLess)
+				assert.Equal(t, primitiveSamples, aa)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "SortAuto",
+		StandardPath: Behavior{
+			Description: "Sorts the elements in their natural order.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[1], s[0], s[1]}
+				generic.SortAuto(&aa)
+				assert.Equal(t, []interface{}{s[0], s[1], s[1]}, aa)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "A sorted slice is unchanged.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				generic.SortAuto(&aa)
+				assert.Equal(t, []interface{}{s[0], s[1]}, aa)
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "An empty slice is left empty.",
+				Expectation: func(t *testing.T) {
+					aa := []interface{}{}
+					generic.SortAuto(&aa)
+					assert.Empty(t, aa)
+				},
 			},
 		},
 	},
 	Specification{
 		FunctionName: "SplitAfter",
 		StandardPath: Behavior{
-			Description: "The slice is spit as expected",
+			Description: "Splits after the first element passing the test.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{6, 7, 8, 9}
-				test := func(a interface{}) bool {
-					return a.(int) == 7
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[0]}
+				bb := generic.SplitAfter(aa, equalTo(s[1]))
+				cc := generic.SliceType2{
+					[]interface{}{s[0], s[1]},
+					[]interface{}{s[0]},
 				}
-				bb := generic.SplitAfter(aa, test)
-				cc := generic.SliceType{
-					generic.SliceType{6, 7},
-					generic.SliceType{8, 9},
-				}
-				assertSlicesEqual(t, bb, cc)
+				assert.Equal(t, cc, bb)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "No match found, aa will be in SliceType[0]",
+			Description: "Splitting an empty slice returns two empty slices.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{6, 7, 8, 9}
-				test := func(a interface{}) bool {
-					return a.(int) == 10
-				}
-				bb := generic.SplitAfter(aa, test)
-				cc := generic.SliceType{
-					generic.SliceType{6, 7, 8, 9},
-					generic.SliceType{},
-				}
-				assertSlicesEqual(t, bb, cc)
+				bb := generic.SplitAfter([]interface{}{}, always)
+				assert.Len(t, bb, 2)
+				assert.Empty(t, bb[0])
+				assert.Empty(t, bb[1])
 			},
 		},
 	},
 	Specification{
 		FunctionName: "SplitAt",
 		StandardPath: Behavior{
-			Description: "The slice is split as expected",
+			Description: "Splits before the specified index.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{6, 7, 8, 9}
-				bb := generic.SplitAt(aa, 2)
-				cc := generic.SliceType{
-					generic.SliceType{6, 7},
-					generic.SliceType{8, 9},
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[0]}
+				bb := generic.SplitAt(aa, 1)
+				cc := generic.SliceType2{
+					[]interface{}{s[0]},
+					[]interface{}{s[1], s[0]},
 				}
-				assertSlicesEqual(t, bb, cc)
+				assert.Equal(t, cc, bb)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "If the slice is empty, two empty slices are returned",
+			Description: "A negative index splits before the head.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{}
-				bb := generic.SplitAt(aa, 2)
-				cc := generic.SliceType{
-					generic.SliceType{},
-					generic.SliceType{},
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				bb := generic.SplitAt(aa, -1)
+				cc := generic.SliceType2{
+					[]interface{}{},
+					[]interface{}{s[0], s[1]},
 				}
-				assertSlicesEqual(t, bb, cc)
+				assert.Equal(t, cc, bb)
 			},
 		},
 		EdgeCases: []Behavior{
 			Behavior{
-				Description: "If the slice is nil, two empty slices are returned",
+				Description: "A nil slice is split into two empty slices.",
 				Expectation: func(t *testing.T) {
-					var aa generic.SliceType
+					var aa []interface{}
 					bb := generic.SplitAt(aa, 2)
-					cc := generic.SliceType{
-						generic.SliceType{},
-						generic.SliceType{},
-					}
-					assertSlicesEqual(t, bb, cc)
+					assert.Equal(t, generic.SliceType2{[]interface{}{}, []interface{}{}}, bb)
 				},
 			},
 			Behavior{
-				Description: "If i < 0, the full slice will be placed in SliceType[1]",
+				Description: "A negative index places every element in the second slice.",
 				Expectation: func(t *testing.T) {
-					aa := []interface{}{6, 7, 8, 9}
-					bb := generic.SplitAt(aa, -1)
-					cc := generic.SliceType{
-						generic.SliceType{},
-						generic.SliceType{6, 7, 8, 9},
-					}
-					assertSlicesEqual(t, bb, cc)
+					s := samples(t, 2)
+					aa := []interface{}{s[0], s[1]}
+					bb := generic.SplitAt(aa, -5)
+					assert.Equal(t, generic.SliceType2{[]interface{}{}, []interface{}{s[0], s[1]}}, bb)
 				},
 			},
 			Behavior{
-				Description: "If i >= len(aa), the full slice will be placed in SliceType[0]",
+				Description: "An index beyond the end places every element in the first slice.",
 				Expectation: func(t *testing.T) {
-					aa := []interface{}{6, 7, 8, 9}
-					bb := generic.SplitAt(aa, 4)
-					cc := generic.SliceType{
-						generic.SliceType{6, 7, 8, 9},
-						generic.SliceType{},
-					}
-					assertSlicesEqual(t, bb, cc)
+					s := samples(t, 2)
+					aa := []interface{}{s[0], s[1]}
+					bb := generic.SplitAt(aa, 2)
+					assert.Equal(t, generic.SliceType2{[]interface{}{s[0], s[1]}, []interface{}{}}, bb)
 				},
 			},
 		},
@@ -1783,33 +2511,25 @@ var Specifications = []Specification{
 	Specification{
 		FunctionName: "SplitBefore",
 		StandardPath: Behavior{
-			Description: "The slice is spit as expected",
+			Description: "Splits before the first element passing the test.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{6, 7, 8, 9}
-				test := func(a interface{}) bool {
-					return a.(int) == 8
-				}
-				bb := generic.SplitBefore(aa, test)
-				cc := generic.SliceType{
-					generic.SliceType{6, 7},
-					generic.SliceType{8, 9},
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[0]}
+				bb := generic.SplitBefore(aa, equalTo(s[1]))
+				cc := generic.SliceType2{
+					[]interface{}{s[0]},
+					[]interface{}{s[1], s[0]},
 				}
-				assertSlicesEqual(t, bb, cc)
+				assert.Equal(t, cc, bb)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "No match found, aa will be in SliceType[0]",
+			Description: "Splitting an empty slice returns two empty slices.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{6, 7, 8, 9}
-				test := func(a interface{}) bool {
-					return a.(int) == 10
-				}
-				bb := generic.SplitBefore(aa, test)
-				cc := generic.SliceType{
-					generic.SliceType{6, 7, 8, 9},
-					generic.SliceType{},
-				}
-				assertSlicesEqual(t, bb, cc)
+				bb := generic.SplitBefore([]interface{}{}, always)
+				assert.Len(t, bb, 2)
+				assert.Empty(t, bb[0])
+				assert.Empty(t, bb[1])
 			},
 		},
 	},
@@ -1818,92 +2538,104 @@ var Specifications = []Specification{
 		StandardPath: Behavior{
 			Description: "Returns string representation of slice.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3}
-				s := generic.String(aa)
-				assert.Equal(t, "[1,2,3]", s)
+				s := samples(t, 2)
+				a := strings.TrimSuffix(strings.TrimPrefix(generic.String([]interface{}{s[0]}), "["), "]")
+				b := strings.TrimSuffix(strings.TrimPrefix(generic.String([]interface{}{s[1]}), "["), "]")
+				assert.NotEqual(t, a, b)
+				assert.Equal(t, "["+a+","+b+"]", generic.String([]interface{}{s[0], s[1]}))
+				assert.Equal(t, "[]", generic.String([]interface{}{}))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "Elements that cannot be marshaled as JSON are formatted as fmt.Sprint formats them.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				for _, a := range primitiveSamples {
+					representation, err := json.Marshal(a)
+					if err != nil {
+						representation = []byte(fmt.Sprint(a))
+					}
+					assert.Equal(t, "["+string(representation)+"]", generic.String([]interface{}{a}))
+				}
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "Bytes are represented as numbers, rather than as base64.",
+				Expectation: func(t *testing.T) {
+					s := samples(t, 2)
+					if reflect.ValueOf(s[0]).Kind() != reflect.Uint8 {
+						t.Skip("The samples are not bytes.")
+					}
+					assert.Equal(t, fmt.Sprintf("[%v,%v]", s[0], s[1]), generic.String([]interface{}{s[0], s[1]}))
+				},
 			},
 		},
 	},
 	Specification{
 		FunctionName: "SwapIndex",
 		StandardPath: Behavior{
-			Description: "Swaps the specified indices.",
+			Description: "Swaps the elements at the specified indices.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4, 5}
-				generic.SwapIndex(aa, 2, 4)
-				bb := []interface{}{1, 2, 5, 4, 3}
-				assertSlicesEqual(t, aa, bb)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0], s[1]}
+				generic.SwapIndex(aa, 0, 2)
+				assert.Equal(t, []interface{}{s[1], s[0], s[0]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "If either index is out of range, swap does nothing.",
+			Description: "The slice is unchanged if either index is out of range.",
 			Expectation: func(t *testing.T) {
-				indices := [][]int64{
-					{-10, -9},
-					{-10, 3},
-					{-10, 10},
-					{3, -10},
-					{3, 10},
-					{10, -10},
-					{10, 3},
-					{10, 9},
-				}
-				for _, ii := range indices {
-					aa := []interface{}{1, 2, 3, 4, 5}
-					generic.SwapIndex(aa, ii[0], ii[1])
-					bb := []interface{}{1, 2, 3, 4, 5}
-					assertSlicesEqual(t, aa, bb)
-				}
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				generic.SwapIndex(aa, 0, 2)
+				generic.SwapIndex(aa, -1, 1)
+				assert.Equal(t, []interface{}{s[0], s[1]}, aa)
 			},
 		},
 	},
 	Specification{
 		FunctionName: "Tail",
 		StandardPath: Behavior{
-			Description: "Removes the head from the slice.",
+			Description: "Removes the head of the slice.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3}
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[1]}
 				generic.Tail(&aa)
-				bb := []interface{}{2, 3}
-				assertSlicesEqual(t, aa, bb)
+				assert.Equal(t, []interface{}{s[1], s[1]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "An empty slice is unchanged.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				aa := []interface{}{}
+				generic.Tail(&aa)
+				assert.Empty(t, aa)
 			},
 		},
 	},
 	Specification{
 		FunctionName: "Take",
 		StandardPath: Behavior{
-			Description: "Normally retains first n elements",
+			Description: "Keeps the first n elements.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[1]}
 				generic.Take(&aa, 2)
-				bb := []interface{}{1, 2}
-				assertSlicesEqual(t, aa, bb)
+				assert.Equal(t, []interface{}{s[0], s[1]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "If slice is empty, Take does nothing",
+			Description: "The slice is unchanged if n is beyond the end.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{}
-				generic.Take(&aa, 2)
-				bb := []interface{}{}
-				assertSlicesEqual(t, aa, bb)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				generic.Take(&aa, 10)
+				assert.Equal(t, []interface{}{s[0], s[1]}, aa)
 			},
 		},
 		EdgeCases: []Behavior{
 			Behavior{
-				Description: "If slice is nil, Take does nothing",
+				Description: "Taking from a nil slice does nothing.",
 				Expectation: func(t *testing.T) {
 					var aa []interface{}
 					generic.Take(&aa, 2)
@@ -1915,114 +2647,188 @@ var Specifications = []Specification{
 	Specification{
 		FunctionName: "TakeWhile",
 		StandardPath: Behavior{
-			Description: "Takes while the test is true.",
+			Description: "Keeps elements until one fails the test.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4}
-				test := func(a interface{}) bool {
-					return a.(int) < 3
-				}
-				generic.TakeWhile(&aa, test)
-				bb := []interface{}{1, 2}
-				assertSlicesEqual(t, aa, bb)
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0], s[1], s[0]}
+				generic.TakeWhile(&aa, equalTo(s[0]))
+				assert.Equal(t, []interface{}{s[0], s[0]}, aa)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "The slice is unchanged if every element passes the test.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				generic.TakeWhile(&aa, always)
+				assert.Equal(t, []interface{}{s[0], s[1]}, aa)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "TopK",
+		StandardPath: Behavior{
+			Description: "Returns the k elements that sort first, in order.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 3)
+				aa := []interface{}{s[2], s[1], s[0], s[1]}
+				assert.Equal(t, []interface{}{s[0], s[1], s[1]}, generic.TopK(aa, 3, sampleLess))
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "Returns nothing if k is not positive.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				s := samples(t, 1)
+				aa := []interface{}{s[0]}
+				assert.Empty(t, generic.TopK(aa, 0, sampleLess))
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "Elements that sort alike are returned in their original order.",
+				Expectation: func(t *testing.T) {
+					s := samples(t, 3)
+					aa := []interface{}{s[1], s[0], s[2]}
+					alike := func(a, b interface{}) bool { return false }
+					assert.Equal(t, []interface{}{s[1], s[0]}, generic.TopK(aa, 2, alike))
+				},
+			},
+			Behavior{
+				Description: "The result matches the start of a sorted copy.",
+				Expectation: func(t *testing.T) {
+					r := rand.New(rand.NewSource(1))
+					aa := []interface{}{}
+					for i := 0; i < 1000; i++ {
+						aa = append(aa, primitiveSamples[r.Intn(len(primitiveSamples))])
+					}
+					sorted := append([]interface{}{}, aa...)
+					generic.Sort(&sorted, sampleLess)
+					for _, k := range []int64{1, 10, 50, 999, 1000} {
+						assert.Equal(t, sorted[:k], generic.TopK(aa, k, sampleLess))
+					}
+				},
 			},
 		},
 	},
 	Specification{
 		FunctionName: "Union",
 		StandardPath: Behavior{
-			Description: "Union appends bb to aa",
+			Description: "Appends the elements of bb to aa.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3}
-				bb := []interface{}{4, 5, 6}
-				generic.Union(&aa, bb)
-				cc := []interface{}{1, 2, 3, 4, 5, 6}
-				assertSlicesEqual(t, aa, cc)
-
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1]}
+				generic.Union(&aa, []interface{}{s[1], s[0]})
+				assert.Equal(t, []interface{}{s[0], s[1], s[1], s[0]}, aa)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "The union with an empty slice is unchanged.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 1)
+				aa := []interface{}{s[0]}
+				generic.Union(&aa, []interface{}{})
+				assert.Equal(t, []interface{}{s[0]}, aa)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "UnionDistinct",
+		StandardPath: Behavior{
+			Description: "Appends the elements of bb to aa, then removes duplicates.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 3)
+				aa := []interface{}{s[0], s[1], s[0]}
+				generic.UnionDistinct(&aa, []interface{}{s[2], s[1]}, sampleEqual)
+				assert.Equal(t, []interface{}{s[0], s[1], s[2]}, aa)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "The union of equal slices is their distinct elements.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				aa := []interface{}{s[1], s[0]}
+				generic.UnionDistinct(&aa, []interface{}{s[0], s[1]}, sampleEqual)
+				assert.Equal(t, []interface{}{s[1], s[0]}, aa)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "UnionDistinctH",
+		StandardPath: Behavior{
+			Description: "Appends the elements of bb to aa, then removes duplicates.",
+			Expectation: func(t *testing.T) {
+				s := samples(t, 3)
+				aa := []interface{}{s[0], s[1], s[0]}
+				generic.UnionDistinctH(&aa, []interface{}{s[2], s[1]}, sampleHash, sampleEqual)
+				assert.Equal(t, []interface{}{s[0], s[1], s[2]}, aa)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "Elements whose hashes collide are compared for equality.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				s := samples(t, 3)
+				aa := []interface{}{s[2], s[0]}
+				generic.UnionDistinctH(&aa, []interface{}{s[0], s[1]}, collide, sampleEqual)
+				assert.Equal(t, []interface{}{s[2], s[0], s[1]}, aa)
 			},
 		},
 	},
 	Specification{
 		FunctionName: "Unzip",
 		StandardPath: Behavior{
-			Description: "Normally unzips.",
+			Description: "Separates the elements at odd and even indices.",
 			Expectation: func(t *testing.T) {
-				aa := []interface{}{1, 2, 3, 4, 5}
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[1], s[0], s[1], s[0]}
 				bb := generic.Unzip(aa)
-				cc := generic.SliceType{
-					generic.SliceType{1, 3, 5},
-					generic.SliceType{2, 4},
+				cc := generic.SliceType2{
+					[]interface{}{s[1], s[1]},
+					[]interface{}{s[0], s[0], s[0]},
 				}
-				assertSlicesEqual(t, bb, cc)
+				assert.Equal(t, cc, bb)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "Unzipping an empty slice returns two empty slices.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				bb := generic.Unzip([]interface{}{})
+				assert.Len(t, bb, 2)
+				assert.Empty(t, bb[0])
+				assert.Empty(t, bb[1])
 			},
 		},
 	},
 	Specification{
 		FunctionName: "WindowCentered",
 		StandardPath: Behavior{
-			Description: "Basic windowing works.",
+			Description: "Applies the function to a window centered on each element.",
 			Expectation: func(t *testing.T) {
-				windowFn := func(aa []interface{}) interface{} {
-					sum := 0.0
-					for _, a := range aa {
-						sum += float64(a.(float64))
-					}
-					return sum / float64(len(aa))
+				s := samples(t, 3)
+				aa := []interface{}{s[0], s[2], s[1], s[0], s[0]}
+				windowFn := func(window []interface{}) interface{} {
+					return generic.Reduce(window, sampleMax)[0]
 				}
-				aa := []interface{}{1.0, 2.0, 3.0, 4.0, 5.0}
-				bb := generic.WindowCentered(aa, 4, windowFn)
-				cc := []interface{}{1.5, 2.0, 2.5, 3.5, 4.0}
-				assertSlicesEqual(t, bb, cc)
+				bb := generic.WindowCentered(aa, 3, windowFn)
+				assert.Equal(t, []interface{}{s[2], s[2], s[2], s[1], s[0]}, bb)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Slice order is correct for odd window size",
+			Description: "Returns nothing for an empty slice.",
 			Expectation: func(t *testing.T) {
-				windowFn := func(aa []interface{}) interface{} {
-					result := ""
-					for _, a := range aa {
-						result = result + a.(string)
-					}
-					return result
-				}
-				aa := []interface{}{"1", "2", "3"}
-				bb := generic.WindowCentered(aa, 3, windowFn)
-				cc := []interface{}{"12", "123", "23"}
-				assertSlicesEqual(t, bb, cc)
+				windowFn := func(window []interface{}) interface{} { return window[0] }
+				assert.Empty(t, generic.WindowCentered([]interface{}{}, 3, windowFn))
 			},
 		},
 		EdgeCases: []Behavior{
 			Behavior{
-				Description: "Slice order is correct for even window size.",
+				Description: "An even window extends further before each element than after it.",
 				Expectation: func(t *testing.T) {
-					windowFn := func(aa []interface{}) interface{} {
-						result := ""
-						for _, a := range aa {
-							result = result + a.(string)
-						}
-						return result
-					}
-					aa := []interface{}{"1", "2", "3", "4", "5"}
-					bb := generic.WindowCentered(aa, 4, windowFn)
-					cc := []interface{}{"12", "123", "1234", "2345", "345"}
-					assertSlicesEqual(t, bb, cc)
+					s := samples(t, 5)
+					aa := []interface{}{s[0], s[1], s[2], s[3], s[4]}
+					first := func(window []interface{}) interface{} { return window[0] }
+					last := func(window []interface{}) interface{} { return window[len(window)-1] }
+					assert.Equal(t, []interface{}{s[0], s[0], s[0], s[1], s[2]}, generic.WindowCentered(aa, 4, first))
+					assert.Equal(t, []interface{}{s[1], s[2], s[3], s[4], s[4]}, generic.WindowCentered(aa, 4, last))
 				},
 			},
 		},
@@ -2030,109 +2836,86 @@ var Specifications = []Specification{
 	Specification{
 		FunctionName: "WindowLeft",
 		StandardPath: Behavior{
-			Description: "Basic windowing works.",
+			Description: "Applies the function to a window starting at each element.",
 			Expectation: func(t *testing.T) {
-				windowFn := func(aa []interface{}) interface{} {
-					sum := 0.0
-					for _, a := range aa {
-						sum += float64(a.(float64))
-					}
-					return sum / float64(len(aa))
+				s := samples(t, 3)
+				aa := []interface{}{s[0], s[2], s[1], s[0]}
+				windowFn := func(window []interface{}) interface{} {
+					return generic.Reduce(window, sampleMax)[0]
 				}
-				aa := []interface{}{1.0, 2.0, 3.0, 4.0, 5.0}
-				bb := generic.WindowLeft(aa, 4, windowFn)
-				cc := []interface{}{2.5, 3.5, 4.0, 4.5, 5.0}
-				assertSlicesEqual(t, bb, cc)
+				bb := generic.WindowLeft(aa, 2, windowFn)
+				assert.Equal(t, []interface{}{s[2], s[2], s[1], s[0]}, bb)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Slice order is is respected",
+			Description: "Returns nothing for an empty slice.",
 			Expectation: func(t *testing.T) {
-				windowFn := func(aa []interface{}) interface{} {
-					result := ""
-					for _, a := range aa {
-						result = result + a.(string)
-					}
-					return result
-				}
-				aa := []interface{}{"1", "2", "3"}
-				bb := generic.WindowLeft(aa, 2, windowFn)
-				cc := []interface{}{"12", "23", "3"}
-				assertSlicesEqual(t, bb, cc)
+				windowFn := func(window []interface{}) interface{} { return window[0] }
+				assert.Empty(t, generic.WindowLeft([]interface{}{}, 2, windowFn))
 			},
 		},
 	},
 	Specification{
 		FunctionName: "WindowRight",
 		StandardPath: Behavior{
-			Description: "Basic windowing works.",
+			Description: "Applies the function to a window ending at each element.",
 			Expectation: func(t *testing.T) {
-				windowFn := func(aa []interface{}) interface{} {
-					sum := 0.0
-					for _, a := range aa {
-						sum += float64(a.(float64))
-					}
-					return sum / float64(len(aa))
+				s := samples(t, 3)
+				aa := []interface{}{s[0], s[2], s[1], s[0]}
+				windowFn := func(window []interface{}) interface{} {
+					return generic.Reduce(window, sampleMax)[0]
 				}
-				aa := []interface{}{1.0, 2.0, 3.0, 4.0, 5.0}
-				bb := generic.WindowRight(aa, 4, windowFn)
-				cc := []interface{}{1.0, 1.5, 2.0, 2.5, 3.5}
-				assertSlicesEqual(t, bb, cc)
+				bb := generic.WindowRight(aa, 2, windowFn)
+				assert.Equal(t, []interface{}{s[0], s[2], s[2], s[1]}, bb)
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "Slice order is is respected",
+			Description: "Returns nothing for an empty slice.",
 			Expectation: func(t *testing.T) {
-				windowFn := func(aa []interface{}) interface{} {
-					result := ""
-					for _, a := range aa {
-						result = result + a.(string)
-					}
-					return result
-				}
-				aa := []interface{}{"1", "2", "3"}
-				bb := generic.WindowRight(aa, 2, windowFn)
-				cc := []interface{}{"1", "12", "23"}
-				assertSlicesEqual(t, bb, cc)
+				windowFn := func(window []interface{}) interface{} { return window[0] }
+				assert.Empty(t, generic.WindowRight([]interface{}{}, 2, windowFn))
 			},
 		},
 	},
 	Specification{
 		FunctionName: "Zip",
 		StandardPath: Behavior{
-			Description: "Interleaves aa and bb",
+			Description: "Interleaves aa and bb, beginning with aa[0].",
 			Expectation: func(t *testing.T) {
+				s := samples(t, 2)
+				a, b := s[0], s[1]
+
 				type testCase struct {
-					aa generic.SliceType
-					bb generic.SliceType
-					dd generic.SliceType
+					aa []interface{}
+					bb []interface{}
+					dd []interface{}
 				}
 
 				testCases := []testCase{
 					testCase{
-						aa: []interface{}{1, 2, 3},
-						bb: []interface{}{7, 8, 9},
-						dd: []interface{}{1, 7, 2, 8, 3, 9},
+						aa: []interface{}{a, a, a},
+						bb: []interface{}{b, b, b},
+						dd: []interface{}{a, b, a, b, a, b},
 					},
 					testCase{
-						aa: []interface{}{1, 2},
-						bb: []interface{}{7, 8, 9},
-						dd: []interface{}{1, 7, 2, 8, 9},
+						aa: []interface{}{a, a},
+						bb: []interface{}{b, b, b},
+						dd: []interface{}{a, b, a, b, b},
 					},
 					testCase{
-						aa: []interface{}{1, 2, 3},
-						bb: []interface{}{7, 8},
-						dd: []interface{}{1, 7, 2, 8, 3},
+						aa: []interface{}{a, a, a},
+						bb: []interface{}{b, b},
+						dd: []interface{}{a, b, a, b, a},
 					},
 					testCase{
 						aa: []interface{}{},
-						bb: []interface{}{7, 8, 9},
-						dd: []interface{}{7, 8, 9},
+						bb: []interface{}{b, b, b},
+						dd: []interface{}{b, b, b},
 					},
 					testCase{
-						aa: []interface{}{1, 2, 3},
+						aa: []interface{}{a, a, a},
 						bb: []interface{}{},
-						dd: []interface{}{1, 2, 3},
+						dd: []interface{}{a, a, a},
 					},
 					testCase{
 						aa: []interface{}{},
@@ -2142,61 +2925,25 @@ var Specifications = []Specification{
 				}
 
 				for _, tc := range testCases {
-					cc := generic.Zip(tc.aa, tc.bb)
-					assertSlicesEqual(t, cc, tc.dd)
+					assert.Equal(t, tc.dd, generic.Zip(tc.aa, tc.bb))
 				}
 			},
 		},
 		AlternativePath: Behavior{
-			Description: "",
+			Description: "The result is a new slice, even if aa or bb is empty.",
 			Expectation: func(t *testing.T) {
-				t.Skip()
+				s := samples(t, 2)
+				aa := []interface{}{s[0], s[0]}
+				cc := generic.Zip(aa, []interface{}{})
+				cc[0] = s[1]
+				dd := generic.Zip([]interface{}{}, aa)
+				dd[0] = s[1]
+				assert.Equal(t, []interface{}{s[0], s[0]}, aa)
 			},
 		},
 	},
 }
 
 func TestTransforms(t *testing.T) {
-	for _, specification := range Specifications {
-		t.Run(specification.FunctionName+"StandardPath", specification.StandardPath.Expectation)
-		t.Run(specification.FunctionName+"AlternativePath", specification.AlternativePath.Expectation)
-		for i, edgeCase := range specification.EdgeCases {
-			t.Run(fmt.Sprintf("%vEdgeCase%v", specification.FunctionName, i+1), edgeCase.Expectation)
-		}
-	}
-}
-
-func assertSlicesEqual(t *testing.T, xx, yy []interface{}) bool {
-	// often dealing with using []interface{} as the key (hash) value in a map
-	// which go doesn't like because []interface{} types are unhashable.
-	// We convert the values to a string to get around this limitation.
-	hash := func(z interface{}) string {
-		return fmt.Sprintf("%v", z)
-	}
-
-	if len(xx) != len(yy) {
-		t.Errorf("Expected lengths to match. Wanted %v, got %v", xx, yy)
-		return false
-	}
-	diff := make(map[interface{}]int, len(xx))
-	for _, x := range xx {
-		diff[hash(x)]++
-	}
-	for _, y := range yy {
-		hashy := hash(y)
-		if _, ok := diff[hashy]; !ok {
-			t.Errorf("Expected %v, but got %v", xx, yy)
-			return false
-		}
-		diff[hashy] -= 1
-		if diff[hashy] == 0 {
-			delete(diff, hashy)
-		}
-	}
-	if len(diff) == 0 {
-		return true
-	}
-
-	t.Errorf("Expected %v, but got %v", xx, yy)
-	return false
+	runSpecifications(t, Specifications)
 }
@@ -0,0 +1,22 @@
+package less_test
+
+import (
+	"fmt"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic"
+	"github.com/ideoterra/transforms/pkg/slices/generic/less"
+)
+
+func ExampleNatural() {
+	files := []interface{}{"file10.txt", "file9.txt", "file1.txt"}
+	generic.Sort(&files, less.Natural())
+	fmt.Println(files)
+	// Output: [file1.txt file9.txt file10.txt]
+}
+
+func ExampleMixed() {
+	values := []interface{}{"b", 2.5, nil, "a", 1, true}
+	generic.Sort(&values, less.Mixed())
+	fmt.Println(values)
+	// Output: [<nil> true 1 2.5 a b]
+}
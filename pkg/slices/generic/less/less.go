@@ -0,0 +1,54 @@
+// Package less provides LessFns for use with transforms such as Sort.
+//
+// Each function returns a new LessFn, which orders values ascending unless its
+// name ends in Desc. The values compared are asserted to be of the expected
+// type, and a LessFn panics, as the assertion does, if either is not. Slices
+// holding values of more than one type may be ordered with Mixed.
+//
+// Complex numbers have no natural order, so no LessFn is provided for them,
+// other than by Mixed.
+package less
+
+import (
+	"time"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic/closures"
+)
+
+// Reverse returns a LessFn that orders values in the reverse of the order
+// given by less.
+func Reverse(less closures.LessFn) closures.LessFn {
+	return func(a, b interface{}) bool {
+		return less(b, a)
+	}
+}
+
+// Time returns a LessFn that orders time.Time values chronologically, using
+// Time.Before, so the same instant in different locations is ordered as
+// neither before nor after itself. It panics if either value is not a
+// time.Time.
+func Time() closures.LessFn {
+	return func(a, b interface{}) bool {
+		return a.(time.Time).Before(b.(time.Time))
+	}
+}
+
+// TimeDesc returns a LessFn that orders time.Time values from the latest to the
+// earliest. It panics if either value is not a time.Time.
+func TimeDesc() closures.LessFn {
+	return Reverse(Time())
+}
+
+// Duration returns a LessFn that orders time.Duration values from the shortest
+// to the longest. It panics if either value is not a time.Duration.
+func Duration() closures.LessFn {
+	return func(a, b interface{}) bool {
+		return a.(time.Duration) < b.(time.Duration)
+	}
+}
+
+// DurationDesc returns a LessFn that orders time.Duration values from the
+// longest to the shortest. It panics if either value is not a time.Duration.
+func DurationDesc() closures.LessFn {
+	return Reverse(Duration())
+}
@@ -0,0 +1,149 @@
+package less_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic"
+	"github.com/ideoterra/transforms/pkg/slices/generic/closures"
+	"github.com/ideoterra/transforms/pkg/slices/generic/less"
+	"github.com/stretchr/testify/assert"
+)
+
+// ordering is a LessFn, and values that it must sort into the order given.
+type ordering struct {
+	name   string
+	less   closures.LessFn
+	sorted []interface{}
+}
+
+func TestLessFns(t *testing.T) {
+	nan := math.NaN()
+	epoch := time.Unix(0, 0)
+
+	orderings := []ordering{
+		{"Bool", less.Bool(), []interface{}{false, true}},
+		{"BoolDesc", less.BoolDesc(), []interface{}{true, false}},
+		{"Byte", less.Byte(), []interface{}{byte(0), byte(1), byte(255)}},
+		{"Float32", less.Float32(), []interface{}{float32(nan), float32(-1), float32(0.5)}},
+		{"Float64", less.Float64(), []interface{}{nan, math.Inf(-1), -1.5, 0.0, 2.5, math.Inf(1)}},
+		{"Float64Desc", less.Float64Desc(), []interface{}{math.Inf(1), 2.5, 0.0, -1.5, nan}},
+		{"Int", less.Int(), []interface{}{-2, 0, 3}},
+		{"IntDesc", less.IntDesc(), []interface{}{3, 0, -2}},
+		{"Int8", less.Int8(), []interface{}{int8(-128), int8(127)}},
+		{"Int16", less.Int16(), []interface{}{int16(-1), int16(1)}},
+		{"Int32", less.Int32(), []interface{}{int32(-1), int32(1)}},
+		{"Int64", less.Int64(), []interface{}{int64(math.MinInt64), int64(math.MaxInt64)}},
+		{"Rune", less.Rune(), []interface{}{'A', 'a', 'é'}},
+		{"String", less.String(), []interface{}{"", "B", "a", "ab"}},
+		{"StringDesc", less.StringDesc(), []interface{}{"ab", "a", "B", ""}},
+		{"Uint", less.Uint(), []interface{}{uint(0), uint(1)}},
+		{"Uint8", less.Uint8(), []interface{}{uint8(0), uint8(1)}},
+		{"Uint16", less.Uint16(), []interface{}{uint16(0), uint16(1)}},
+		{"Uint32", less.Uint32(), []interface{}{uint32(0), uint32(1)}},
+		{"Uint64", less.Uint64(), []interface{}{uint64(0), uint64(math.MaxUint64)}},
+		{"Uintptr", less.Uintptr(), []interface{}{uintptr(0), uintptr(1)}},
+		{"Time", less.Time(), []interface{}{epoch.Add(-time.Hour), epoch, epoch.Add(time.Nanosecond)}},
+		{"TimeDesc", less.TimeDesc(), []interface{}{epoch.Add(time.Nanosecond), epoch, epoch.Add(-time.Hour)}},
+		{"Duration", less.Duration(), []interface{}{-time.Second, time.Duration(0), time.Millisecond, time.Hour}},
+		{"DurationDesc", less.DurationDesc(), []interface{}{time.Hour, time.Millisecond, time.Duration(0), -time.Second}},
+		{"Natural", less.Natural(), []interface{}{
+			"", "0", "00", "1", "01", "001", "2", "9", "10", "99", "100",
+			"99999999999999999999999", "999999999999999999999999",
+			"file", "file1", "file01", "file1.txt", "file2", "file9", "file10", "file10a", "file10b", "file11",
+			"x2-g8", "x2-y7", "x2-y08", "x8-y8", "z",
+		}},
+		{"NaturalDesc", less.NaturalDesc(), []interface{}{"file10", "file9", "file1"}},
+	}
+
+	for _, o := range orderings {
+		assertOrdered(t, o)
+	}
+}
+
+func TestLessFnsPanicOnUnexpectedTypes(t *testing.T) {
+	for name, fn := range map[string]closures.LessFn{
+		"Int":      less.Int(),
+		"String":   less.String(),
+		"Natural":  less.Natural(),
+		"Time":     less.Time(),
+		"Duration": less.Duration(),
+	} {
+		assert.Panics(t, func() { fn(int64(1), int64(2)) }, name)
+	}
+}
+
+func TestMixed(t *testing.T) {
+	type named string
+	var nilPointer *int
+	one := 1
+	epoch := time.Unix(0, 0)
+
+	sorted := []interface{}{
+		nil,
+		nilPointer,
+		[]int(nil),
+		false,
+		true,
+		math.NaN(),
+		math.Inf(-1),
+		int64(math.MinInt64),
+		-1.5,
+		-1,
+		0,
+		uint8(0),
+		float64(1) / 3,
+		1.0,
+		1,
+		time.Duration(1),
+		uint(1),
+		math.Nextafter(1, 2),
+		int64(1 << 53),
+		int64(1<<53 + 1),
+		uint64(math.MaxUint64),
+		math.Inf(1),
+		complex(-1, 5),
+		complex(1, 0),
+		complex(1, 2),
+		"",
+		"A",
+		named("a"),
+		"a",
+		"b",
+		epoch,
+		epoch.Add(time.Second),
+		&one,
+		[]int{1},
+		[]int{2},
+		struct{}{},
+	}
+	assertOrdered(t, ordering{"Mixed", less.Mixed(), sorted})
+
+	reversed := append([]interface{}{}, sorted...)
+	generic.Reverse(&reversed)
+	assertOrdered(t, ordering{"MixedDesc", less.MixedDesc(), reversed})
+}
+
+// assertOrdered verifies that o.less orders each pair of o.sorted as it appears
+// in o.sorted, and that generic.Sort restores o.sorted from its reverse.
+func assertOrdered(t *testing.T, o ordering) {
+	t.Helper()
+	for i, a := range o.sorted {
+		for j, b := range o.sorted {
+			if i < j {
+				assert.True(t, o.less(a, b), "%v: %#v should sort before %#v", o.name, a, b)
+			} else {
+				assert.False(t, o.less(a, b), "%v: %#v should not sort before %#v", o.name, a, b)
+			}
+		}
+	}
+
+	aa := append([]interface{}{}, o.sorted...)
+	generic.Reverse(&aa)
+	generic.Sort(&aa, o.less)
+	assert.Equal(t, len(o.sorted), len(aa))
+	for i := range aa {
+		assert.True(t, !o.less(aa[i], o.sorted[i]) && !o.less(o.sorted[i], aa[i]), "%v: element %v is %#v, not %#v", o.name, i, aa[i], o.sorted[i])
+	}
+}
@@ -0,0 +1,195 @@
+package less
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"time"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic/closures"
+)
+
+// The classes of value ordered by Mixed, in order.
+const (
+	nilClass = iota
+	boolClass
+	numberClass
+	complexClass
+	stringClass
+	timeClass
+	otherClass
+)
+
+// Mixed returns a LessFn that imposes a total order upon values of any type,
+// for sorting slices whose elements are not all of the same type. Values are
+// ordered first by their class:
+//
+//  1. nil, including nil pointers, maps, slices, channels and functions,
+//  2. booleans, false before true,
+//  3. integers and floating point numbers, by numeric value, with NaN first,
+//  4. complex numbers, by their real parts, then their imaginary parts,
+//  5. strings, byte by byte,
+//  6. time.Time values, chronologically,
+//  7. any other value, by the name of its type, then by its fmt.Sprint
+//     representation.
+//
+// Types are classified by their kind, so a named type such as time.Duration is
+// ordered among the numbers. Values of different types that are otherwise
+// equal, such as int(1) and float64(1), are ordered by the names of their
+// types. Numbers of different types are compared exactly, without conversion
+// to a common type. Mixed never panics.
+func Mixed() closures.LessFn {
+	return func(a, b interface{}) bool {
+		return mixedCompare(a, b) < 0
+	}
+}
+
+// MixedDesc returns a LessFn that orders values of any type in the reverse of
+// the order given by Mixed.
+func MixedDesc() closures.LessFn {
+	return Reverse(Mixed())
+}
+
+// mixedCompare returns -1, 0 or 1 as a sorts before, with, or after b.
+func mixedCompare(a, b interface{}) int {
+	x, y := reflect.ValueOf(a), reflect.ValueOf(b)
+	cx, cy := classOf(x), classOf(y)
+	if cx != cy {
+		return compareInts(cx, cy)
+	}
+
+	c := 0
+	switch cx {
+	case boolClass:
+		c = compareBools(x.Bool(), y.Bool())
+	case numberClass:
+		c = compareNumbers(x, y)
+	case complexClass:
+		xc, yc := x.Complex(), y.Complex()
+		c = compareFloats(real(xc), real(yc))
+		if c == 0 {
+			c = compareFloats(imag(xc), imag(yc))
+		}
+	case stringClass:
+		c = compareStrings(x.String(), y.String())
+	case timeClass:
+		xt, yt := x.Interface().(time.Time), y.Interface().(time.Time)
+		if xt.Before(yt) {
+			c = -1
+		} else if yt.Before(xt) {
+			c = 1
+		}
+	}
+	if c != 0 {
+		return c
+	}
+
+	c = compareStrings(typeName(x), typeName(y))
+	if c == 0 && cx == otherClass {
+		c = compareStrings(fmt.Sprint(a), fmt.Sprint(b))
+	}
+	return c
+}
+
+// classOf returns the class into which Mixed places v.
+func classOf(v reflect.Value) int {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nilClass
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func, reflect.Interface:
+		if v.IsNil() {
+			return nilClass
+		}
+	case reflect.Bool:
+		return boolClass
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return numberClass
+	case reflect.Complex64, reflect.Complex128:
+		return complexClass
+	case reflect.String:
+		return stringClass
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(time.Time{}) {
+			return timeClass
+		}
+	}
+	return otherClass
+}
+
+// compareNumbers compares the integers or floating point numbers x and y
+// exactly.
+func compareNumbers(x, y reflect.Value) int {
+	xf, yf := bigFloat(x), bigFloat(y)
+	if xf == nil || yf == nil {
+		// At least one is NaN, which sorts first.
+		return compareBools(yf == nil, xf == nil)
+	}
+	return xf.Cmp(yf)
+}
+
+// bigFloat returns v, which holds an integer or floating point number, as a
+// big.Float, or nil if v is NaN.
+func bigFloat(v reflect.Value) *big.Float {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Float).SetInt64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Float).SetUint64(v.Uint())
+	default:
+		f := v.Float()
+		if math.IsNaN(f) {
+			return nil
+		}
+		return new(big.Float).SetFloat64(f)
+	}
+}
+
+func typeName(v reflect.Value) string {
+	if !v.IsValid() {
+		return ""
+	}
+	return v.Type().String()
+}
+
+func compareInts(x, y int) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func compareBools(x, y bool) int {
+	switch {
+	case !x && y:
+		return -1
+	case x && !y:
+		return 1
+	}
+	return 0
+}
+
+func compareFloats(x, y float64) int {
+	switch {
+	case x < y || (math.IsNaN(x) && !math.IsNaN(y)):
+		return -1
+	case y < x || (math.IsNaN(y) && !math.IsNaN(x)):
+		return 1
+	}
+	return 0
+}
+
+func compareStrings(x, y string) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
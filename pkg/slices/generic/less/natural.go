@@ -0,0 +1,78 @@
+package less
+
+import "github.com/ideoterra/transforms/pkg/slices/generic/closures"
+
+// Natural returns a LessFn that orders strings naturally, as a person would:
+// runs of decimal digits within the strings are compared by their numeric
+// value, and everything else is compared byte by byte, so "file9" sorts before
+// "file10". Numbers of equal value, such as "07" and "7", are ordered by their
+// number of leading zeros, fewest first, and the order is otherwise that of
+// the strings themselves. It panics if either value is not a string.
+func Natural() closures.LessFn {
+	return func(a, b interface{}) bool {
+		return naturalLess(a.(string), b.(string))
+	}
+}
+
+// NaturalDesc returns a LessFn that orders strings naturally, as Natural
+// does, but descending. It panics if either value is not a string.
+func NaturalDesc() closures.LessFn {
+	return Reverse(Natural())
+}
+
+func naturalLess(a, b string) bool {
+	// zeros breaks ties between numbers of equal value; it is decided by the
+	// first pair of such numbers with different numbers of leading zeros.
+	zeros := 0
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if !isDigit(a[i]) || !isDigit(b[j]) {
+			if a[i] != b[j] {
+				return a[i] < b[j]
+			}
+			i++
+			j++
+			continue
+		}
+
+		x, xZeros := digits(a, i)
+		y, yZeros := digits(b, j)
+		i += xZeros + len(x)
+		j += yZeros + len(y)
+		if len(x) != len(y) {
+			return len(x) < len(y)
+		}
+		if x != y {
+			return x < y
+		}
+		if zeros == 0 {
+			zeros = xZeros - yZeros
+		}
+	}
+	if len(a)-i != len(b)-j {
+		return len(a)-i < len(b)-j
+	}
+	if zeros != 0 {
+		return zeros < 0
+	}
+	return a < b
+}
+
+// digits returns the run of digits starting at s[i], less its leading zeros,
+// and the number of leading zeros.
+func digits(s string, i int) (string, int) {
+	start := i
+	for i < len(s) && s[i] == '0' {
+		i++
+	}
+	zeros := i - start
+	start = i
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return s[start:i], zeros
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
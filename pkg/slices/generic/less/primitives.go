@@ -0,0 +1,249 @@
+package less
+
+import (
+	"math"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic/closures"
+)
+
+// Bool returns a LessFn that orders bool values ascending, with false before
+// true. It panics if either value is not a bool.
+func Bool() closures.LessFn {
+	return func(a, b interface{}) bool {
+		return !a.(bool) && b.(bool)
+	}
+}
+
+// BoolDesc returns a LessFn that orders bool values descending. It panics if
+// either value is not a bool.
+func BoolDesc() closures.LessFn {
+	return Reverse(Bool())
+}
+
+// Byte returns a LessFn that orders byte values ascending. It panics if either
+// value is not a byte.
+func Byte() closures.LessFn {
+	return func(a, b interface{}) bool {
+		return a.(byte) < b.(byte)
+	}
+}
+
+// ByteDesc returns a LessFn that orders byte values descending. It panics if
+// either value is not a byte.
+func ByteDesc() closures.LessFn {
+	return Reverse(Byte())
+}
+
+// Float32 returns a LessFn that orders float32 values ascending, with NaN
+// before any other value, as sort.Float64s does. It panics if either value is
+// not a float32.
+func Float32() closures.LessFn {
+	return func(a, b interface{}) bool {
+		x, y := a.(float32), b.(float32)
+		return x < y || (math.IsNaN(float64(x)) && !math.IsNaN(float64(y)))
+	}
+}
+
+// Float32Desc returns a LessFn that orders float32 values descending. It panics
+// if either value is not a float32.
+func Float32Desc() closures.LessFn {
+	return Reverse(Float32())
+}
+
+// Float64 returns a LessFn that orders float64 values ascending, with NaN
+// before any other value, as sort.Float64s does. It panics if either value is
+// not a float64.
+func Float64() closures.LessFn {
+	return func(a, b interface{}) bool {
+		x, y := a.(float64), b.(float64)
+		return x < y || (math.IsNaN(x) && !math.IsNaN(y))
+	}
+}
+
+// Float64Desc returns a LessFn that orders float64 values descending. It panics
+// if either value is not a float64.
+func Float64Desc() closures.LessFn {
+	return Reverse(Float64())
+}
+
+// Int returns a LessFn that orders int values ascending. It panics if either
+// value is not an int.
+func Int() closures.LessFn {
+	return func(a, b interface{}) bool {
+		return a.(int) < b.(int)
+	}
+}
+
+// IntDesc returns a LessFn that orders int values descending. It panics if
+// either value is not an int.
+func IntDesc() closures.LessFn {
+	return Reverse(Int())
+}
+
+// Int8 returns a LessFn that orders int8 values ascending. It panics if either
+// value is not an int8.
+func Int8() closures.LessFn {
+	return func(a, b interface{}) bool {
+		return a.(int8) < b.(int8)
+	}
+}
+
+// Int8Desc returns a LessFn that orders int8 values descending. It panics if
+// either value is not an int8.
+func Int8Desc() closures.LessFn {
+	return Reverse(Int8())
+}
+
+// Int16 returns a LessFn that orders int16 values ascending. It panics if
+// either value is not an int16.
+func Int16() closures.LessFn {
+	return func(a, b interface{}) bool {
+		return a.(int16) < b.(int16)
+	}
+}
+
+// Int16Desc returns a LessFn that orders int16 values descending. It panics if
+// either value is not an int16.
+func Int16Desc() closures.LessFn {
+	return Reverse(Int16())
+}
+
+// Int32 returns a LessFn that orders int32 values ascending. It panics if
+// either value is not an int32.
+func Int32() closures.LessFn {
+	return func(a, b interface{}) bool {
+		return a.(int32) < b.(int32)
+	}
+}
+
+// Int32Desc returns a LessFn that orders int32 values descending. It panics if
+// either value is not an int32.
+func Int32Desc() closures.LessFn {
+	return Reverse(Int32())
+}
+
+// Int64 returns a LessFn that orders int64 values ascending. It panics if
+// either value is not an int64.
+func Int64() closures.LessFn {
+	return func(a, b interface{}) bool {
+		return a.(int64) < b.(int64)
+	}
+}
+
+// Int64Desc returns a LessFn that orders int64 values descending. It panics if
+// either value is not an int64.
+func Int64Desc() closures.LessFn {
+	return Reverse(Int64())
+}
+
+// Rune returns a LessFn that orders rune values ascending. It panics if either
+// value is not a rune.
+func Rune() closures.LessFn {
+	return func(a, b interface{}) bool {
+		return a.(rune) < b.(rune)
+	}
+}
+
+// RuneDesc returns a LessFn that orders rune values descending. It panics if
+// either value is not a rune.
+func RuneDesc() closures.LessFn {
+	return Reverse(Rune())
+}
+
+// String returns a LessFn that orders string values ascending. It panics if
+// either value is not a string.
+func String() closures.LessFn {
+	return func(a, b interface{}) bool {
+		return a.(string) < b.(string)
+	}
+}
+
+// StringDesc returns a LessFn that orders string values descending. It panics
+// if either value is not a string.
+func StringDesc() closures.LessFn {
+	return Reverse(String())
+}
+
+// Uint returns a LessFn that orders uint values ascending. It panics if either
+// value is not an uint.
+func Uint() closures.LessFn {
+	return func(a, b interface{}) bool {
+		return a.(uint) < b.(uint)
+	}
+}
+
+// UintDesc returns a LessFn that orders uint values descending. It panics if
+// either value is not an uint.
+func UintDesc() closures.LessFn {
+	return Reverse(Uint())
+}
+
+// Uint8 returns a LessFn that orders uint8 values ascending. It panics if
+// either value is not an uint8.
+func Uint8() closures.LessFn {
+	return func(a, b interface{}) bool {
+		return a.(uint8) < b.(uint8)
+	}
+}
+
+// Uint8Desc returns a LessFn that orders uint8 values descending. It panics if
+// either value is not an uint8.
+func Uint8Desc() closures.LessFn {
+	return Reverse(Uint8())
+}
+
+// Uint16 returns a LessFn that orders uint16 values ascending. It panics if
+// either value is not an uint16.
+func Uint16() closures.LessFn {
+	return func(a, b interface{}) bool {
+		return a.(uint16) < b.(uint16)
+	}
+}
+
+// Uint16Desc returns a LessFn that orders uint16 values descending. It panics
+// if either value is not an uint16.
+func Uint16Desc() closures.LessFn {
+	return Reverse(Uint16())
+}
+
+// Uint32 returns a LessFn that orders uint32 values ascending. It panics if
+// either value is not an uint32.
+func Uint32() closures.LessFn {
+	return func(a, b interface{}) bool {
+		return a.(uint32) < b.(uint32)
+	}
+}
+
+// Uint32Desc returns a LessFn that orders uint32 values descending. It panics
+// if either value is not an uint32.
+func Uint32Desc() closures.LessFn {
+	return Reverse(Uint32())
+}
+
+// Uint64 returns a LessFn that orders uint64 values ascending. It panics if
+// either value is not an uint64.
+func Uint64() closures.LessFn {
+	return func(a, b interface{}) bool {
+		return a.(uint64) < b.(uint64)
+	}
+}
+
+// Uint64Desc returns a LessFn that orders uint64 values descending. It panics
+// if either value is not an uint64.
+func Uint64Desc() closures.LessFn {
+	return Reverse(Uint64())
+}
+
+// Uintptr returns a LessFn that orders uintptr values ascending. It panics if
+// either value is not an uintptr.
+func Uintptr() closures.LessFn {
+	return func(a, b interface{}) bool {
+		return a.(uintptr) < b.(uintptr)
+	}
+}
+
+// UintptrDesc returns a LessFn that orders uintptr values descending. It panics
+// if either value is not an uintptr.
+func UintptrDesc() closures.LessFn {
+	return Reverse(Uintptr())
+}
@@ -2,8 +2,6 @@ package generic_test
 
 import (
 	"fmt"
-	"reflect"
-	"strconv"
 	"testing"
 
 	"github.com/ideoterra/transforms/pkg/slices/generic"
@@ -54,8 +52,8 @@ func TestUnaryValueMethodHappyPaths(t *testing.T) {
 		func(aa generic.SliceType) { aa.Skip(0) },
 		func(aa generic.SliceType) { aa.SplitAt(0) },
 		func(aa generic.SliceType) { aa.Take(0) },
-		func(aa generic.SliceType) { aa.Union(new([]interface{})) },
-		func(aa generic.SliceType) { aa.Zip(new([]interface{})) },
+		func(aa generic.SliceType) { aa.Union(nil) },
+		func(aa generic.SliceType) { aa.Zip(nil) },
 	}
 
 	for i, methodCall := range methodCalls {
@@ -128,6 +126,9 @@ func TestUnaryClosureHappyPaths(t *testing.T) {
 		func(aa generic.SliceType) {
 			aa.GroupI(func(int64, interface{}) string { return "0" })
 		},
+		func(aa generic.SliceType) {
+			aa.GroupByTrait(func(a, b interface{}) bool { return true }, func(a, b interface{}) bool { return true })
+		},
 		func(aa generic.SliceType) {
 			aa.Map(func(interface{}) interface{} { return primitiveZero })
 		},
@@ -246,169 +247,3 @@ func TestBinaryValueValueHappyPaths(t *testing.T) {
 		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
 	}
 }
-
-func TestMutatingMethods(t *testing.T) {
-	// The methods covered by this condition are expected to mutate their receiver
-	// value. Core behavior of each method is covered in the function
-	// tests (see functions_test.go), so these tests are fairly cursory in that
-	// they only seek to verify that the value has changed after an operation,
-	// but don't go so far as to check how the value changed.
-
-	var equality = func(a, b interface{}) bool {
-		return a.(int) == b.(int)
-	}
-
-	var condition = func(a interface{}) bool {
-		return a.(int) == 1
-	}
-
-	var sliceForUnionTest = []interface{}{1}
-
-	methodCalls := []func(*generic.SliceType){
-		func(aa *generic.SliceType) { aa.Append(1) },
-		func(aa *generic.SliceType) { aa.Apply(func(a interface{}) interface{} { return a.(int) * 2 }) },
-		func(aa *generic.SliceType) { aa.Clear() },
-		func(aa *generic.SliceType) { aa.Dequeue() },
-		func(aa *generic.SliceType) { aa.Distinct(equality) },
-		func(aa *generic.SliceType) { aa.Enqueue(1) },
-		func(aa *generic.SliceType) { aa.Filter(condition) },
-		func(aa *generic.SliceType) { aa.InsertAfter(1, condition) },
-		func(aa *generic.SliceType) { aa.InsertBefore(1, condition) },
-		func(aa *generic.SliceType) { aa.InsertAt(1, 0) },
-		func(aa *generic.SliceType) { aa.Pop() },
-		func(aa *generic.SliceType) { aa.Push(1) },
-		func(aa *generic.SliceType) { aa.Remove(condition) },
-		func(aa *generic.SliceType) { aa.RemoveAt(1) },
-		func(aa *generic.SliceType) { aa.Reverse() },
-		func(aa *generic.SliceType) { aa.Skip(1) },
-		func(aa *generic.SliceType) { aa.SkipWhile(condition) },
-		func(aa *generic.SliceType) { aa.Sort(func(a, b interface{}) bool { return a.(int) < b.(int) }) },
-		func(aa *generic.SliceType) { aa.SwapIndex(0, 2) },
-		func(aa *generic.SliceType) { aa.Tail() },
-		func(aa *generic.SliceType) { aa.Take(1) },
-		func(aa *generic.SliceType) { aa.TakeWhile(condition) },
-		func(aa *generic.SliceType) { aa.Union(&sliceForUnionTest) },
-	}
-	for i, methodCall := range methodCalls {
-		condition := func(t *testing.T) {
-			aa := generic.SliceType{1, 1, 2, 1}
-			methodCall(&aa)
-			bb := generic.SliceType{1, 1, 2, 1}
-			if reflect.DeepEqual(aa, bb) {
-				t.Fail()
-			}
-		}
-		t.Run(fmt.Sprintf("Mutation condition %v", i+1), condition)
-	}
-}
-
-func TestNonMutatingMethods(t *testing.T) {
-	// The methods covered by this condition are be expected not to mutate their
-	// receiver value. Core behavior of each method is covered in the function
-	// tests (see functions_test.go), so these tests are fairly cursory in that
-	// they only seek to verify that the receiver value has not changed after
-	// each operation, but do not otherwise verify the outcomes.
-
-	var equality = func(a, b interface{}) bool {
-		return a.(int) == b.(int)
-	}
-
-	var condition = func(a interface{}) bool {
-		return a.(int) > 0
-	}
-
-	var window = func(window []interface{}) interface{} {
-		return window[0]
-	}
-
-	sliceForZipTest := []interface{}{4, 5, 6}
-
-	methodCalls := []func(*generic.SliceType){
-		func(aa *generic.SliceType) { aa.All(condition) },
-		func(aa *generic.SliceType) { aa.Any(condition) },
-		func(aa *generic.SliceType) { aa.Clone() },
-		func(aa *generic.SliceType) {
-			aa.Collect([]interface{}{1, 2}, func(a, b interface{}) interface{} {
-				return a.(int) * b.(int)
-			})
-		},
-		func(aa *generic.SliceType) { aa.Count(condition) },
-		func(aa *generic.SliceType) { aa.Difference([]interface{}{2, 3}, equality) },
-		func(aa *generic.SliceType) { aa.Empty() },
-		func(aa *generic.SliceType) { aa.End() },
-		func(aa *generic.SliceType) {
-			aa.Expand(func(a interface{}) []interface{} { return []interface{}{1, 2} })
-		},
-		func(aa *generic.SliceType) { aa.FindIndex(condition) },
-		func(aa *generic.SliceType) { aa.First(condition) },
-		func(aa *generic.SliceType) {
-			aa.Fold(2, func(a, acc interface{}) interface{} {
-				return acc.(int) * a.(int)
-			})
-		},
-		func(aa *generic.SliceType) {
-			aa.FoldI(2, func(_ int64, a, acc interface{}) interface{} {
-				return acc.(int) * a.(int)
-			})
-		},
-		func(aa *generic.SliceType) {
-			aa.ForEach(func(_ interface{}) shared.Continue { return shared.ContinueYes })
-		},
-		func(aa *generic.SliceType) {
-			aa.ForEachC(1, func(_ interface{}, _ func() bool) shared.Continue { return shared.ContinueYes })
-		},
-		func(aa *generic.SliceType) {
-			aa.ForEachR(func(_ interface{}) shared.Continue { return shared.ContinueYes })
-		},
-		func(aa *generic.SliceType) { aa.Group(func(_ interface{}) string { return "0" }) },
-		func(aa *generic.SliceType) {
-			aa.GroupI(func(i int64, _ interface{}) string { return strconv.Itoa(int(i)) })
-		},
-		func(aa *generic.SliceType) { aa.Head() },
-		func(aa *generic.SliceType) { aa.Intersection([]interface{}{1, 2}, equality) },
-		func(aa *generic.SliceType) { aa.IsProperSubset([]interface{}{1, 2}, equality) },
-		func(aa *generic.SliceType) { aa.IsProperSuperset([]interface{}{1, 2}, equality) },
-		func(aa *generic.SliceType) { aa.IsSubset([]interface{}{1, 2}, equality) },
-		func(aa *generic.SliceType) { aa.IsSuperset([]interface{}{1, 2}, equality) },
-		func(aa *generic.SliceType) { aa.Item(0) },
-		func(aa *generic.SliceType) { aa.ItemFuzzy(0) },
-		func(aa *generic.SliceType) { aa.Last(condition) },
-		func(aa *generic.SliceType) { aa.Len() },
-		func(aa *generic.SliceType) { aa.None(condition) },
-		func(aa *generic.SliceType) {
-			aa.Pairwise(1, func(a, b interface{}) interface{} {
-				return a.(int) * b.(int)
-			})
-		},
-		func(aa *generic.SliceType) { aa.Partition(condition) },
-		func(aa *generic.SliceType) { aa.Permutable() },
-		func(aa *generic.SliceType) { aa.Permutations() },
-		func(aa *generic.SliceType) { aa.Permute() },
-		func(aa *generic.SliceType) {
-			aa.Reduce(func(a, acc interface{}) interface{} {
-				return a.(int) + acc.(int)
-			})
-		},
-		func(aa *generic.SliceType) { aa.SplitAfter(condition) },
-		func(aa *generic.SliceType) { aa.SplitAt(1) },
-		func(aa *generic.SliceType) { aa.SplitBefore(condition) },
-		func(aa *generic.SliceType) { _ = aa.String() },
-		func(aa *generic.SliceType) { aa.Unzip() },
-		func(aa *generic.SliceType) { aa.WindowCentered(2, window) },
-		func(aa *generic.SliceType) { aa.WindowLeft(2, window) },
-		func(aa *generic.SliceType) { aa.WindowRight(2, window) },
-		func(aa *generic.SliceType) { aa.Zip(&sliceForZipTest) },
-	}
-
-	for i, methodCall := range methodCalls {
-		condition := func(t *testing.T) {
-			aa := generic.SliceType{1, 2, 3}
-			methodCall(&aa)
-			bb := generic.SliceType{1, 2, 3}
-			if !reflect.DeepEqual(aa, bb) {
-				t.Fail()
-			}
-		}
-		t.Run(fmt.Sprintf("Mutation condition %v", i+1), condition)
-	}
-}
@@ -0,0 +1,50 @@
+package generic_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic"
+	"github.com/stretchr/testify/assert"
+)
+
+// methods.go is derived from functions.go by the generator. Every exported
+// function whose first parameter is the source slice, aa, must have a method
+// counterpart; if this test fails, regenerate the package (go generate).
+func TestMethodParity(t *testing.T) {
+	f, err := parser.ParseFile(token.NewFileSet(), "functions.go", nil, 0)
+	if !assert.NoError(t, err) {
+		return
+	}
+	methods := reflect.TypeOf(&generic.SliceType{})
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || !fn.Name.IsExported() || !takesSourceSlice(fn) {
+			continue
+		}
+		_, ok = methods.MethodByName(fn.Name.Name)
+		assert.True(t, ok, "%v has no method counterpart", fn.Name.Name)
+	}
+}
+
+// takesSourceSlice reports whether the first parameter of fn is aa, of type
+// []interface{} or *[]interface{}.
+func takesSourceSlice(fn *ast.FuncDecl) bool {
+	params := fn.Type.Params.List
+	if len(params) == 0 || params[0].Names[0].Name != "aa" {
+		return false
+	}
+	t := params[0].Type
+	if star, ok := t.(*ast.StarExpr); ok {
+		t = star.X
+	}
+	array, ok := t.(*ast.ArrayType)
+	if !ok {
+		return false
+	}
+	iface, ok := array.Elt.(*ast.InterfaceType)
+	return ok && len(iface.Methods.List) == 0
+}
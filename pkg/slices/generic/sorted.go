@@ -0,0 +1,136 @@
+package generic
+
+import (
+	"sort"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic/closures"
+)
+
+// SortedSliceType holds elements sorted in the order given by a Comparator.
+// It provides only operations that keep them so, and its values may therefore
+// be given to the S variants of the transforms, which require sorted data,
+// without risk of an incorrect result:
+//
+//	ss := NewSorted(compare, values...)
+//	n := CountS(ss.Values(), closures.SeekBetween(lo, hi, ss.Comparator()))
+//
+// Elements that compare equal are kept in the order in which they were
+// inserted. The zero value holds no Comparator and cannot be used; a
+// SortedSliceType is created with NewSorted. It is not safe for concurrent
+// use.
+type SortedSliceType struct {
+	aa      []interface{}
+	compare closures.Comparator
+}
+
+// NewSorted returns a SortedSliceType ordered by compare, holding values.
+// values is not modified.
+func NewSorted(compare closures.Comparator, values ...interface{}) *SortedSliceType {
+	aa := append([]interface{}{}, values...)
+	sort.SliceStable(aa, func(i, j int) bool {
+		return compare(aa[i], aa[j]) < 0
+	})
+	return &SortedSliceType{aa: aa, compare: compare}
+}
+
+// Insert adds values, placing each after any elements that compare equal to
+// it. Each is placed by binary search, though elements after it must then be
+// moved along.
+func (ss *SortedSliceType) Insert(values ...interface{}) *SortedSliceType {
+	for _, value := range values {
+		i := ss.end(value)
+		ss.aa = append(ss.aa, value)
+		copy(ss.aa[i+1:], ss.aa[i:])
+		ss.aa[i] = value
+	}
+	return ss
+}
+
+// Remove removes every element that compares equal to value, and returns the
+// number removed.
+func (ss *SortedSliceType) Remove(value interface{}) int64 {
+	i, j := ss.first(value), ss.end(value)
+	ss.aa = append(ss.aa[:i], ss.aa[j:]...)
+	return int64(j - i)
+}
+
+// Find returns the index of the first element that compares equal to value,
+// or -1 if there is none.
+func (ss *SortedSliceType) Find(value interface{}) int64 {
+	return FindIndexS(ss.aa, ss.seek(value))
+}
+
+// Contains returns true if an element compares equal to value.
+func (ss *SortedSliceType) Contains(value interface{}) bool {
+	return AnyS(ss.aa, ss.seek(value))
+}
+
+// Rank returns the number of elements that sort before value, which is the
+// index at which value is, or would be, first found.
+func (ss *SortedSliceType) Rank(value interface{}) int64 {
+	return int64(ss.first(value))
+}
+
+// Floor returns a []interface{} containing the greatest element that does not
+// sort after value (the last, if several compare equal to value), or an empty
+// []interface{} if every element sorts after value.
+func (ss *SortedSliceType) Floor(value interface{}) []interface{} {
+	i := ss.end(value)
+	if i == 0 {
+		return []interface{}{}
+	}
+	return []interface{}{ss.aa[i-1]}
+}
+
+// Ceiling returns a []interface{} containing the least element that does not
+// sort before value (the first, if several compare equal to value), or an
+// empty []interface{} if every element sorts before value.
+func (ss *SortedSliceType) Ceiling(value interface{}) []interface{} {
+	i := ss.first(value)
+	if i == len(ss.aa) {
+		return []interface{}{}
+	}
+	return []interface{}{ss.aa[i]}
+}
+
+// RangeBetween returns the elements from lo to hi, inclusive, in order. The
+// result is empty if hi sorts before lo.
+func (ss *SortedSliceType) RangeBetween(lo, hi interface{}) []interface{} {
+	i, j := ss.first(lo), ss.end(hi)
+	if j < i {
+		return []interface{}{}
+	}
+	return append([]interface{}{}, ss.aa[i:j]...)
+}
+
+// Len returns the number of elements.
+func (ss *SortedSliceType) Len() int {
+	return len(ss.aa)
+}
+
+// Values returns a copy of the elements, in order. Changes to the copy do not
+// affect the SortedSliceType.
+func (ss *SortedSliceType) Values() SliceType {
+	return append(SliceType{}, ss.aa...)
+}
+
+// Comparator returns the Comparator by which the elements are ordered.
+func (ss *SortedSliceType) Comparator() closures.Comparator {
+	return ss.compare
+}
+
+// seek returns a search for the elements that compare equal to value.
+func (ss *SortedSliceType) seek(value interface{}) closures.SearchFn {
+	return closures.Seek(value, ss.compare)
+}
+
+// first returns the index of the first element that does not sort before
+// value.
+func (ss *SortedSliceType) first(value interface{}) int {
+	return searchFirst(ss.aa, ss.seek(value))
+}
+
+// end returns the index of the first element that sorts after value.
+func (ss *SortedSliceType) end(value interface{}) int {
+	return searchEnd(ss.aa, ss.seek(value))
+}
@@ -0,0 +1,109 @@
+package generic_test
+
+import (
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic"
+	"github.com/ideoterra/transforms/pkg/slices/generic/closures"
+	"github.com/ideoterra/transforms/pkg/slices/generic/less"
+	"github.com/stretchr/testify/assert"
+)
+
+// The tests in this file rely upon int values, and so, like those in
+// mutations_test.go, are not generated alongside each typed package.
+
+func compareInts(a, b interface{}) int {
+	return a.(int) - b.(int)
+}
+
+func TestNewSorted(t *testing.T) {
+	values := []interface{}{5, 1, 4, 1, 3}
+	ss := generic.NewSorted(compareInts, values...)
+	assert.Equal(t, generic.SliceType{1, 1, 3, 4, 5}, ss.Values())
+	assert.Equal(t, []interface{}{5, 1, 4, 1, 3}, values)
+	assert.Equal(t, 5, ss.Len())
+	assert.Equal(t, 0, generic.NewSorted(compareInts).Len())
+}
+
+func TestSortedInsert(t *testing.T) {
+	ss := generic.NewSorted(compareInts)
+	ss.Insert(3, 1).Insert(2, 5, 4, 0, 6)
+	assert.Equal(t, generic.SliceType{0, 1, 2, 3, 4, 5, 6}, ss.Values())
+}
+
+func TestSortedInsertIsStable(t *testing.T) {
+	byLength := closures.By(func(a interface{}) any { return len(a.(string)) }, func(a, b any) bool {
+		return a.(int) < b.(int)
+	})
+	ss := generic.NewSorted(byLength, "bb", "a", "cc")
+	ss.Insert("dd", "e", "fff")
+	assert.Equal(t, generic.SliceType{"a", "e", "bb", "cc", "dd", "fff"}, ss.Values())
+}
+
+func TestSortedRemove(t *testing.T) {
+	ss := generic.NewSorted(compareInts, 1, 2, 2, 2, 3)
+	assert.Equal(t, int64(3), ss.Remove(2))
+	assert.Equal(t, int64(0), ss.Remove(2))
+	assert.Equal(t, generic.SliceType{1, 3}, ss.Values())
+}
+
+func TestSortedFind(t *testing.T) {
+	ss := generic.NewSorted(compareInts, 1, 3, 3, 5)
+	assert.Equal(t, int64(1), ss.Find(3))
+	assert.Equal(t, int64(-1), ss.Find(4))
+	assert.True(t, ss.Contains(5))
+	assert.False(t, ss.Contains(0))
+}
+
+func TestSortedRank(t *testing.T) {
+	ss := generic.NewSorted(compareInts, 1, 3, 3, 5)
+	assert.Equal(t, int64(0), ss.Rank(0))
+	assert.Equal(t, int64(1), ss.Rank(3))
+	assert.Equal(t, int64(3), ss.Rank(4))
+	assert.Equal(t, int64(4), ss.Rank(9))
+}
+
+func TestSortedFloorAndCeiling(t *testing.T) {
+	ss := generic.NewSorted(compareInts, 10, 20, 30)
+	assert.Equal(t, []interface{}{20}, ss.Floor(25))
+	assert.Equal(t, []interface{}{20}, ss.Floor(20))
+	assert.Equal(t, []interface{}{}, ss.Floor(5))
+	assert.Equal(t, []interface{}{30}, ss.Ceiling(25))
+	assert.Equal(t, []interface{}{20}, ss.Ceiling(20))
+	assert.Equal(t, []interface{}{}, ss.Ceiling(35))
+}
+
+func TestSortedFloorAndCeilingAmongEquals(t *testing.T) {
+	byTens := func(a, b interface{}) int {
+		return a.(int)/10 - b.(int)/10
+	}
+	ss := generic.NewSorted(byTens, 21, 5, 25, 23)
+	assert.Equal(t, []interface{}{23}, ss.Floor(20))
+	assert.Equal(t, []interface{}{21}, ss.Ceiling(29))
+}
+
+func TestSortedRangeBetween(t *testing.T) {
+	ss := generic.NewSorted(compareInts, 1, 2, 4, 4, 6, 8)
+	assert.Equal(t, []interface{}{2, 4, 4}, ss.RangeBetween(2, 5))
+	assert.Equal(t, []interface{}{4, 4, 6, 8}, ss.RangeBetween(3, 9))
+	assert.Equal(t, []interface{}{}, ss.RangeBetween(5, 5))
+	assert.Equal(t, []interface{}{}, ss.RangeBetween(6, 2))
+}
+
+func TestSortedValuesAreACopy(t *testing.T) {
+	ss := generic.NewSorted(compareInts, 1, 2, 3)
+	values := ss.Values()
+	values.Reverse()
+	assert.Equal(t, generic.SliceType{1, 2, 3}, ss.Values())
+	ss.RangeBetween(1, 3)[0] = 9
+	assert.Equal(t, generic.SliceType{1, 2, 3}, ss.Values())
+}
+
+func TestSortedWithSVariants(t *testing.T) {
+	ss := generic.NewSorted(closures.Compare(less.Int()), 7, 3, 9, 1, 5, 3)
+	values := ss.Values()
+	assert.Equal(t, int64(2), values.CountS(closures.Seek(3, ss.Comparator())))
+	assert.Equal(t, int64(3), values.CountS(closures.SeekBetween(4, 9, ss.Comparator())))
+	other := generic.NewSorted(ss.Comparator(), 9, 3, 4)
+	assert.Equal(t, []interface{}{3, 9}, generic.IntersectionS(values, other.Values(), ss.Comparator()))
+}
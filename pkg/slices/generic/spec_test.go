@@ -0,0 +1,88 @@
+package generic_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/generic"
+)
+
+// Behaviors and specifications are generated, along with the specifications of
+// functions_test.go, for every typed package.
+
+type Behavior struct {
+	Description string
+	Expectation func(t *testing.T)
+}
+
+type Specification struct {
+	FunctionName    string
+	StandardPath    Behavior
+	AlternativePath Behavior
+	EdgeCases       []Behavior
+}
+
+func runSpecifications(t *testing.T, specifications []Specification) {
+	for _, specification := range specifications {
+		t.Run(specification.FunctionName+"StandardPath", specification.StandardPath.Expectation)
+		t.Run(specification.FunctionName+"AlternativePath", specification.AlternativePath.Expectation)
+		for i, edgeCase := range specification.EdgeCases {
+			t.Run(fmt.Sprintf("%vEdgeCase%v", specification.FunctionName, i+1), edgeCase.Expectation)
+		}
+	}
+}
+
+func assertSlicesEqual(t *testing.T, xx, yy []interface{}) bool {
+	return assertMultisetsEqual(t, xx, yy,
+		hashes(len(xx), func(i int) any { return xx[i] }),
+		hashes(len(yy), func(i int) any { return yy[i] }))
+}
+
+// assertGroupsEqual asserts that xx and yy hold the same groups, in any order.
+// The elements of each group must be in the same order.
+func assertGroupsEqual(t *testing.T, xx, yy generic.SliceType2) bool {
+	return assertMultisetsEqual(t, xx, yy,
+		hashes(len(xx), func(i int) any { return xx[i] }),
+		hashes(len(yy), func(i int) any { return yy[i] }))
+}
+
+// hashes returns a hash for each of n items.
+func hashes(n int, item func(int) any) []string {
+	// often dealing with using []interface{} as the key (hash) value in a map
+	// which go doesn't like because []interface{} types are unhashable.
+	// We convert the values to a string to get around this limitation.
+	result := make([]string, n)
+	for i := range result {
+		result[i] = fmt.Sprintf("%v", item(i))
+	}
+	return result
+}
+
+// assertMultisetsEqual asserts that the slices xx and yy, with element hashes
+// hashxx and hashyy, hold the same elements in any order.
+func assertMultisetsEqual(t *testing.T, xx, yy any, hashxx, hashyy []string) bool {
+	if len(hashxx) != len(hashyy) {
+		t.Errorf("Expected lengths to match. Wanted %v, got %v", xx, yy)
+		return false
+	}
+	diff := make(map[string]int, len(hashxx))
+	for _, hashx := range hashxx {
+		diff[hashx]++
+	}
+	for _, hashy := range hashyy {
+		if _, ok := diff[hashy]; !ok {
+			t.Errorf("Expected %v, but got %v", xx, yy)
+			return false
+		}
+		diff[hashy] -= 1
+		if diff[hashy] == 0 {
+			delete(diff, hashy)
+		}
+	}
+	if len(diff) == 0 {
+		return true
+	}
+
+	t.Errorf("Expected %v, but got %v", xx, yy)
+	return false
+}
@@ -1,4 +1,20 @@
 package generic
 
+// PrimitiveType is the type of the elements held by a SliceType. Within this
+// package it is interface{}; the generator (see cmd) substitutes a concrete
+// type for it, and for every other use of interface{}, when deriving a typed
+// package from this one.
+type PrimitiveType = interface{}
+
 // SliceType is a one dimensional slice of PrimitiveType.
 type SliceType []interface{}
+
+// SliceType2 is a two dimensional slice of PrimitiveType, such as is returned
+// by Group, Partition, and the Split functions. Each of its elements is a
+// []interface{}.
+//
+// The methods of those functions return a SliceType2 rather than a *SliceType.
+// In a typed package a SliceType2 is a [][]T, which is not a SliceType, so it
+// cannot be boxed as one; returning it as it is keeps the methods identical
+// across packages, at the cost of chaining on their results.
+type SliceType2 = []interface{}
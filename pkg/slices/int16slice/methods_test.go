@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package int16slice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/int16slice"
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(int16slice.Int16Slice){
+		func(aa int16slice.Int16Slice) { aa.Clear() },
+		func(aa int16slice.Int16Slice) { aa.Clone() },
+		func(aa int16slice.Int16Slice) { aa.Dequeue() },
+		func(aa int16slice.Int16Slice) { aa.Empty() },
+		func(aa int16slice.Int16Slice) { aa.End() },
+		func(aa int16slice.Int16Slice) { aa.Head() },
+		func(aa int16slice.Int16Slice) { aa.Len() },
+		func(aa int16slice.Int16Slice) { aa.Permutable() },
+		func(aa int16slice.Int16Slice) { aa.Permutations() },
+		func(aa int16slice.Int16Slice) { aa.Permute() },
+		func(aa int16slice.Int16Slice) { aa.Pop() },
+		func(aa int16slice.Int16Slice) { aa.Reverse() },
+		func(aa int16slice.Int16Slice) { _ = aa.String() },
+		func(aa int16slice.Int16Slice) { aa.Tail() },
+		func(aa int16slice.Int16Slice) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int16slice.Int16Slice{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(int16slice.Int16Slice){
+		func(aa int16slice.Int16Slice) { aa.Item(0) },
+		func(aa int16slice.Int16Slice) { aa.ItemFuzzy(0) },
+		func(aa int16slice.Int16Slice) { aa.RemoveAt(0) },
+		func(aa int16slice.Int16Slice) { aa.Skip(0) },
+		func(aa int16slice.Int16Slice) { aa.SplitAt(0) },
+		func(aa int16slice.Int16Slice) { aa.Take(0) },
+		func(aa int16slice.Int16Slice) { aa.Union(nil) },
+		func(aa int16slice.Int16Slice) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int16slice.Int16Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(int16slice.Int16Slice, int16){
+		func(aa int16slice.Int16Slice, b int16) { aa.Append(b) },
+		func(aa int16slice.Int16Slice, b int16) { aa.Enqueue(b) },
+		func(aa int16slice.Int16Slice, b int16) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int16slice.Int16Slice{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(int16slice.Int16Slice, int16slice.ConditionFn){
+		func(aa int16slice.Int16Slice, condition int16slice.ConditionFn) { aa.All(condition) },
+		func(aa int16slice.Int16Slice, condition int16slice.ConditionFn) { aa.Any(condition) },
+		func(aa int16slice.Int16Slice, condition int16slice.ConditionFn) { aa.Count(condition) },
+		func(aa int16slice.Int16Slice, condition int16slice.ConditionFn) { aa.Filter(condition) },
+		func(aa int16slice.Int16Slice, condition int16slice.ConditionFn) { aa.FindIndex(condition) },
+		func(aa int16slice.Int16Slice, condition int16slice.ConditionFn) { aa.First(condition) },
+		func(aa int16slice.Int16Slice, condition int16slice.ConditionFn) { aa.Last(condition) },
+		func(aa int16slice.Int16Slice, condition int16slice.ConditionFn) { aa.None(condition) },
+		func(aa int16slice.Int16Slice, condition int16slice.ConditionFn) { aa.Partition(condition) },
+		func(aa int16slice.Int16Slice, condition int16slice.ConditionFn) { aa.Remove(condition) },
+		func(aa int16slice.Int16Slice, condition int16slice.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa int16slice.Int16Slice, condition int16slice.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa int16slice.Int16Slice, condition int16slice.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa int16slice.Int16Slice, condition int16slice.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ int16) bool {
+				return true
+			}
+			methodCall(int16slice.Int16Slice{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(int16slice.Int16Slice){
+		func(aa int16slice.Int16Slice) {
+			aa.Distinct(func(a, b int16) bool { return true })
+		},
+		func(aa int16slice.Int16Slice) {
+			aa.Expand(func(int16) []int16 { return nil })
+		},
+		func(aa int16slice.Int16Slice) {
+			aa.ForEach(func(int16) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa int16slice.Int16Slice) {
+			aa.ForEachR(func(int16) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa int16slice.Int16Slice) {
+			aa.Group(func(int16) string { return "0" })
+		},
+		func(aa int16slice.Int16Slice) {
+			aa.GroupI(func(int64, int16) string { return "0" })
+		},
+		func(aa int16slice.Int16Slice) {
+			aa.GroupByTrait(func(a, b int16) bool { return true }, func(a, b int16) bool { return true })
+		},
+		func(aa int16slice.Int16Slice) {
+			aa.Map(func(int16) int16 { return primitiveZero })
+		},
+		func(aa int16slice.Int16Slice) {
+			aa.Reduce(func(a, b int16) int16 { return primitiveZero })
+		},
+		func(aa int16slice.Int16Slice) {
+			aa.Sort(func(a, b int16) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int16slice.Int16Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(int16slice.Int16Slice, int16slice.EqualityFn){
+		func(aa int16slice.Int16Slice, equality int16slice.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa int16slice.Int16Slice, equality int16slice.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa int16slice.Int16Slice, equality int16slice.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa int16slice.Int16Slice, equality int16slice.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa int16slice.Int16Slice, equality int16slice.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa int16slice.Int16Slice, equality int16slice.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b int16) bool {
+				return false
+			}
+			methodCall(int16slice.Int16Slice{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(int16slice.Int16Slice, int16, int16slice.ConditionFn){
+		func(aa int16slice.Int16Slice, b int16, condition int16slice.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa int16slice.Int16Slice, b int16, condition int16slice.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(int16) bool {
+				return false
+			}
+			methodCall(int16slice.Int16Slice{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(int16slice.Int16Slice){
+		func(aa int16slice.Int16Slice) {
+			aa.ForEachC(0, func(int16, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa int16slice.Int16Slice) {
+			aa.WindowCentered(0, func([]int16) int16 { return primitiveZero })
+		},
+		func(aa int16slice.Int16Slice) {
+			aa.WindowLeft(0, func([]int16) int16 { return primitiveZero })
+		},
+		func(aa int16slice.Int16Slice) {
+			aa.WindowRight(0, func([]int16) int16 { return primitiveZero })
+		},
+		func(aa int16slice.Int16Slice) {
+			aa.Fold(primitiveZero, func(a, b int16) int16 { return primitiveZero })
+		},
+		func(aa int16slice.Int16Slice) {
+			aa.FoldI(primitiveZero, func(i int64, a, b int16) int16 { return primitiveZero })
+		},
+		func(aa int16slice.Int16Slice) {
+			aa.Pairwise(primitiveZero, func(a, b int16) int16 { return primitiveZero })
+		},
+		func(aa int16slice.Int16Slice) {
+			aa.Collect([]int16{}, func(a, b int16) int16 { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int16slice.Int16Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(int16slice.Int16Slice){
+		func(aa int16slice.Int16Slice) { aa.InsertAt(primitiveZero, 0) },
+		func(aa int16slice.Int16Slice) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int16slice.Int16Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package int16slice2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/int16slice2"
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(int16slice2.Int16Slice2){
+		func(aa int16slice2.Int16Slice2) { aa.Clear() },
+		func(aa int16slice2.Int16Slice2) { aa.Clone() },
+		func(aa int16slice2.Int16Slice2) { aa.Dequeue() },
+		func(aa int16slice2.Int16Slice2) { aa.Empty() },
+		func(aa int16slice2.Int16Slice2) { aa.End() },
+		func(aa int16slice2.Int16Slice2) { aa.Head() },
+		func(aa int16slice2.Int16Slice2) { aa.Len() },
+		func(aa int16slice2.Int16Slice2) { aa.Permutable() },
+		func(aa int16slice2.Int16Slice2) { aa.Permutations() },
+		func(aa int16slice2.Int16Slice2) { aa.Permute() },
+		func(aa int16slice2.Int16Slice2) { aa.Pop() },
+		func(aa int16slice2.Int16Slice2) { aa.Reverse() },
+		func(aa int16slice2.Int16Slice2) { _ = aa.String() },
+		func(aa int16slice2.Int16Slice2) { aa.Tail() },
+		func(aa int16slice2.Int16Slice2) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int16slice2.Int16Slice2{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(int16slice2.Int16Slice2){
+		func(aa int16slice2.Int16Slice2) { aa.Item(0) },
+		func(aa int16slice2.Int16Slice2) { aa.ItemFuzzy(0) },
+		func(aa int16slice2.Int16Slice2) { aa.RemoveAt(0) },
+		func(aa int16slice2.Int16Slice2) { aa.Skip(0) },
+		func(aa int16slice2.Int16Slice2) { aa.SplitAt(0) },
+		func(aa int16slice2.Int16Slice2) { aa.Take(0) },
+		func(aa int16slice2.Int16Slice2) { aa.Union(nil) },
+		func(aa int16slice2.Int16Slice2) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int16slice2.Int16Slice2{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(int16slice2.Int16Slice2, []int16){
+		func(aa int16slice2.Int16Slice2, b []int16) { aa.Append(b) },
+		func(aa int16slice2.Int16Slice2, b []int16) { aa.Enqueue(b) },
+		func(aa int16slice2.Int16Slice2, b []int16) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int16slice2.Int16Slice2{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(int16slice2.Int16Slice2, int16slice2.ConditionFn){
+		func(aa int16slice2.Int16Slice2, condition int16slice2.ConditionFn) { aa.All(condition) },
+		func(aa int16slice2.Int16Slice2, condition int16slice2.ConditionFn) { aa.Any(condition) },
+		func(aa int16slice2.Int16Slice2, condition int16slice2.ConditionFn) { aa.Count(condition) },
+		func(aa int16slice2.Int16Slice2, condition int16slice2.ConditionFn) { aa.Filter(condition) },
+		func(aa int16slice2.Int16Slice2, condition int16slice2.ConditionFn) { aa.FindIndex(condition) },
+		func(aa int16slice2.Int16Slice2, condition int16slice2.ConditionFn) { aa.First(condition) },
+		func(aa int16slice2.Int16Slice2, condition int16slice2.ConditionFn) { aa.Last(condition) },
+		func(aa int16slice2.Int16Slice2, condition int16slice2.ConditionFn) { aa.None(condition) },
+		func(aa int16slice2.Int16Slice2, condition int16slice2.ConditionFn) { aa.Partition(condition) },
+		func(aa int16slice2.Int16Slice2, condition int16slice2.ConditionFn) { aa.Remove(condition) },
+		func(aa int16slice2.Int16Slice2, condition int16slice2.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa int16slice2.Int16Slice2, condition int16slice2.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa int16slice2.Int16Slice2, condition int16slice2.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa int16slice2.Int16Slice2, condition int16slice2.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ []int16) bool {
+				return true
+			}
+			methodCall(int16slice2.Int16Slice2{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(int16slice2.Int16Slice2){
+		func(aa int16slice2.Int16Slice2) {
+			aa.Distinct(func(a, b []int16) bool { return true })
+		},
+		func(aa int16slice2.Int16Slice2) {
+			aa.Expand(func([]int16) [][]int16 { return nil })
+		},
+		func(aa int16slice2.Int16Slice2) {
+			aa.ForEach(func([]int16) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa int16slice2.Int16Slice2) {
+			aa.ForEachR(func([]int16) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa int16slice2.Int16Slice2) {
+			aa.Group(func([]int16) string { return "0" })
+		},
+		func(aa int16slice2.Int16Slice2) {
+			aa.GroupI(func(int64, []int16) string { return "0" })
+		},
+		func(aa int16slice2.Int16Slice2) {
+			aa.GroupByTrait(func(a, b []int16) bool { return true }, func(a, b []int16) bool { return true })
+		},
+		func(aa int16slice2.Int16Slice2) {
+			aa.Map(func([]int16) []int16 { return primitiveZero })
+		},
+		func(aa int16slice2.Int16Slice2) {
+			aa.Reduce(func(a, b []int16) []int16 { return primitiveZero })
+		},
+		func(aa int16slice2.Int16Slice2) {
+			aa.Sort(func(a, b []int16) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int16slice2.Int16Slice2{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(int16slice2.Int16Slice2, int16slice2.EqualityFn){
+		func(aa int16slice2.Int16Slice2, equality int16slice2.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa int16slice2.Int16Slice2, equality int16slice2.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa int16slice2.Int16Slice2, equality int16slice2.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa int16slice2.Int16Slice2, equality int16slice2.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa int16slice2.Int16Slice2, equality int16slice2.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa int16slice2.Int16Slice2, equality int16slice2.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b []int16) bool {
+				return false
+			}
+			methodCall(int16slice2.Int16Slice2{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(int16slice2.Int16Slice2, []int16, int16slice2.ConditionFn){
+		func(aa int16slice2.Int16Slice2, b []int16, condition int16slice2.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa int16slice2.Int16Slice2, b []int16, condition int16slice2.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func([]int16) bool {
+				return false
+			}
+			methodCall(int16slice2.Int16Slice2{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(int16slice2.Int16Slice2){
+		func(aa int16slice2.Int16Slice2) {
+			aa.ForEachC(0, func([]int16, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa int16slice2.Int16Slice2) {
+			aa.WindowCentered(0, func([][]int16) []int16 { return primitiveZero })
+		},
+		func(aa int16slice2.Int16Slice2) {
+			aa.WindowLeft(0, func([][]int16) []int16 { return primitiveZero })
+		},
+		func(aa int16slice2.Int16Slice2) {
+			aa.WindowRight(0, func([][]int16) []int16 { return primitiveZero })
+		},
+		func(aa int16slice2.Int16Slice2) {
+			aa.Fold(primitiveZero, func(a, b []int16) []int16 { return primitiveZero })
+		},
+		func(aa int16slice2.Int16Slice2) {
+			aa.FoldI(primitiveZero, func(i int64, a, b []int16) []int16 { return primitiveZero })
+		},
+		func(aa int16slice2.Int16Slice2) {
+			aa.Pairwise(primitiveZero, func(a, b []int16) []int16 { return primitiveZero })
+		},
+		func(aa int16slice2.Int16Slice2) {
+			aa.Collect([][]int16{}, func(a, b []int16) []int16 { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int16slice2.Int16Slice2{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(int16slice2.Int16Slice2){
+		func(aa int16slice2.Int16Slice2) { aa.InsertAt(primitiveZero, 0) },
+		func(aa int16slice2.Int16Slice2) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int16slice2.Int16Slice2{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package int64slice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/int64slice"
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(int64slice.Int64Slice){
+		func(aa int64slice.Int64Slice) { aa.Clear() },
+		func(aa int64slice.Int64Slice) { aa.Clone() },
+		func(aa int64slice.Int64Slice) { aa.Dequeue() },
+		func(aa int64slice.Int64Slice) { aa.Empty() },
+		func(aa int64slice.Int64Slice) { aa.End() },
+		func(aa int64slice.Int64Slice) { aa.Head() },
+		func(aa int64slice.Int64Slice) { aa.Len() },
+		func(aa int64slice.Int64Slice) { aa.Permutable() },
+		func(aa int64slice.Int64Slice) { aa.Permutations() },
+		func(aa int64slice.Int64Slice) { aa.Permute() },
+		func(aa int64slice.Int64Slice) { aa.Pop() },
+		func(aa int64slice.Int64Slice) { aa.Reverse() },
+		func(aa int64slice.Int64Slice) { _ = aa.String() },
+		func(aa int64slice.Int64Slice) { aa.Tail() },
+		func(aa int64slice.Int64Slice) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int64slice.Int64Slice{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(int64slice.Int64Slice){
+		func(aa int64slice.Int64Slice) { aa.Item(0) },
+		func(aa int64slice.Int64Slice) { aa.ItemFuzzy(0) },
+		func(aa int64slice.Int64Slice) { aa.RemoveAt(0) },
+		func(aa int64slice.Int64Slice) { aa.Skip(0) },
+		func(aa int64slice.Int64Slice) { aa.SplitAt(0) },
+		func(aa int64slice.Int64Slice) { aa.Take(0) },
+		func(aa int64slice.Int64Slice) { aa.Union(nil) },
+		func(aa int64slice.Int64Slice) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int64slice.Int64Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(int64slice.Int64Slice, int64){
+		func(aa int64slice.Int64Slice, b int64) { aa.Append(b) },
+		func(aa int64slice.Int64Slice, b int64) { aa.Enqueue(b) },
+		func(aa int64slice.Int64Slice, b int64) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int64slice.Int64Slice{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(int64slice.Int64Slice, int64slice.ConditionFn){
+		func(aa int64slice.Int64Slice, condition int64slice.ConditionFn) { aa.All(condition) },
+		func(aa int64slice.Int64Slice, condition int64slice.ConditionFn) { aa.Any(condition) },
+		func(aa int64slice.Int64Slice, condition int64slice.ConditionFn) { aa.Count(condition) },
+		func(aa int64slice.Int64Slice, condition int64slice.ConditionFn) { aa.Filter(condition) },
+		func(aa int64slice.Int64Slice, condition int64slice.ConditionFn) { aa.FindIndex(condition) },
+		func(aa int64slice.Int64Slice, condition int64slice.ConditionFn) { aa.First(condition) },
+		func(aa int64slice.Int64Slice, condition int64slice.ConditionFn) { aa.Last(condition) },
+		func(aa int64slice.Int64Slice, condition int64slice.ConditionFn) { aa.None(condition) },
+		func(aa int64slice.Int64Slice, condition int64slice.ConditionFn) { aa.Partition(condition) },
+		func(aa int64slice.Int64Slice, condition int64slice.ConditionFn) { aa.Remove(condition) },
+		func(aa int64slice.Int64Slice, condition int64slice.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa int64slice.Int64Slice, condition int64slice.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa int64slice.Int64Slice, condition int64slice.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa int64slice.Int64Slice, condition int64slice.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ int64) bool {
+				return true
+			}
+			methodCall(int64slice.Int64Slice{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(int64slice.Int64Slice){
+		func(aa int64slice.Int64Slice) {
+			aa.Distinct(func(a, b int64) bool { return true })
+		},
+		func(aa int64slice.Int64Slice) {
+			aa.Expand(func(int64) []int64 { return nil })
+		},
+		func(aa int64slice.Int64Slice) {
+			aa.ForEach(func(int64) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa int64slice.Int64Slice) {
+			aa.ForEachR(func(int64) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa int64slice.Int64Slice) {
+			aa.Group(func(int64) string { return "0" })
+		},
+		func(aa int64slice.Int64Slice) {
+			aa.GroupI(func(int64, int64) string { return "0" })
+		},
+		func(aa int64slice.Int64Slice) {
+			aa.GroupByTrait(func(a, b int64) bool { return true }, func(a, b int64) bool { return true })
+		},
+		func(aa int64slice.Int64Slice) {
+			aa.Map(func(int64) int64 { return primitiveZero })
+		},
+		func(aa int64slice.Int64Slice) {
+			aa.Reduce(func(a, b int64) int64 { return primitiveZero })
+		},
+		func(aa int64slice.Int64Slice) {
+			aa.Sort(func(a, b int64) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int64slice.Int64Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(int64slice.Int64Slice, int64slice.EqualityFn){
+		func(aa int64slice.Int64Slice, equality int64slice.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa int64slice.Int64Slice, equality int64slice.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa int64slice.Int64Slice, equality int64slice.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa int64slice.Int64Slice, equality int64slice.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa int64slice.Int64Slice, equality int64slice.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa int64slice.Int64Slice, equality int64slice.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b int64) bool {
+				return false
+			}
+			methodCall(int64slice.Int64Slice{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(int64slice.Int64Slice, int64, int64slice.ConditionFn){
+		func(aa int64slice.Int64Slice, b int64, condition int64slice.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa int64slice.Int64Slice, b int64, condition int64slice.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(int64) bool {
+				return false
+			}
+			methodCall(int64slice.Int64Slice{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(int64slice.Int64Slice){
+		func(aa int64slice.Int64Slice) {
+			aa.ForEachC(0, func(int64, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa int64slice.Int64Slice) {
+			aa.WindowCentered(0, func([]int64) int64 { return primitiveZero })
+		},
+		func(aa int64slice.Int64Slice) {
+			aa.WindowLeft(0, func([]int64) int64 { return primitiveZero })
+		},
+		func(aa int64slice.Int64Slice) {
+			aa.WindowRight(0, func([]int64) int64 { return primitiveZero })
+		},
+		func(aa int64slice.Int64Slice) {
+			aa.Fold(primitiveZero, func(a, b int64) int64 { return primitiveZero })
+		},
+		func(aa int64slice.Int64Slice) {
+			aa.FoldI(primitiveZero, func(i int64, a, b int64) int64 { return primitiveZero })
+		},
+		func(aa int64slice.Int64Slice) {
+			aa.Pairwise(primitiveZero, func(a, b int64) int64 { return primitiveZero })
+		},
+		func(aa int64slice.Int64Slice) {
+			aa.Collect([]int64{}, func(a, b int64) int64 { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int64slice.Int64Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(int64slice.Int64Slice){
+		func(aa int64slice.Int64Slice) { aa.InsertAt(primitiveZero, 0) },
+		func(aa int64slice.Int64Slice) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int64slice.Int64Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
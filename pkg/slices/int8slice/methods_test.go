@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package int8slice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/int8slice"
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(int8slice.Int8Slice){
+		func(aa int8slice.Int8Slice) { aa.Clear() },
+		func(aa int8slice.Int8Slice) { aa.Clone() },
+		func(aa int8slice.Int8Slice) { aa.Dequeue() },
+		func(aa int8slice.Int8Slice) { aa.Empty() },
+		func(aa int8slice.Int8Slice) { aa.End() },
+		func(aa int8slice.Int8Slice) { aa.Head() },
+		func(aa int8slice.Int8Slice) { aa.Len() },
+		func(aa int8slice.Int8Slice) { aa.Permutable() },
+		func(aa int8slice.Int8Slice) { aa.Permutations() },
+		func(aa int8slice.Int8Slice) { aa.Permute() },
+		func(aa int8slice.Int8Slice) { aa.Pop() },
+		func(aa int8slice.Int8Slice) { aa.Reverse() },
+		func(aa int8slice.Int8Slice) { _ = aa.String() },
+		func(aa int8slice.Int8Slice) { aa.Tail() },
+		func(aa int8slice.Int8Slice) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int8slice.Int8Slice{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(int8slice.Int8Slice){
+		func(aa int8slice.Int8Slice) { aa.Item(0) },
+		func(aa int8slice.Int8Slice) { aa.ItemFuzzy(0) },
+		func(aa int8slice.Int8Slice) { aa.RemoveAt(0) },
+		func(aa int8slice.Int8Slice) { aa.Skip(0) },
+		func(aa int8slice.Int8Slice) { aa.SplitAt(0) },
+		func(aa int8slice.Int8Slice) { aa.Take(0) },
+		func(aa int8slice.Int8Slice) { aa.Union(nil) },
+		func(aa int8slice.Int8Slice) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int8slice.Int8Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(int8slice.Int8Slice, int8){
+		func(aa int8slice.Int8Slice, b int8) { aa.Append(b) },
+		func(aa int8slice.Int8Slice, b int8) { aa.Enqueue(b) },
+		func(aa int8slice.Int8Slice, b int8) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int8slice.Int8Slice{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(int8slice.Int8Slice, int8slice.ConditionFn){
+		func(aa int8slice.Int8Slice, condition int8slice.ConditionFn) { aa.All(condition) },
+		func(aa int8slice.Int8Slice, condition int8slice.ConditionFn) { aa.Any(condition) },
+		func(aa int8slice.Int8Slice, condition int8slice.ConditionFn) { aa.Count(condition) },
+		func(aa int8slice.Int8Slice, condition int8slice.ConditionFn) { aa.Filter(condition) },
+		func(aa int8slice.Int8Slice, condition int8slice.ConditionFn) { aa.FindIndex(condition) },
+		func(aa int8slice.Int8Slice, condition int8slice.ConditionFn) { aa.First(condition) },
+		func(aa int8slice.Int8Slice, condition int8slice.ConditionFn) { aa.Last(condition) },
+		func(aa int8slice.Int8Slice, condition int8slice.ConditionFn) { aa.None(condition) },
+		func(aa int8slice.Int8Slice, condition int8slice.ConditionFn) { aa.Partition(condition) },
+		func(aa int8slice.Int8Slice, condition int8slice.ConditionFn) { aa.Remove(condition) },
+		func(aa int8slice.Int8Slice, condition int8slice.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa int8slice.Int8Slice, condition int8slice.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa int8slice.Int8Slice, condition int8slice.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa int8slice.Int8Slice, condition int8slice.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ int8) bool {
+				return true
+			}
+			methodCall(int8slice.Int8Slice{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(int8slice.Int8Slice){
+		func(aa int8slice.Int8Slice) {
+			aa.Distinct(func(a, b int8) bool { return true })
+		},
+		func(aa int8slice.Int8Slice) {
+			aa.Expand(func(int8) []int8 { return nil })
+		},
+		func(aa int8slice.Int8Slice) {
+			aa.ForEach(func(int8) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa int8slice.Int8Slice) {
+			aa.ForEachR(func(int8) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa int8slice.Int8Slice) {
+			aa.Group(func(int8) string { return "0" })
+		},
+		func(aa int8slice.Int8Slice) {
+			aa.GroupI(func(int64, int8) string { return "0" })
+		},
+		func(aa int8slice.Int8Slice) {
+			aa.GroupByTrait(func(a, b int8) bool { return true }, func(a, b int8) bool { return true })
+		},
+		func(aa int8slice.Int8Slice) {
+			aa.Map(func(int8) int8 { return primitiveZero })
+		},
+		func(aa int8slice.Int8Slice) {
+			aa.Reduce(func(a, b int8) int8 { return primitiveZero })
+		},
+		func(aa int8slice.Int8Slice) {
+			aa.Sort(func(a, b int8) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int8slice.Int8Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(int8slice.Int8Slice, int8slice.EqualityFn){
+		func(aa int8slice.Int8Slice, equality int8slice.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa int8slice.Int8Slice, equality int8slice.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa int8slice.Int8Slice, equality int8slice.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa int8slice.Int8Slice, equality int8slice.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa int8slice.Int8Slice, equality int8slice.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa int8slice.Int8Slice, equality int8slice.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b int8) bool {
+				return false
+			}
+			methodCall(int8slice.Int8Slice{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(int8slice.Int8Slice, int8, int8slice.ConditionFn){
+		func(aa int8slice.Int8Slice, b int8, condition int8slice.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa int8slice.Int8Slice, b int8, condition int8slice.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(int8) bool {
+				return false
+			}
+			methodCall(int8slice.Int8Slice{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(int8slice.Int8Slice){
+		func(aa int8slice.Int8Slice) {
+			aa.ForEachC(0, func(int8, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa int8slice.Int8Slice) {
+			aa.WindowCentered(0, func([]int8) int8 { return primitiveZero })
+		},
+		func(aa int8slice.Int8Slice) {
+			aa.WindowLeft(0, func([]int8) int8 { return primitiveZero })
+		},
+		func(aa int8slice.Int8Slice) {
+			aa.WindowRight(0, func([]int8) int8 { return primitiveZero })
+		},
+		func(aa int8slice.Int8Slice) {
+			aa.Fold(primitiveZero, func(a, b int8) int8 { return primitiveZero })
+		},
+		func(aa int8slice.Int8Slice) {
+			aa.FoldI(primitiveZero, func(i int64, a, b int8) int8 { return primitiveZero })
+		},
+		func(aa int8slice.Int8Slice) {
+			aa.Pairwise(primitiveZero, func(a, b int8) int8 { return primitiveZero })
+		},
+		func(aa int8slice.Int8Slice) {
+			aa.Collect([]int8{}, func(a, b int8) int8 { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int8slice.Int8Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(int8slice.Int8Slice){
+		func(aa int8slice.Int8Slice) { aa.InsertAt(primitiveZero, 0) },
+		func(aa int8slice.Int8Slice) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(int8slice.Int8Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package intslice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/intslice"
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(intslice.IntSlice){
+		func(aa intslice.IntSlice) { aa.Clear() },
+		func(aa intslice.IntSlice) { aa.Clone() },
+		func(aa intslice.IntSlice) { aa.Dequeue() },
+		func(aa intslice.IntSlice) { aa.Empty() },
+		func(aa intslice.IntSlice) { aa.End() },
+		func(aa intslice.IntSlice) { aa.Head() },
+		func(aa intslice.IntSlice) { aa.Len() },
+		func(aa intslice.IntSlice) { aa.Permutable() },
+		func(aa intslice.IntSlice) { aa.Permutations() },
+		func(aa intslice.IntSlice) { aa.Permute() },
+		func(aa intslice.IntSlice) { aa.Pop() },
+		func(aa intslice.IntSlice) { aa.Reverse() },
+		func(aa intslice.IntSlice) { _ = aa.String() },
+		func(aa intslice.IntSlice) { aa.Tail() },
+		func(aa intslice.IntSlice) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(intslice.IntSlice{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(intslice.IntSlice){
+		func(aa intslice.IntSlice) { aa.Item(0) },
+		func(aa intslice.IntSlice) { aa.ItemFuzzy(0) },
+		func(aa intslice.IntSlice) { aa.RemoveAt(0) },
+		func(aa intslice.IntSlice) { aa.Skip(0) },
+		func(aa intslice.IntSlice) { aa.SplitAt(0) },
+		func(aa intslice.IntSlice) { aa.Take(0) },
+		func(aa intslice.IntSlice) { aa.Union(nil) },
+		func(aa intslice.IntSlice) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(intslice.IntSlice{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(intslice.IntSlice, int){
+		func(aa intslice.IntSlice, b int) { aa.Append(b) },
+		func(aa intslice.IntSlice, b int) { aa.Enqueue(b) },
+		func(aa intslice.IntSlice, b int) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(intslice.IntSlice{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(intslice.IntSlice, intslice.ConditionFn){
+		func(aa intslice.IntSlice, condition intslice.ConditionFn) { aa.All(condition) },
+		func(aa intslice.IntSlice, condition intslice.ConditionFn) { aa.Any(condition) },
+		func(aa intslice.IntSlice, condition intslice.ConditionFn) { aa.Count(condition) },
+		func(aa intslice.IntSlice, condition intslice.ConditionFn) { aa.Filter(condition) },
+		func(aa intslice.IntSlice, condition intslice.ConditionFn) { aa.FindIndex(condition) },
+		func(aa intslice.IntSlice, condition intslice.ConditionFn) { aa.First(condition) },
+		func(aa intslice.IntSlice, condition intslice.ConditionFn) { aa.Last(condition) },
+		func(aa intslice.IntSlice, condition intslice.ConditionFn) { aa.None(condition) },
+		func(aa intslice.IntSlice, condition intslice.ConditionFn) { aa.Partition(condition) },
+		func(aa intslice.IntSlice, condition intslice.ConditionFn) { aa.Remove(condition) },
+		func(aa intslice.IntSlice, condition intslice.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa intslice.IntSlice, condition intslice.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa intslice.IntSlice, condition intslice.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa intslice.IntSlice, condition intslice.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ int) bool {
+				return true
+			}
+			methodCall(intslice.IntSlice{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(intslice.IntSlice){
+		func(aa intslice.IntSlice) {
+			aa.Distinct(func(a, b int) bool { return true })
+		},
+		func(aa intslice.IntSlice) {
+			aa.Expand(func(int) []int { return nil })
+		},
+		func(aa intslice.IntSlice) {
+			aa.ForEach(func(int) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa intslice.IntSlice) {
+			aa.ForEachR(func(int) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa intslice.IntSlice) {
+			aa.Group(func(int) string { return "0" })
+		},
+		func(aa intslice.IntSlice) {
+			aa.GroupI(func(int64, int) string { return "0" })
+		},
+		func(aa intslice.IntSlice) {
+			aa.GroupByTrait(func(a, b int) bool { return true }, func(a, b int) bool { return true })
+		},
+		func(aa intslice.IntSlice) {
+			aa.Map(func(int) int { return primitiveZero })
+		},
+		func(aa intslice.IntSlice) {
+			aa.Reduce(func(a, b int) int { return primitiveZero })
+		},
+		func(aa intslice.IntSlice) {
+			aa.Sort(func(a, b int) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(intslice.IntSlice{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(intslice.IntSlice, intslice.EqualityFn){
+		func(aa intslice.IntSlice, equality intslice.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa intslice.IntSlice, equality intslice.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa intslice.IntSlice, equality intslice.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa intslice.IntSlice, equality intslice.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa intslice.IntSlice, equality intslice.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa intslice.IntSlice, equality intslice.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b int) bool {
+				return false
+			}
+			methodCall(intslice.IntSlice{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(intslice.IntSlice, int, intslice.ConditionFn){
+		func(aa intslice.IntSlice, b int, condition intslice.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa intslice.IntSlice, b int, condition intslice.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(int) bool {
+				return false
+			}
+			methodCall(intslice.IntSlice{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(intslice.IntSlice){
+		func(aa intslice.IntSlice) {
+			aa.ForEachC(0, func(int, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa intslice.IntSlice) {
+			aa.WindowCentered(0, func([]int) int { return primitiveZero })
+		},
+		func(aa intslice.IntSlice) {
+			aa.WindowLeft(0, func([]int) int { return primitiveZero })
+		},
+		func(aa intslice.IntSlice) {
+			aa.WindowRight(0, func([]int) int { return primitiveZero })
+		},
+		func(aa intslice.IntSlice) {
+			aa.Fold(primitiveZero, func(a, b int) int { return primitiveZero })
+		},
+		func(aa intslice.IntSlice) {
+			aa.FoldI(primitiveZero, func(i int64, a, b int) int { return primitiveZero })
+		},
+		func(aa intslice.IntSlice) {
+			aa.Pairwise(primitiveZero, func(a, b int) int { return primitiveZero })
+		},
+		func(aa intslice.IntSlice) {
+			aa.Collect([]int{}, func(a, b int) int { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(intslice.IntSlice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(intslice.IntSlice){
+		func(aa intslice.IntSlice) { aa.InsertAt(primitiveZero, 0) },
+		func(aa intslice.IntSlice) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(intslice.IntSlice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package intslice2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/intslice2"
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(intslice2.IntSlice2){
+		func(aa intslice2.IntSlice2) { aa.Clear() },
+		func(aa intslice2.IntSlice2) { aa.Clone() },
+		func(aa intslice2.IntSlice2) { aa.Dequeue() },
+		func(aa intslice2.IntSlice2) { aa.Empty() },
+		func(aa intslice2.IntSlice2) { aa.End() },
+		func(aa intslice2.IntSlice2) { aa.Head() },
+		func(aa intslice2.IntSlice2) { aa.Len() },
+		func(aa intslice2.IntSlice2) { aa.Permutable() },
+		func(aa intslice2.IntSlice2) { aa.Permutations() },
+		func(aa intslice2.IntSlice2) { aa.Permute() },
+		func(aa intslice2.IntSlice2) { aa.Pop() },
+		func(aa intslice2.IntSlice2) { aa.Reverse() },
+		func(aa intslice2.IntSlice2) { _ = aa.String() },
+		func(aa intslice2.IntSlice2) { aa.Tail() },
+		func(aa intslice2.IntSlice2) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(intslice2.IntSlice2{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(intslice2.IntSlice2){
+		func(aa intslice2.IntSlice2) { aa.Item(0) },
+		func(aa intslice2.IntSlice2) { aa.ItemFuzzy(0) },
+		func(aa intslice2.IntSlice2) { aa.RemoveAt(0) },
+		func(aa intslice2.IntSlice2) { aa.Skip(0) },
+		func(aa intslice2.IntSlice2) { aa.SplitAt(0) },
+		func(aa intslice2.IntSlice2) { aa.Take(0) },
+		func(aa intslice2.IntSlice2) { aa.Union(nil) },
+		func(aa intslice2.IntSlice2) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(intslice2.IntSlice2{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(intslice2.IntSlice2, []int){
+		func(aa intslice2.IntSlice2, b []int) { aa.Append(b) },
+		func(aa intslice2.IntSlice2, b []int) { aa.Enqueue(b) },
+		func(aa intslice2.IntSlice2, b []int) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(intslice2.IntSlice2{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(intslice2.IntSlice2, intslice2.ConditionFn){
+		func(aa intslice2.IntSlice2, condition intslice2.ConditionFn) { aa.All(condition) },
+		func(aa intslice2.IntSlice2, condition intslice2.ConditionFn) { aa.Any(condition) },
+		func(aa intslice2.IntSlice2, condition intslice2.ConditionFn) { aa.Count(condition) },
+		func(aa intslice2.IntSlice2, condition intslice2.ConditionFn) { aa.Filter(condition) },
+		func(aa intslice2.IntSlice2, condition intslice2.ConditionFn) { aa.FindIndex(condition) },
+		func(aa intslice2.IntSlice2, condition intslice2.ConditionFn) { aa.First(condition) },
+		func(aa intslice2.IntSlice2, condition intslice2.ConditionFn) { aa.Last(condition) },
+		func(aa intslice2.IntSlice2, condition intslice2.ConditionFn) { aa.None(condition) },
+		func(aa intslice2.IntSlice2, condition intslice2.ConditionFn) { aa.Partition(condition) },
+		func(aa intslice2.IntSlice2, condition intslice2.ConditionFn) { aa.Remove(condition) },
+		func(aa intslice2.IntSlice2, condition intslice2.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa intslice2.IntSlice2, condition intslice2.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa intslice2.IntSlice2, condition intslice2.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa intslice2.IntSlice2, condition intslice2.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ []int) bool {
+				return true
+			}
+			methodCall(intslice2.IntSlice2{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(intslice2.IntSlice2){
+		func(aa intslice2.IntSlice2) {
+			aa.Distinct(func(a, b []int) bool { return true })
+		},
+		func(aa intslice2.IntSlice2) {
+			aa.Expand(func([]int) [][]int { return nil })
+		},
+		func(aa intslice2.IntSlice2) {
+			aa.ForEach(func([]int) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa intslice2.IntSlice2) {
+			aa.ForEachR(func([]int) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa intslice2.IntSlice2) {
+			aa.Group(func([]int) string { return "0" })
+		},
+		func(aa intslice2.IntSlice2) {
+			aa.GroupI(func(int64, []int) string { return "0" })
+		},
+		func(aa intslice2.IntSlice2) {
+			aa.GroupByTrait(func(a, b []int) bool { return true }, func(a, b []int) bool { return true })
+		},
+		func(aa intslice2.IntSlice2) {
+			aa.Map(func([]int) []int { return primitiveZero })
+		},
+		func(aa intslice2.IntSlice2) {
+			aa.Reduce(func(a, b []int) []int { return primitiveZero })
+		},
+		func(aa intslice2.IntSlice2) {
+			aa.Sort(func(a, b []int) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(intslice2.IntSlice2{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(intslice2.IntSlice2, intslice2.EqualityFn){
+		func(aa intslice2.IntSlice2, equality intslice2.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa intslice2.IntSlice2, equality intslice2.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa intslice2.IntSlice2, equality intslice2.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa intslice2.IntSlice2, equality intslice2.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa intslice2.IntSlice2, equality intslice2.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa intslice2.IntSlice2, equality intslice2.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b []int) bool {
+				return false
+			}
+			methodCall(intslice2.IntSlice2{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(intslice2.IntSlice2, []int, intslice2.ConditionFn){
+		func(aa intslice2.IntSlice2, b []int, condition intslice2.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa intslice2.IntSlice2, b []int, condition intslice2.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func([]int) bool {
+				return false
+			}
+			methodCall(intslice2.IntSlice2{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(intslice2.IntSlice2){
+		func(aa intslice2.IntSlice2) {
+			aa.ForEachC(0, func([]int, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa intslice2.IntSlice2) {
+			aa.WindowCentered(0, func([][]int) []int { return primitiveZero })
+		},
+		func(aa intslice2.IntSlice2) {
+			aa.WindowLeft(0, func([][]int) []int { return primitiveZero })
+		},
+		func(aa intslice2.IntSlice2) {
+			aa.WindowRight(0, func([][]int) []int { return primitiveZero })
+		},
+		func(aa intslice2.IntSlice2) {
+			aa.Fold(primitiveZero, func(a, b []int) []int { return primitiveZero })
+		},
+		func(aa intslice2.IntSlice2) {
+			aa.FoldI(primitiveZero, func(i int64, a, b []int) []int { return primitiveZero })
+		},
+		func(aa intslice2.IntSlice2) {
+			aa.Pairwise(primitiveZero, func(a, b []int) []int { return primitiveZero })
+		},
+		func(aa intslice2.IntSlice2) {
+			aa.Collect([][]int{}, func(a, b []int) []int { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(intslice2.IntSlice2{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(intslice2.IntSlice2){
+		func(aa intslice2.IntSlice2) { aa.InsertAt(primitiveZero, 0) },
+		func(aa intslice2.IntSlice2) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(intslice2.IntSlice2{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
@@ -0,0 +1,7 @@
+package parametric_test
+
+// Constants used by tests
+
+const (
+	primitiveZero = 0
+)
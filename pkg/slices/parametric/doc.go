@@ -0,0 +1,34 @@
+// Package parametric contains transform functions for slices of any element
+// type, expressed with Go type parameters.
+//
+// Package parametric mirrors the catalog provided by package generic, but
+// rather than operating on []interface{}, each function is parameterized by
+// the element type of the slice(s) it operates on. As a result, call sites do
+// not need to box values, nor type-assert within their closures. For example,
+// where package generic would require
+//
+//   generic.Filter(&aa, func(a interface{}) bool { return a.(int) < 5 })
+//
+// package parametric allows
+//
+//   parametric.Filter(&aa, func(a int) bool { return a < 5 })
+//
+// Transforms that can change the type of the elements (such as Map, Fold,
+// Collect, and the Window functions) accept additional type parameters for the
+// resulting element type. Transforms that produce groups of elements (such as
+// Group, Partition, Permute, and the Split functions) return a [][]T rather
+// than a slice of boxed slices.
+//
+// The naming, parameter, mutability, null result handling, ordinality, and
+// equality conventions documented for package generic apply equally to this
+// package. In particular, transforms that mutate the supplied sources will
+// always require the slice as a pointer, while functions that require slices
+// be passed as values can be expected to be immutable.
+//
+// Slice is provided as a convenience type, which exposes the same chainable
+// method set as generic.SliceType. Because Go does not permit methods to
+// declare their own type parameters, the Slice methods that transform element
+// types (such as Map and Fold) are restricted to producing the same element
+// type as the receiver. Use the package functions when a different resulting
+// type is needed.
+package parametric
@@ -0,0 +1,30 @@
+package parametric_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ideoterra/transforms/pkg/slices/parametric"
+)
+
+func Example_mapReduce() {
+	sentance := "it was the best of times, it was the worst of times"
+
+	words := []string{}
+	for _, word := range strings.Split(sentance, " ") {
+		parametric.Append(&words, word)
+	}
+
+	wordLength := func(word string) int {
+		return len(word)
+	}
+
+	wordLengths := parametric.Map(words, wordLength)
+
+	totalLength := parametric.Reduce(wordLengths, func(a, acc int) int {
+		return a + acc
+	})
+
+	fmt.Println(totalLength)
+	//Output:[40]
+}
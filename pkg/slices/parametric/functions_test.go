@@ -0,0 +1,2290 @@
+package parametric_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ideoterra/transforms/pkg/slices/parametric"
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+type Behavior struct {
+	Description string
+	Expectation func(t *testing.T)
+}
+
+type Specification struct {
+	FunctionName    string
+	StandardPath    Behavior
+	AlternativePath Behavior
+	EdgeCases       []Behavior
+}
+
+var Specifications = []Specification{
+	Specification{
+		FunctionName: "All",
+		StandardPath: Behavior{
+			Description: "Returns true if all elements pass test.",
+			Expectation: func(t *testing.T) {
+				s := []int{1, 2, 3, 4}
+				test := func(p int) bool {
+					return p < 5
+				}
+				assert.True(t, parametric.All(s, test))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Returns false if not all elements pass test.",
+			Expectation: func(t *testing.T) {
+				s := []int{1, 2, 3, 4, 5}
+				test := func(p int) bool {
+					return p < 5
+				}
+				assert.False(t, parametric.All(s, test))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Any",
+		StandardPath: Behavior{
+			Description: "Returns true if any of the elements match.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				test := func(a int) bool {
+					return a == 2
+				}
+				assert.True(t, parametric.Any(aa, test))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Returns false if none of the elements match.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				test := func(a int) bool {
+					return a == 5
+				}
+				assert.False(t, parametric.Any(aa, test))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Append",
+		StandardPath: Behavior{
+			Description: "Values are added to the end of the slice.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				bb := []int{5, 6, 7, 8}
+				parametric.Append(&aa, bb...)
+				assertSlicesEqual(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, aa)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "No values supplied makes no change.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				bb := []int{}
+				parametric.Append(&aa, bb...)
+				assertSlicesEqual(t, []int{1, 2, 3, 4}, aa)
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "Nil passed as aa appends bb",
+				Expectation: func(t *testing.T) {
+					var aa []int
+					bb := []int{5, 6, 7, 8}
+					parametric.Append(&aa, bb...)
+					assertSlicesEqual(t, []int{5, 6, 7, 8}, aa)
+				},
+			},
+		},
+	},
+
+	Specification{
+		FunctionName: "Clear",
+		StandardPath: Behavior{
+			Description: "The slice is set to nil",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				parametric.Clear(&aa)
+				assert.Nil(t, aa)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "An already nil slice can be cleared.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				parametric.Clear(&aa)
+				parametric.Append(&aa, 6, 7, 8)
+				assertSlicesEqual(t, []int{6, 7, 8}, aa)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Clone",
+		StandardPath: Behavior{
+			Description: "A new identical slice is allocated in memory.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				bb := parametric.Clone(aa)
+				if &aa == &bb {
+					t.Error("Slices aa and bb should not have the same address")
+				}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Slices are not deep cloned in this operation",
+			Expectation: func(t *testing.T) {
+				value := 1
+				aa := []*int{&value}
+				bb := parametric.Clone(aa)
+				a := aa[0]
+				b := bb[0]
+				if a != b {
+					t.Error("Expected aa[0] and bb[0] to have the same address")
+				}
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Collect",
+		StandardPath: Behavior{
+			Description: "Values are concatenated as expected.",
+			Expectation: func(t *testing.T) {
+				aa := []string{"A", "B"}
+				bb := []string{"Y", "Z"}
+				collector := func(a, b string) string {
+					return a + b
+				}
+				cc := parametric.Collect(aa, bb, collector)
+				dd := []string{"AY", "AZ", "BY", "BZ"}
+				assertSlicesEqual(t, cc, dd)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				t.Skip()
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Count",
+		StandardPath: Behavior{
+			Description: "Returns the correct count",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4, 5}
+				test := func(a int) bool {
+					return a%2 == 0
+				}
+				assert.Equal(t, int64(2), parametric.Count(aa, test))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Returns 0 if no matches",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4, 5}
+				test := func(a int) bool {
+					return a == 6
+				}
+				assert.Equal(t, int64(0), parametric.Count(aa, test))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Dequeue",
+		StandardPath: Behavior{
+			Description: "Removes and returns head.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3}
+				bb := parametric.Dequeue(&aa)
+				assert.Equal(t, 1, bb[0])
+				cc := []int{2, 3}
+				assertSlicesEqual(t, aa, cc)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "If source slice is empty, empty slice is returned.",
+			Expectation: func(t *testing.T) {
+				aa := []int{}
+				bb := parametric.Dequeue(&aa)
+				if len(bb) != 0 {
+					t.Error("Expected bb to be empty.")
+				}
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Difference",
+		StandardPath: Behavior{
+			Description: "Returns the difference between two slices",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				bb := []int{5, 4, 3}
+				equality := func(a, b int) bool {
+					return a == b
+				}
+				cc := parametric.Difference(aa, bb, equality)
+				dd := []int{1, 2, 5}
+				assertSlicesEqual(t, cc, dd)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: `Duplicates are handled.
+						  Those from aa appear first in the result. bb appear
+						  second. Order and duplicates should be maintained.`,
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 3, 1, 4}
+				bb := []int{5, 4, 3, 5}
+				equality := func(a, b int) bool {
+					return a == b
+				}
+				cc := parametric.Difference(aa, bb, equality)
+				dd := []int{1, 2, 1, 5, 5}
+				assertSlicesEqual(t, cc, dd)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Distinct",
+		StandardPath: Behavior{
+			Description: "Duplicates are removed from the slice, mutating the original",
+			Expectation: func(t *testing.T) {
+				aa := []string{"Dani", "Riley", "Dani", "Tori", "Janice"}
+				equality := func(a, b string) bool {
+					return a == b
+				}
+				parametric.Distinct(&aa, equality)
+				bb := []string{"Dani", "Riley", "Tori", "Janice"}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				t.Skip()
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Empty",
+		StandardPath: Behavior{
+			Description: "Returns true if slice is empty",
+			Expectation: func(t *testing.T) {
+				aa := []int{}
+				assert.True(t, parametric.Empty(aa))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Returns false if slice is not empty",
+			Expectation: func(t *testing.T) {
+				aa := []int{1}
+				assert.False(t, parametric.Empty(aa))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "End",
+		StandardPath: Behavior{
+			Description: "Returns a slice with the last element.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3}
+				bb := parametric.End(aa)
+				assert.Equal(t, 3, bb[0])
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "An empty slice returns an empty slice.",
+			Expectation: func(t *testing.T) {
+				aa := []int{}
+				bb := parametric.End(aa)
+				assert.True(t, parametric.Empty(bb))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Enqueue",
+		StandardPath: Behavior{
+			Description: "The value is added to the head of the slice.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3}
+				parametric.Enqueue(&aa, 4)
+				bb := []int{4, 1, 2, 3}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				t.Skip()
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Expand",
+		StandardPath: Behavior{
+			Description: "Expands the supplied list according to the expansion",
+			Expectation: func(t *testing.T) {
+				aa := []string{"AB", "CD", "EF"}
+				expansion := func(a string) []string {
+					b := string(a[0])
+					c := string(a[1])
+					return []string{b, c}
+				}
+				bb := parametric.Expand(aa, expansion)
+				cc := []string{"A", "B", "C", "D", "E", "F"}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				t.Skip()
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Filter",
+		StandardPath: Behavior{
+			Description: "Items are removed for which the test function returns true.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				test := func(a int) bool {
+					return a%2 == 0
+				}
+				parametric.Filter(&aa, test)
+				bb := []int{1, 3}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				t.Skip()
+			},
+		},
+	},
+	Specification{
+		FunctionName: "FindIndex",
+		StandardPath: Behavior{
+			Description: "The first matching element is returned.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				test := func(a int) bool {
+					return a >= 3
+				}
+				n := parametric.FindIndex(aa, test)
+				assert.Equal(t, int64(2), n)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "If no match is found, -1 is returned.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				test := func(a int) bool {
+					return a >= 5
+				}
+				n := parametric.FindIndex(aa, test)
+				assert.Equal(t, int64(-1), n)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "First",
+		StandardPath: Behavior{
+			Description: "The first matching element is returned.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				test := func(a int) bool {
+					return a >= 2
+				}
+				bb := parametric.First(aa, test)
+				cc := []int{2}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "An empty slice is returned if there are no matches.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				test := func(a int) bool {
+					return a >= 5
+				}
+				bb := parametric.First(aa, test)
+				cc := []int{}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Flatten",
+		StandardPath: Behavior{
+			Description: "The elements of each slice are appended to a single slice.",
+			Expectation: func(t *testing.T) {
+				aa := [][]int{{1, 2}, {3}, {4, 5}}
+				bb := parametric.Flatten(aa)
+				cc := []int{1, 2, 3, 4, 5}
+				assert.Equal(t, cc, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Flattening an empty slice returns an empty slice.",
+			Expectation: func(t *testing.T) {
+				aa := [][]int{}
+				bb := parametric.Flatten(aa)
+				assert.Empty(t, bb)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Fold",
+		StandardPath: Behavior{
+			Description: "Fold accumulates values properly",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				folder := func(a, acc int) int {
+					return a + acc
+				}
+				bb := parametric.Fold(aa, 1, folder)
+				assert.Equal(t, 11, bb[0])
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				t.Skip()
+			},
+		},
+	},
+	Specification{
+		FunctionName: "FoldI",
+		StandardPath: Behavior{
+			Description: "FoldI accumulates values properly",
+			Expectation: func(t *testing.T) {
+				aa := []string{"A", "B", "C"}
+				folder := func(i int64, a, acc string) string {
+					return fmt.Sprintf("%v%v%v",
+						acc,
+						strconv.Itoa(int(i)),
+						a)
+				}
+				bb := parametric.FoldI(aa, "X", folder)
+				assert.Equal(t, "X0A1B2C", bb[0])
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				t.Skip()
+			},
+		},
+	},
+	Specification{
+		FunctionName: "ForEach",
+		StandardPath: Behavior{
+			Description: "Each element of the list is applied to the function",
+			Expectation: func(t *testing.T) {
+				aa := []string{"A", "B", "C"}
+				result := ""
+				fn := func(a string) shared.Continue {
+					result = result + a
+					return shared.ContinueYes
+				}
+				parametric.ForEach(aa, fn)
+				assert.Equal(t, "ABC", result)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "The iterator stops if false is returned.",
+			Expectation: func(t *testing.T) {
+				aa := []string{"A", "B", "C"}
+				result := ""
+				fn := func(a string) shared.Continue {
+					result = result + a
+					return a != "B"
+				}
+				parametric.ForEach(aa, fn)
+				assert.Equal(t, "AB", result)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "ForEachC",
+		StandardPath: Behavior{
+			Description: "Each element of the list is applied to the function",
+			Expectation: func(t *testing.T) {
+				aa := []string{"A", "B", "C"}
+				mu := new(sync.Mutex)
+				result := ""
+				fn := func(a string, cancelPending func() bool) shared.Continue {
+					mu.Lock()
+					defer mu.Unlock()
+					result = result + a
+					return shared.ContinueYes
+				}
+				parametric.ForEachC(aa, 1, fn)
+				bb := []string{"ABC", "BAC", "CAB", "ACB", "BCA", "CBA"}
+				if !parametric.Any(bb, func(b string) bool {
+					return b == result
+				}) {
+					t.Errorf("Expected a variant of 'ABC', but got '%v'", result)
+				}
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "The function panic if a negative pool size is specified.",
+			Expectation: func(t *testing.T) {
+				aa := []string{"A", "B", "C"}
+				mu := new(sync.Mutex)
+				result := ""
+				fn := func(a string, cancelPending func() bool) shared.Continue {
+					mu.Lock()
+					defer mu.Unlock()
+					result = result + a
+					return shared.ContinueYes
+				}
+				assert.PanicsWithValue(t,
+					"ForEachC: The concurrency pool size (c) must be non-negative.",
+					func() { parametric.ForEachC(aa, -1, fn) })
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: `Long running operations wind down when a 
+							  cancellation is broadcast`,
+				Expectation: func(t *testing.T) {
+					// Elements "A" and "B" will spawn infinitely loops, which
+					// check for pending cancellation on each iteration.
+					// Element "C" will spawn an operation that will wait until
+					// "A" and "B" are both running, at which point "C" will
+					// cancel further iterations.
+					// "A" and "B" will then identify the cancellation, and
+					// will halt.
+					//
+					// If this test is failing, it should time out. This can
+					// be verified by removing the "halt = true" assignment and
+					// manually confirming that the test would time out in that
+					// case.
+					aa := []string{"A", "B", "C"}
+					mu := new(sync.RWMutex)
+					aIsRunning := false
+					bIsRunning := false
+					fn := func(a string, cancelPending func() bool) shared.Continue {
+						if a == "A" || a == "B" {
+							mu.Lock()
+							if a == "A" {
+								aIsRunning = true
+							} else {
+								bIsRunning = true
+							}
+							mu.Unlock()
+							for cancelPending() == false {
+							}
+							return shared.ContinueYes
+						} else {
+							halt := false
+							for {
+								mu.RLock()
+								if aIsRunning && bIsRunning {
+									halt = true
+								}
+								mu.RUnlock()
+								if halt {
+									return shared.ContinueNo
+								}
+							}
+						}
+					}
+					parametric.ForEachC(aa, 3, fn)
+				},
+			},
+			Behavior{
+				Description: `Upon cancellation, active goroutines are allowed
+							  to wind down before the function returns.`,
+				Expectation: func(t *testing.T) {
+					// Elements "A" and "B" will spawn infinitely loops, which
+					// check for pending cancellation on each iteration.
+					// Element "C" will spawn an operation that will wait until
+					// "A" and "B" are both running, at which point "C" will
+					// cancel further iterations.
+					// "A" and "B" will then identify the cancellation, and
+					// will halt.
+					//
+					// "A", and "B" will each write out a value upon
+					// cancellation, which is used to verify that the function
+					// blocked until all goroutines exited cleanly.
+					aa := []string{"A", "B", "C"}
+					mu := new(sync.RWMutex)
+					aIsRunning := false
+					bIsRunning := false
+					aExitedCleanly := false
+					bExitedCleanly := false
+					fn := func(a string, cancelPending func() bool) shared.Continue {
+						if a == "A" || a == "B" {
+							mu.Lock()
+							if a == "A" {
+								aIsRunning = true
+							} else {
+								bIsRunning = true
+							}
+							mu.Unlock()
+							for cancelPending() == false {
+							}
+							mu.Lock()
+							if a == "A" {
+								aExitedCleanly = true
+							} else {
+								bExitedCleanly = true
+							}
+							mu.Unlock()
+							return shared.ContinueYes
+						} else {
+							halt := false
+							for {
+								mu.RLock()
+								if aIsRunning && bIsRunning {
+									halt = true
+								}
+								mu.RUnlock()
+								if halt {
+									return shared.ContinueNo
+								}
+							}
+						}
+					}
+					parametric.ForEachC(aa, 3, fn)
+					assert.True(t, aExitedCleanly)
+					assert.True(t, bExitedCleanly)
+				},
+			},
+			Behavior{
+				Description: `Upon cancellation, no previously backlogged work
+							  will be scheduled.`,
+				Expectation: func(t *testing.T) {
+					// Elements "A" and "B" will spawn loops, which
+					// check for pending cancellation on each iteration.
+					//
+					// Element "C" will spawn an operation that will wait until
+					// "A" and "B" are both running, at which point "C" will
+					// cancel further iterations. In response "A" and "B" will
+					// then identify the cancellation, and will halt.
+					//
+					// Element "D" spawns an operation that will wait a couple
+					// seconds, ensuring that the goroutine pool stays maxed
+					// at 3 while A, B, and C wind down.
+					//
+					// "E" will write out a value upon initialization, but
+					// because the pool size is only 3, A, B, C, and D will fill
+					// the pool, causing E to be backlogged. As such, E should
+					// never be marshalled to a goroutine if C requests a
+					// cancellation.
+					aa := []string{"A", "B", "C", "D", "E"}
+					mu := new(sync.RWMutex)
+					aIsRunning := false
+					bIsRunning := false
+					eStarted := false
+					fn := func(a string, cancelPending func() bool) shared.Continue {
+						if a == "A" || a == "B" {
+							mu.Lock()
+							if a == "A" {
+								aIsRunning = true
+							} else {
+								bIsRunning = true
+							}
+							mu.Unlock()
+							for cancelPending() == false {
+							}
+							return shared.ContinueYes
+						} else if a == "C" {
+							halt := false
+							for {
+								mu.RLock()
+								if aIsRunning && bIsRunning {
+									halt = true
+								}
+								mu.RUnlock()
+								if halt {
+									return shared.ContinueNo
+								}
+							}
+						} else if a == "D" {
+							time.Sleep(2 * time.Second)
+							for cancelPending() == false {
+							}
+							return shared.ContinueNo
+						} else if a == "E" {
+							mu.Lock()
+							eStarted = true
+							mu.Unlock()
+						}
+						return shared.ContinueYes
+					}
+					parametric.ForEachC(aa, 3, fn)
+					assert.False(t, eStarted)
+				},
+			},
+		},
+	},
+	Specification{
+		FunctionName: "ForEachR",
+		StandardPath: Behavior{
+			Description: `Each element of the list is applied to the function
+						  in reverse order`,
+			Expectation: func(t *testing.T) {
+				aa := []string{"A", "B", "C"}
+				result := ""
+				fn := func(a string) shared.Continue {
+					result = result + a
+					return true
+				}
+				parametric.ForEachR(aa, fn)
+				assert.Equal(t, "CBA", result)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: `The iterator stops when the function return true.`,
+			Expectation: func(t *testing.T) {
+				aa := []string{"A", "B", "C"}
+				result := ""
+				fn := func(a string) shared.Continue {
+					result = result + a
+					return a != "B"
+				}
+				parametric.ForEachR(aa, fn)
+				assert.Equal(t, "CB", result)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Group",
+		StandardPath: Behavior{
+			Description: "Elements are grouped as expected.",
+			Expectation: func(t *testing.T) {
+				aa := []string{"A", "B", "C", "D", "E", "F"}
+				grouper := func(a string) string {
+					switch {
+					case a == "A" || a == "B":
+						return "1"
+					case a == "C" || a == "D":
+						return "2"
+					default:
+						return "3"
+					}
+				}
+				bb := parametric.Group(aa, grouper)
+				cc := [][]string{
+					[]string{"A", "B"},
+					[]string{"C", "D"},
+					[]string{"E", "F"},
+				}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				t.Skip()
+			},
+		},
+	},
+	Specification{
+		FunctionName: "GroupByTrait",
+		StandardPath: Behavior{
+			Description: "Normally groups by trait.",
+			Expectation: func(t *testing.T) {
+				aa := []string{"pigdog", "pigs", "dog", "pigdogs", "cat", "dogs", "pig"}
+				trait := func(ai, an string) bool {
+					return strings.Index(an, ai) == 0
+				}
+				equality := func(a, b string) bool {
+					return a == b
+				}
+				bb := parametric.GroupByTrait(aa, trait, equality)
+				cc := [][]string{
+					[]string{"dog", "dogs"},
+					[]string{"cat"},
+					[]string{"pigdog", "pigs", "pigdogs", "pig"},
+				}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				t.Skip()
+			},
+		},
+	},
+	Specification{
+		FunctionName: "GroupI",
+		StandardPath: Behavior{
+			Description: "Elements are grouped as expected.",
+			Expectation: func(t *testing.T) {
+				aa := []string{"A", "B", "C", "D", "E", "F"}
+				grouper := func(i int64, a string) string {
+					switch {
+					case i <= 1:
+						return "1"
+					case i <= 3:
+						return "2"
+					default:
+						return "3"
+					}
+				}
+				bb := parametric.GroupI(aa, grouper)
+				cc := [][]string{
+					[]string{"A", "B"},
+					[]string{"C", "D"},
+					[]string{"E", "F"},
+				}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				t.Skip()
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Head",
+		StandardPath: Behavior{
+			Description: "Returns the first item from the slice.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3}
+				bb := parametric.Head(aa)
+				assertSlicesEqual(t, bb, []int{1})
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Returns an empty slice if the source slice is empty.",
+			Expectation: func(t *testing.T) {
+				aa := []int{}
+				bb := parametric.Head(aa)
+				if len(bb) != 0 {
+					t.Error("Expected bb to be empty.")
+				}
+			},
+		},
+	},
+	Specification{
+		FunctionName: "InsertAfter",
+		StandardPath: Behavior{
+			Description: "Inserts after the first element passing the test",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3}
+				test := func(a int) bool {
+					return a%2 != 0
+				}
+				parametric.InsertAfter(&aa, 9, test)
+				bb := []int{1, 9, 2, 3}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "No tests pass, inserts at end.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3}
+				test := func(a int) bool {
+					return a > 10
+				}
+				parametric.InsertAfter(&aa, 9, test)
+				bb := []int{1, 2, 3, 9}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "InsertBefore",
+		StandardPath: Behavior{
+			Description: "Inserts before the first element passing the test.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				test := func(a int) bool {
+					return a%2 == 0
+				}
+				parametric.InsertBefore(&aa, 9, test)
+				bb := []int{1, 9, 2, 3, 4}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Inserts at head if no tests pass",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				test := func(a int) bool {
+					return a == 10
+				}
+				parametric.InsertBefore(&aa, 9, test)
+				bb := []int{9, 1, 2, 3, 4}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "InsertAt",
+		StandardPath: Behavior{
+			Description: "Inserts properly in middle of list.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3}
+				parametric.InsertAt(&aa, 9, 2)
+				bb := []int{1, 2, 9, 3}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Inserts into an empty list.",
+			Expectation: func(t *testing.T) {
+				aa := []int{}
+				parametric.InsertAt(&aa, 9, 0)
+				bb := []int{9}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "Negative index inserted at 0",
+				Expectation: func(t *testing.T) {
+					aa := []int{1, 2, 3}
+					parametric.InsertAt(&aa, 9, -2)
+					bb := []int{9, 1, 2, 3}
+					assertSlicesEqual(t, aa, bb)
+				},
+			},
+			Behavior{
+				Description: "Index greater than length appended to end.",
+				Expectation: func(t *testing.T) {
+					aa := []int{1, 2, 3}
+					parametric.InsertAt(&aa, 9, 99)
+					bb := []int{1, 2, 3, 9}
+					assertSlicesEqual(t, aa, bb)
+				},
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Intersection",
+		StandardPath: Behavior{
+			Description: "Returns a slice of the commmon items.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 4, 2, 5}
+				bb := []int{4, 3, 7, 2}
+				equality := func(a, b int) bool {
+					return a == b
+				}
+				cc := parametric.Intersection(aa, bb, equality)
+				dd := []int{4, 2}
+				assertSlicesEqual(t, cc, dd)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Duplicates are not retained",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 4, 2, 2, 2, 5, 4}
+				bb := []int{4, 3, 2, 7, 2}
+				equality := func(a, b int) bool {
+					return a == b
+				}
+				cc := parametric.Intersection(aa, bb, equality)
+				dd := []int{4, 2}
+				assertSlicesEqual(t, cc, dd)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "IsProperSubset",
+		StandardPath: Behavior{
+			Description: "Returns true if aa is a proper subset of bb",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				bb := []int{1, 2, 3, 4, 5}
+				equality := func(a, b int) bool {
+					return a == b
+				}
+				result := parametric.IsProperSubset(aa, bb, equality)
+				assert.True(t, result)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Returns false if aa is not a proper subset of bb",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4, 5}
+				bb := []int{1, 2, 3, 4, 5}
+				equality := func(a, b int) bool {
+					return a == b
+				}
+				result := parametric.IsProperSubset(aa, bb, equality)
+				assert.False(t, result)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "IsProperSuperset",
+		StandardPath: Behavior{
+			Description: "Returns true if aa is a proper superset of bb",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4, 5}
+				bb := []int{1, 2, 3, 4}
+				equality := func(a, b int) bool {
+					return a == b
+				}
+				result := parametric.IsProperSuperset(aa, bb, equality)
+				assert.True(t, result)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Returns false if aa is not a proper superset of bb",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4, 5}
+				bb := []int{1, 2, 3, 4, 5}
+				equality := func(a, b int) bool {
+					return a == b
+				}
+				result := parametric.IsProperSuperset(aa, bb, equality)
+				assert.False(t, result)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "IsSubset",
+		StandardPath: Behavior{
+			Description: "Returns true if aa is a subset of bb",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				bb := []int{1, 2, 3, 4}
+				equality := func(a, b int) bool {
+					return a == b
+				}
+				result := parametric.IsSubset(aa, bb, equality)
+				assert.True(t, result)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Returns false if aa is not a subset of bb",
+			Expectation: func(t *testing.T) {
+				aa := []int{6, 7, 8, 9, 0}
+				bb := []int{1, 2, 3, 4, 5}
+				equality := func(a, b int) bool {
+					return a == b
+				}
+				result := parametric.IsSubset(aa, bb, equality)
+				assert.False(t, result)
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "Duplicates in aa need only one equal element in bb",
+				Expectation: func(t *testing.T) {
+					aa := []int{1, 1}
+					bb := []int{1}
+					equality := func(a, b int) bool {
+						return a == b
+					}
+					assert.True(t, parametric.IsSubset(aa, bb, equality))
+					assert.True(t, parametric.IsSubset(aa, aa, equality))
+					assert.True(t, parametric.IsProperSubset(aa, []int{1, 1, 2}, equality))
+					assert.True(t, parametric.IsSuperset(bb, aa, equality))
+				},
+			},
+		},
+	},
+	Specification{
+		FunctionName: "IsSuperset",
+		StandardPath: Behavior{
+			Description: "Returns true if aa is a superset of bb",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				bb := []int{1, 2, 3, 4}
+				equality := func(a, b int) bool {
+					return a == b
+				}
+				result := parametric.IsSuperset(aa, bb, equality)
+				assert.True(t, result)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Returns false if aa is not a superset of bb",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4, 5}
+				bb := []int{6, 7, 8, 9, 0}
+				equality := func(a, b int) bool {
+					return a == b
+				}
+				result := parametric.IsSuperset(aa, bb, equality)
+				assert.False(t, result)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Item",
+		StandardPath: Behavior{
+			Description: "Element at i is returned.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3}
+				bb := parametric.Item(aa, 1)
+				cc := []int{2}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "aa is empty, returns empty for any index",
+			Expectation: func(t *testing.T) {
+				for i := int64(-1); i <= 1; i++ {
+					aa := []int{}
+					bb := parametric.ItemFuzzy(aa, i)
+					cc := []int{}
+					assertSlicesEqual(t, bb, cc)
+				}
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "i < 0 returns empty",
+				Expectation: func(t *testing.T) {
+					aa := []int{1, 2, 3}
+					bb := parametric.Item(aa, -1)
+					cc := []int{}
+					assertSlicesEqual(t, bb, cc)
+				},
+			},
+			Behavior{
+				Description: "i >= len(aa) returns empty",
+				Expectation: func(t *testing.T) {
+					aa := []int{1, 2, 3}
+					bb := parametric.Item(aa, 10)
+					cc := []int{}
+					assertSlicesEqual(t, bb, cc)
+				},
+			},
+		},
+	},
+	Specification{
+		FunctionName: "ItemFuzzy",
+		StandardPath: Behavior{
+			Description: "Element at i is returned.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3}
+				bb := parametric.ItemFuzzy(aa, 1)
+				cc := []int{2}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "aa is empty, returns empty for any index",
+			Expectation: func(t *testing.T) {
+				for i := int64(-1); i <= 1; i++ {
+					aa := []int{}
+					bb := parametric.ItemFuzzy(aa, i)
+					cc := []int{}
+					assertSlicesEqual(t, bb, cc)
+				}
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "i < 0 returns head",
+				Expectation: func(t *testing.T) {
+					aa := []int{1, 2, 3}
+					bb := parametric.ItemFuzzy(aa, -1)
+					cc := []int{1}
+					assertSlicesEqual(t, bb, cc)
+				},
+			},
+			Behavior{
+				Description: "i >= len(aa) returns end",
+				Expectation: func(t *testing.T) {
+					aa := []int{1, 2, 3}
+					bb := parametric.ItemFuzzy(aa, 10)
+					cc := []int{3}
+					assertSlicesEqual(t, bb, cc)
+				},
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Last",
+		StandardPath: Behavior{
+			Description: "Returns the last that matches the expectation.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4, 5, 6, 7, 8}
+				test := func(a int) bool {
+					return a%2 != 0
+				}
+				bb := parametric.Last(aa, test)
+				cc := []int{7}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				t.Skip()
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Len",
+		StandardPath: Behavior{
+			Description: "Returns the length of the slice",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4, 5, 6, 7, 8}
+				assert.Equal(t, 8, parametric.Len(aa))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				t.Skip()
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Apply",
+		StandardPath: Behavior{
+			Description: "Applies the transform to each element",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3}
+				mapFn := func(a int) int {
+					return a * 2
+				}
+				parametric.Apply(&aa, mapFn)
+				bb := []int{2, 4, 6}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				t.Skip()
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Map",
+		StandardPath: Behavior{
+			Description: "Projects the transform across each element",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3}
+				mapFn := func(a int) int {
+					return a * 2
+				}
+				bb := parametric.Map(aa, mapFn)
+				cc := []int{2, 4, 6}
+				assert.Equal(t, cc, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "The resulting element type may differ from the source",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3}
+				mapFn := func(a int) string {
+					return strconv.Itoa(a)
+				}
+				bb := parametric.Map(aa, mapFn)
+				cc := []string{"1", "2", "3"}
+				assert.Equal(t, cc, bb)
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "The source slice is not mutated",
+				Expectation: func(t *testing.T) {
+					aa := []int{1, 2, 3}
+					parametric.Map(aa, func(a int) int { return a * 2 })
+					assert.Equal(t, []int{1, 2, 3}, aa)
+				},
+			},
+		},
+	},
+	Specification{
+		FunctionName: "None",
+		StandardPath: Behavior{
+			Description: "Returns true if the test fails for all items",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3}
+				test := func(a int) bool {
+					return a == 4
+				}
+				assert.True(t, parametric.None(aa, test))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Returns false if the test passes for any item",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3}
+				test := func(a int) bool {
+					return a == 2
+				}
+				assert.False(t, parametric.None(aa, test))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Pairwise",
+		StandardPath: Behavior{
+			Description: "Processes elements pairwise",
+			Expectation: func(t *testing.T) {
+				aa := []string{"W", "X", "Y", "Z"}
+				xform := func(a, b string) string {
+					return a + b
+				}
+				bb := parametric.Pairwise(aa, "V", xform)
+				cc := []string{"VW", "WX", "XY", "YZ"}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Returns empty slice if aa is empty",
+			Expectation: func(t *testing.T) {
+				aa := []string{}
+				xform := func(a, b string) string {
+					return a + b
+				}
+				bb := parametric.Pairwise(aa, "V", xform)
+				cc := []string{}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Partition",
+		StandardPath: Behavior{
+			Description: "Parition splits the slice as expected",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4, 5, 6}
+				test := func(a int) bool {
+					return a%2 == 0
+				}
+				bb := parametric.Partition(aa, test)
+				cc := [][]int{{2, 4, 6}, {1, 3, 5}}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				t.Skip()
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Permutable",
+		StandardPath: Behavior{
+			Description: "Returns true if the slice has less than MaxInt64 permutations.",
+			Expectation: func(t *testing.T) {
+				aa := []int{}
+				for i := 0; i < 20; i++ {
+					parametric.Append(&aa, i)
+				}
+				assert.True(t, parametric.Permutable(aa))
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Returns if the slice has more than MaxInt64 permutations.",
+			Expectation: func(t *testing.T) {
+				aa := []int{}
+				for i := 0; i < 21; i++ {
+					parametric.Append(&aa, i)
+				}
+				assert.False(t, parametric.Permutable(aa))
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Permutations",
+		StandardPath: Behavior{
+			Description: "Returns the correct number of possible permutations.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4, 5, 6}
+				p := parametric.Permutations(aa)
+				assert.Equal(t, int64(720), p.Int64())
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				t.Skip()
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Permute",
+		StandardPath: Behavior{
+			Description: "Creates permutations.",
+			Expectation: func(t *testing.T) {
+				aa := []string{"A", "B", "C"}
+				bb := parametric.Permute(aa)
+				cc := [][]string{
+					[]string{"A", "B", "C"},
+					[]string{"B", "A", "C"},
+					[]string{"C", "A", "B"},
+					[]string{"A", "C", "B"},
+					[]string{"B", "C", "A"},
+					[]string{"C", "B", "A"},
+				}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Original slice is unaltered.",
+			Expectation: func(t *testing.T) {
+				aa := []string{"A", "B", "C"}
+				parametric.Permute(aa)
+				cc := []string{"A", "B", "C"}
+				assertSlicesEqual(t, aa, cc)
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: `Permute should panic if the number of permutations
+						  would exceed MaxInt64`,
+				Expectation: func(t *testing.T) {
+					aa := []int{}
+					for n := 0; n < 21; n++ {
+						parametric.Append(&aa, n)
+					}
+					assert.Panics(t, func() { parametric.Permute(aa) })
+				},
+			},
+			Behavior{
+				Description: `If source is empty, empty slice should be returned.`,
+				Expectation: func(t *testing.T) {
+					aa := []int{}
+					bb := parametric.Permute(aa)
+					if len(bb) != 0 {
+						t.Error("Expected bb to be empty, but it was not.")
+					}
+				},
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Pop",
+		StandardPath: Behavior{
+			Description: "Returns the head element from the slice, removing it from the slice.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3}
+				bb := parametric.Pop(&aa)
+				cc := []int{2, 3}
+				dd := []int{1}
+				assertSlicesEqual(t, aa, cc)
+				assertSlicesEqual(t, bb, dd)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Slice is empty, returns an empty slice.",
+			Expectation: func(t *testing.T) {
+				aa := []int{}
+				bb := parametric.Pop(&aa)
+				if len(bb) > 0 {
+					t.Error("Expected bb to be empty.")
+				}
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Push",
+		StandardPath: Behavior{
+			Description: "Inserts a new element at the head of the slice.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3}
+				parametric.Push(&aa, 9)
+				bb := []int{9, 1, 2, 3}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				t.Skip()
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Reduce",
+		StandardPath: Behavior{
+			Description: "Slice is reduced as expected.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				reducer := func(a, acc int) int {
+					return a + acc
+				}
+				bb := parametric.Reduce(aa, reducer)
+				cc := []int{10}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Reducing empty slice returns an empty slice.",
+			Expectation: func(t *testing.T) {
+				aa := []int{}
+				reducer := func(a, acc int) int {
+					return a + acc
+				}
+				bb := parametric.Reduce(aa, reducer)
+				cc := []int{}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "Reducing a single element slice returns a single element slice.",
+				Expectation: func(t *testing.T) {
+					aa := []int{1}
+					reducer := func(a, acc int) int {
+						return a + acc
+					}
+					bb := parametric.Reduce(aa, reducer)
+					cc := []int{1}
+					assertSlicesEqual(t, bb, cc)
+				},
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Remove",
+		StandardPath: Behavior{
+			Description: "Removes the items that pass the test.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4, 5, 6}
+				test := func(a int) bool {
+					return a%2 == 0
+				}
+				parametric.Remove(&aa, test)
+				bb := []int{1, 3, 5}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Does nothing if no items satisfy test.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4, 5, 6}
+				test := func(a int) bool {
+					return a == 10
+				}
+				parametric.Remove(&aa, test)
+				bb := []int{1, 2, 3, 4, 5, 6}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "RemoveAt",
+		StandardPath: Behavior{
+			Description: "Removes the item at the specified index.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				parametric.RemoveAt(&aa, 2)
+				bb := []int{1, 2, 4}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Does nothing if slice is empty.",
+			Expectation: func(t *testing.T) {
+				aa := []int{}
+				parametric.RemoveAt(&aa, 2)
+				bb := []int{}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "Does nothing if slice is nil",
+				Expectation: func(t *testing.T) {
+					var aa []int
+					parametric.RemoveAt(&aa, 2)
+					assert.Nil(t, aa)
+				},
+			},
+			Behavior{
+				Description: "Does nothing if index is negative",
+				Expectation: func(t *testing.T) {
+					aa := []int{1, 2, 3, 4}
+					parametric.RemoveAt(&aa, -1)
+					bb := []int{1, 2, 3, 4}
+					assertSlicesEqual(t, aa, bb)
+				},
+			},
+			Behavior{
+				Description: "Does nothing if index greater than max",
+				Expectation: func(t *testing.T) {
+					aa := []int{1, 2, 3, 4}
+					parametric.RemoveAt(&aa, 10)
+					bb := []int{1, 2, 3, 4}
+					assertSlicesEqual(t, aa, bb)
+				},
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Reverse",
+		StandardPath: Behavior{
+			Description: "Reverses slice",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				parametric.Reverse(&aa)
+				bb := []int{4, 3, 2, 1}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Empty slice has no effect",
+			Expectation: func(t *testing.T) {
+				aa := []int{}
+				parametric.Reverse(&aa)
+				bb := []int{}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "Nil slice has no effect",
+				Expectation: func(t *testing.T) {
+					var aa []int
+					parametric.Reverse(&aa)
+					assert.Nil(t, aa)
+				},
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Skip",
+		StandardPath: Behavior{
+			Description: "Skips the first n elements",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				parametric.Skip(&aa, 2)
+				bb := []int{3, 4}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Empties the list if n >= len(aa)",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				parametric.Skip(&aa, 4)
+				bb := []int{}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "Empty list does nothing",
+				Expectation: func(t *testing.T) {
+					aa := []int{}
+					parametric.Skip(&aa, 4)
+					bb := []int{}
+					assertSlicesEqual(t, aa, bb)
+				},
+			},
+			Behavior{
+				Description: "Nil list does nothing",
+				Expectation: func(t *testing.T) {
+					var aa []int
+					parametric.Skip(&aa, 4)
+					assert.Nil(t, aa)
+				},
+			},
+			Behavior{
+				Description: "n <= 0 does nothing",
+				Expectation: func(t *testing.T) {
+					nn := []int64{-1, 0}
+					for _, n := range nn {
+						aa := []int{}
+						parametric.Skip(&aa, n)
+						bb := []int{}
+						assertSlicesEqual(t, aa, bb)
+					}
+				},
+			},
+		},
+	},
+	Specification{
+		FunctionName: "SkipWhile",
+		StandardPath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				test := func(a int) bool {
+					return a < 3
+				}
+				parametric.SkipWhile(&aa, test)
+				bb := []int{3, 4}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Test never satisfied, does nothing",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				test := func(a int) bool {
+					return a > 10
+				}
+				parametric.SkipWhile(&aa, test)
+				bb := []int{1, 2, 3, 4}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Sort",
+		StandardPath: Behavior{
+			Description: "Sorts",
+			Expectation: func(t *testing.T) {
+				aa := []int{6, 3, 4, 2, 5}
+				less := func(a, b int) bool {
+					return a < b
+				}
+				parametric.Sort(&aa, less)
+				bb := []int{2, 3, 4, 5, 6}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				t.Skip()
+			},
+		},
+	},
+	Specification{
+		FunctionName: "SplitAfter",
+		StandardPath: Behavior{
+			Description: "The slice is spit as expected",
+			Expectation: func(t *testing.T) {
+				aa := []int{6, 7, 8, 9}
+				test := func(a int) bool {
+					return a == 7
+				}
+				bb := parametric.SplitAfter(aa, test)
+				cc := [][]int{
+					[]int{6, 7},
+					[]int{8, 9},
+				}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "No match found, aa will be in SliceType[0]",
+			Expectation: func(t *testing.T) {
+				aa := []int{6, 7, 8, 9}
+				test := func(a int) bool {
+					return a == 10
+				}
+				bb := parametric.SplitAfter(aa, test)
+				cc := [][]int{
+					[]int{6, 7, 8, 9},
+					[]int{},
+				}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "SplitAt",
+		StandardPath: Behavior{
+			Description: "The slice is split as expected",
+			Expectation: func(t *testing.T) {
+				aa := []int{6, 7, 8, 9}
+				bb := parametric.SplitAt(aa, 2)
+				cc := [][]int{
+					[]int{6, 7},
+					[]int{8, 9},
+				}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "If the slice is empty, two empty slices are returned",
+			Expectation: func(t *testing.T) {
+				aa := []int{}
+				bb := parametric.SplitAt(aa, 2)
+				cc := [][]int{
+					[]int{},
+					[]int{},
+				}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "If the slice is nil, two empty slices are returned",
+				Expectation: func(t *testing.T) {
+					var aa []int
+					bb := parametric.SplitAt(aa, 2)
+					cc := [][]int{
+						[]int{},
+						[]int{},
+					}
+					assertSlicesEqual(t, bb, cc)
+				},
+			},
+			Behavior{
+				Description: "If i < 0, the full slice will be placed in SliceType[1]",
+				Expectation: func(t *testing.T) {
+					aa := []int{6, 7, 8, 9}
+					bb := parametric.SplitAt(aa, -1)
+					cc := [][]int{
+						[]int{},
+						[]int{6, 7, 8, 9},
+					}
+					assertSlicesEqual(t, bb, cc)
+				},
+			},
+			Behavior{
+				Description: "If i >= len(aa), the full slice will be placed in SliceType[0]",
+				Expectation: func(t *testing.T) {
+					aa := []int{6, 7, 8, 9}
+					bb := parametric.SplitAt(aa, 4)
+					cc := [][]int{
+						[]int{6, 7, 8, 9},
+						[]int{},
+					}
+					assertSlicesEqual(t, bb, cc)
+				},
+			},
+		},
+	},
+	Specification{
+		FunctionName: "SplitBefore",
+		StandardPath: Behavior{
+			Description: "The slice is spit as expected",
+			Expectation: func(t *testing.T) {
+				aa := []int{6, 7, 8, 9}
+				test := func(a int) bool {
+					return a == 8
+				}
+				bb := parametric.SplitBefore(aa, test)
+				cc := [][]int{
+					[]int{6, 7},
+					[]int{8, 9},
+				}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "No match found, aa will be in SliceType[0]",
+			Expectation: func(t *testing.T) {
+				aa := []int{6, 7, 8, 9}
+				test := func(a int) bool {
+					return a == 10
+				}
+				bb := parametric.SplitBefore(aa, test)
+				cc := [][]int{
+					[]int{6, 7, 8, 9},
+					[]int{},
+				}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "String",
+		StandardPath: Behavior{
+			Description: "Returns string representation of slice.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3}
+				s := parametric.String(aa)
+				assert.Equal(t, "[1,2,3]", s)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Elements that cannot be marshaled as JSON are formatted as fmt.Sprint formats them.",
+			Expectation: func(t *testing.T) {
+				aa := []complex128{complex(1, 2), complex(3, -4)}
+				assert.Equal(t, "[(1+2i),(3-4i)]", parametric.String(aa))
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "Bytes are represented as numbers, rather than as base64.",
+				Expectation: func(t *testing.T) {
+					aa := []byte{1, 2}
+					assert.Equal(t, "[1,2]", parametric.String(aa))
+				},
+			},
+		},
+	},
+	Specification{
+		FunctionName: "SwapIndex",
+		StandardPath: Behavior{
+			Description: "Swaps the specified indices.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4, 5}
+				parametric.SwapIndex(aa, 2, 4)
+				bb := []int{1, 2, 5, 4, 3}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "If either index is out of range, swap does nothing.",
+			Expectation: func(t *testing.T) {
+				indices := [][]int64{
+					{-10, -9},
+					{-10, 3},
+					{-10, 10},
+					{3, -10},
+					{3, 10},
+					{10, -10},
+					{10, 3},
+					{10, 9},
+				}
+				for _, ii := range indices {
+					aa := []int{1, 2, 3, 4, 5}
+					parametric.SwapIndex(aa, ii[0], ii[1])
+					bb := []int{1, 2, 3, 4, 5}
+					assertSlicesEqual(t, aa, bb)
+				}
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Tail",
+		StandardPath: Behavior{
+			Description: "Removes the head from the slice.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3}
+				parametric.Tail(&aa)
+				bb := []int{2, 3}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				t.Skip()
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Take",
+		StandardPath: Behavior{
+			Description: "Normally retains first n elements",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				parametric.Take(&aa, 2)
+				bb := []int{1, 2}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "If slice is empty, Take does nothing",
+			Expectation: func(t *testing.T) {
+				aa := []int{}
+				parametric.Take(&aa, 2)
+				bb := []int{}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "If slice is nil, Take does nothing",
+				Expectation: func(t *testing.T) {
+					var aa []int
+					parametric.Take(&aa, 2)
+					assert.Nil(t, aa)
+				},
+			},
+		},
+	},
+	Specification{
+		FunctionName: "TakeWhile",
+		StandardPath: Behavior{
+			Description: "Takes while the test is true.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4}
+				test := func(a int) bool {
+					return a < 3
+				}
+				parametric.TakeWhile(&aa, test)
+				bb := []int{1, 2}
+				assertSlicesEqual(t, aa, bb)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				t.Skip()
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Union",
+		StandardPath: Behavior{
+			Description: "Union appends bb to aa",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3}
+				bb := []int{4, 5, 6}
+				parametric.Union(&aa, bb)
+				cc := []int{1, 2, 3, 4, 5, 6}
+				assertSlicesEqual(t, aa, cc)
+
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				t.Skip()
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Unzip",
+		StandardPath: Behavior{
+			Description: "Normally unzips.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3, 4, 5}
+				bb := parametric.Unzip(aa)
+				cc := [][]int{
+					[]int{1, 3, 5},
+					[]int{2, 4},
+				}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "",
+			Expectation: func(t *testing.T) {
+				t.Skip()
+			},
+		},
+	},
+	Specification{
+		FunctionName: "WindowCentered",
+		StandardPath: Behavior{
+			Description: "Basic windowing works.",
+			Expectation: func(t *testing.T) {
+				windowFn := func(aa []float64) float64 {
+					sum := 0.0
+					for _, a := range aa {
+						sum += float64(a)
+					}
+					return sum / float64(len(aa))
+				}
+				aa := []float64{1.0, 2.0, 3.0, 4.0, 5.0}
+				bb := parametric.WindowCentered(aa, 4, windowFn)
+				cc := []float64{1.5, 2.0, 2.5, 3.5, 4.0}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Slice order is correct for odd window size",
+			Expectation: func(t *testing.T) {
+				windowFn := func(aa []string) string {
+					result := ""
+					for _, a := range aa {
+						result = result + a
+					}
+					return result
+				}
+				aa := []string{"1", "2", "3"}
+				bb := parametric.WindowCentered(aa, 3, windowFn)
+				cc := []string{"12", "123", "23"}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		EdgeCases: []Behavior{
+			Behavior{
+				Description: "Slice order is correct for even window size.",
+				Expectation: func(t *testing.T) {
+					windowFn := func(aa []string) string {
+						result := ""
+						for _, a := range aa {
+							result = result + a
+						}
+						return result
+					}
+					aa := []string{"1", "2", "3", "4", "5"}
+					bb := parametric.WindowCentered(aa, 4, windowFn)
+					cc := []string{"12", "123", "1234", "2345", "345"}
+					assertSlicesEqual(t, bb, cc)
+				},
+			},
+		},
+	},
+	Specification{
+		FunctionName: "WindowLeft",
+		StandardPath: Behavior{
+			Description: "Basic windowing works.",
+			Expectation: func(t *testing.T) {
+				windowFn := func(aa []float64) float64 {
+					sum := 0.0
+					for _, a := range aa {
+						sum += float64(a)
+					}
+					return sum / float64(len(aa))
+				}
+				aa := []float64{1.0, 2.0, 3.0, 4.0, 5.0}
+				bb := parametric.WindowLeft(aa, 4, windowFn)
+				cc := []float64{2.5, 3.5, 4.0, 4.5, 5.0}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Slice order is is respected",
+			Expectation: func(t *testing.T) {
+				windowFn := func(aa []string) string {
+					result := ""
+					for _, a := range aa {
+						result = result + a
+					}
+					return result
+				}
+				aa := []string{"1", "2", "3"}
+				bb := parametric.WindowLeft(aa, 2, windowFn)
+				cc := []string{"12", "23", "3"}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "WindowRight",
+		StandardPath: Behavior{
+			Description: "Basic windowing works.",
+			Expectation: func(t *testing.T) {
+				windowFn := func(aa []float64) float64 {
+					sum := 0.0
+					for _, a := range aa {
+						sum += float64(a)
+					}
+					return sum / float64(len(aa))
+				}
+				aa := []float64{1.0, 2.0, 3.0, 4.0, 5.0}
+				bb := parametric.WindowRight(aa, 4, windowFn)
+				cc := []float64{1.0, 1.5, 2.0, 2.5, 3.5}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "Slice order is is respected",
+			Expectation: func(t *testing.T) {
+				windowFn := func(aa []string) string {
+					result := ""
+					for _, a := range aa {
+						result = result + a
+					}
+					return result
+				}
+				aa := []string{"1", "2", "3"}
+				bb := parametric.WindowRight(aa, 2, windowFn)
+				cc := []string{"1", "12", "23"}
+				assertSlicesEqual(t, bb, cc)
+			},
+		},
+	},
+	Specification{
+		FunctionName: "Zip",
+		StandardPath: Behavior{
+			Description: "Interleaves aa and bb, beginning with aa[0].",
+			Expectation: func(t *testing.T) {
+				type testCase struct {
+					aa parametric.Slice[int]
+					bb parametric.Slice[int]
+					dd parametric.Slice[int]
+				}
+
+				testCases := []testCase{
+					testCase{
+						aa: []int{1, 2, 3},
+						bb: []int{7, 8, 9},
+						dd: []int{1, 7, 2, 8, 3, 9},
+					},
+					testCase{
+						aa: []int{1, 2},
+						bb: []int{7, 8, 9},
+						dd: []int{1, 7, 2, 8, 9},
+					},
+					testCase{
+						aa: []int{1, 2, 3},
+						bb: []int{7, 8},
+						dd: []int{1, 7, 2, 8, 3},
+					},
+					testCase{
+						aa: []int{},
+						bb: []int{7, 8, 9},
+						dd: []int{7, 8, 9},
+					},
+					testCase{
+						aa: []int{1, 2, 3},
+						bb: []int{},
+						dd: []int{1, 2, 3},
+					},
+					testCase{
+						aa: []int{},
+						bb: []int{},
+						dd: []int{},
+					},
+				}
+
+				for _, tc := range testCases {
+					cc := parametric.Zip(tc.aa, tc.bb)
+					assert.Equal(t, []int(tc.dd), cc)
+				}
+			},
+		},
+		AlternativePath: Behavior{
+			Description: "The result is a new slice, even if aa or bb is empty.",
+			Expectation: func(t *testing.T) {
+				aa := []int{1, 2, 3}
+				cc := parametric.Zip(aa, []int{})
+				cc[0] = 9
+				dd := parametric.Zip([]int{}, aa)
+				dd[0] = 9
+				assert.Equal(t, []int{1, 2, 3}, aa)
+			},
+		},
+	},
+}
+
+func TestTransforms(t *testing.T) {
+	for _, specification := range Specifications {
+		t.Run(specification.FunctionName+"StandardPath", specification.StandardPath.Expectation)
+		t.Run(specification.FunctionName+"AlternativePath", specification.AlternativePath.Expectation)
+		for i, edgeCase := range specification.EdgeCases {
+			t.Run(fmt.Sprintf("%vEdgeCase%v", specification.FunctionName, i+1), edgeCase.Expectation)
+		}
+	}
+}
+
+func assertSlicesEqual[T any](t *testing.T, xx, yy []T) bool {
+	// often dealing with using []T as the key (hash) value in a map
+	// which go doesn't like because slice types are unhashable.
+	// We convert the values to a string to get around this limitation.
+	hash := func(z T) string {
+		return fmt.Sprintf("%v", z)
+	}
+
+	if len(xx) != len(yy) {
+		t.Errorf("Expected lengths to match. Wanted %v, got %v", xx, yy)
+		return false
+	}
+	diff := make(map[string]int, len(xx))
+	for _, x := range xx {
+		diff[hash(x)]++
+	}
+	for _, y := range yy {
+		hashy := hash(y)
+		if _, ok := diff[hashy]; !ok {
+			t.Errorf("Expected %v, but got %v", xx, yy)
+			return false
+		}
+		diff[hashy] -= 1
+		if diff[hashy] == 0 {
+			delete(diff, hashy)
+		}
+	}
+	if len(diff) == 0 {
+		return true
+	}
+
+	t.Errorf("Expected %v, but got %v", xx, yy)
+	return false
+}
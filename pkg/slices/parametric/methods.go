@@ -0,0 +1,553 @@
+package parametric
+
+import (
+	"math/big"
+
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+)
+
+func unbox[T any](aa []T) *Slice[T] {
+	bb := Slice[T](aa)
+	return &bb
+}
+
+func box[T any](aa Slice[T]) []T {
+	return ([]T)(aa)
+}
+
+func boxP[T any](aa *Slice[T]) *[]T {
+	return (*[]T)(aa)
+}
+
+// All applies a condition function to each element in the slice, and returns true if
+// the condition function returns true for all items in the slice.
+func (aa *Slice[T]) All(condition ConditionFn[T]) bool {
+	return All(*aa, condition)
+}
+
+// Any applies a condition function to each element of the
+// slice and returns true if the condition function returns true for at least one
+// item in the list.
+//
+// Any does not require that the source slice be sorted, and merely scans
+// the slice, returning as soon as any element passes the supplied condition. For
+// a binary search, consider using sort.Search from the standard library.
+func (aa *Slice[T]) Any(condition ConditionFn[T]) bool {
+	return Any(box(*aa), condition)
+}
+
+//Append adds the supplied values to the end of the slice.
+func (aa *Slice[T]) Append(values ...T) *Slice[T] {
+	Append(boxP(aa), values...)
+	return aa
+}
+
+// Apply applies a tranform to each element of the list.
+func (aa *Slice[T]) Apply(convertFn func(T) T) *Slice[T] {
+	Apply(boxP(aa), convertFn)
+	return aa
+}
+
+// Clear removes all of the items from the slice, setting the slice to nil
+// such that any memory previously allocated to the slice can be garbage
+// collected.
+func (aa *Slice[T]) Clear() *Slice[T] {
+	*aa = nil
+	return aa
+}
+
+// Clone returns a copy of aa
+func (aa *Slice[T]) Clone() *Slice[T] {
+	return unbox(Clone(box(*aa)))
+}
+
+// Collect applies a given function against each item in slice aa and
+// each item of a slice bb, and returns the concatenation of each result.
+func (aa *Slice[T]) Collect(bb []T, collector func(a, b T) T) *Slice[T] {
+	return unbox(Collect(box(*aa), bb, collector))
+}
+
+// Count applies the supplied condition function to each element of the slice,
+// and returns the count of items for which the condition returns true.
+func (aa *Slice[T]) Count(condition ConditionFn[T]) int64 {
+	return Count(*aa, condition)
+}
+
+// Dequeue returns a *Slice containing the head item from the source slice.
+// The head item is removed from the source slice in this operation. If the
+// source slice is initially empty, the resulting slice will also be empty.
+func (aa *Slice[T]) Dequeue() *Slice[T] {
+	return unbox(Dequeue(boxP(aa)))
+}
+
+// Difference returns a new slice that contains items that are not common
+// between aa and bb. The supplied equality function is used to compare values
+// between each slice. Duplicates are retained through this process. As such,
+// The elements in the slice that results from this transform may not be
+// distinct. Distinct values from aa are listed ahead of those from bb in the
+// resulting slice.
+func (aa *Slice[T]) Difference(bb []T, equality EqualityFn[T]) *Slice[T] {
+	return unbox(Difference(box(*aa), bb, equality))
+
+}
+
+// Distinct removes all duplicates from the slice, using the supplied equality
+// function to determine equality.
+func (aa *Slice[T]) Distinct(equality EqualityFn[T]) *Slice[T] {
+	Distinct(boxP(aa), equality)
+	return aa
+}
+
+// Empty returns true if the length of the slice is zero.
+func (aa *Slice[T]) Empty() bool {
+	return Empty(*aa)
+}
+
+// End returns the a *Slice containing only the last element from aa.
+func (aa *Slice[T]) End() *Slice[T] {
+	return unbox(End(box(*aa)))
+
+}
+
+// Enqueue places an item at the head of the slice.
+func (aa *Slice[T]) Enqueue(a T) *Slice[T] {
+	Enqueue(boxP(aa), a)
+	return aa
+}
+
+// Expand applies an expansion function to each element of aa, and flattens
+// the results into a single *Slice.
+func (aa *Slice[T]) Expand(expansion func(T) []T) *Slice[T] {
+	return unbox(Expand(box(*aa), expansion))
+}
+
+// Filter removes all items from the slice for which the supplied condition function
+// returns true.
+func (aa *Slice[T]) Filter(condition ConditionFn[T]) *Slice[T] {
+	Filter(boxP(aa), condition)
+	return aa
+}
+
+// FindIndex returns the index of the first element in the slice for which the
+// supplied condition function returns true. If no matches are found, -1 is returned.
+func (aa *Slice[T]) FindIndex(condition ConditionFn[T]) int64 {
+	return FindIndex(*aa, condition)
+}
+
+// First returns a *Slice containing the first element in the slice for which
+// the supplied condition function returns true.
+func (aa *Slice[T]) First(condition ConditionFn[T]) *Slice[T] {
+	return unbox(First(box(*aa), condition))
+
+}
+
+// Fold applies a function to each item in slice aa, threading an accumulator
+// through each iteration. The accumulated value is returned in a new *Slice
+// once aa is fully scanned. Fold returns a *Slice rather than a
+// T to be consistent with this package's Reduce implementation.
+func (aa *Slice[T]) Fold(acc T, folder func(a, acc T) T) *Slice[T] {
+	return unbox(Fold(box(*aa), acc, folder))
+}
+
+// FoldI applies a function to each item in slice aa, threading an accumulator
+// and an index value through each iteration. The accumulated value is returned
+// once aa is fully scanned. Foldi returns a *Slice rather than a
+// T to be consistent with this package's Reduce implementation.
+func (aa *Slice[T]) FoldI(acc T, folder func(i int64, a, acc T) T) *Slice[T] {
+	return unbox(FoldI(box(*aa), acc, folder))
+}
+
+// ForEach applies each element of the list to the given function.
+// ForEach will stop iterating if fn return false.
+func (aa *Slice[T]) ForEach(fn func(T) shared.Continue) *Slice[T] {
+	ForEach(*aa, fn)
+	return aa
+}
+
+// ForEachC concurrently applies each element of the list to the given function.
+// The elements of the list are marshalled to a pool of goroutines, where each
+// element is passed to fn concurrently.
+//
+// The concurrency pool is limited to contain no more than c active goroutines
+// at any time. Note that if a pool size of 0 is supplied, this method
+// will block indefinitely. This function will panic if a negative value is
+// supplied for c.
+//
+// If any execution of fn returns shared.ContinueNo, ForEachC will cease marshalling
+// any backlogged work, and will immediately set the cancellation flag to true.
+// Any goroutines monitoring the cancelPending closure can wind down their
+// activities as necessary. ForEachC will continue to block until all active
+// goroutines exit cleanly.
+func (aa *Slice[T]) ForEachC(c int, fn func(a T, cancelPending func() bool) shared.Continue) *Slice[T] {
+	ForEachC(*aa, c, fn)
+	return aa
+}
+
+// ForEachR applies each element of aa to a given function, scanning
+// through the slice in reverse order, starting from the end and working towards
+// the head.
+func (aa *Slice[T]) ForEachR(fn func(T) shared.Continue) *Slice[T] {
+	ForEachR(*aa, fn)
+	return aa
+}
+
+// Group consolidates like-items into groups according to the supplied grouper
+// function, and returns them as a [][]T.
+// The grouper function is expected to return a hash value which Group will use
+// to determine into which bucket each element wil be placed.
+func (aa *Slice[T]) Group(grouper func(T) string) [][]T {
+	return Group(box(*aa), grouper)
+}
+
+// GroupI consolidates like-items into groups according to the supplied grouper
+// function, and returns them as a [][]T.
+// The grouper function is expected to return a hash value which Group will use
+// to determine into which bucket each element wil be placed. For convenience
+// the index value from aa is also passed into the grouper function.
+func (aa *Slice[T]) GroupI(grouper func(int64, T) string) [][]T {
+	return GroupI(box(*aa), grouper)
+}
+
+// Head returns a *Slice containing the first item from the aa. If aa is
+// empty, the resulting *Slice will be empty.
+func (aa *Slice[T]) Head() *Slice[T] {
+	return unbox(Head(box(*aa)))
+}
+
+// InsertAfter inserts an element in aa after the first element for which the
+// supplied condition function returns true. If none of the tests return true, the
+// element is appended to the end of the aa.
+func (aa *Slice[T]) InsertAfter(b T, condition ConditionFn[T]) *Slice[T] {
+	InsertAfter(boxP(aa), b, condition)
+	return aa
+}
+
+// InsertBefore inserts an element in aa before the first element for which the
+// supplied condition function returns true. If none of the tests return true,
+// the element is inserted at the head of aa.
+func (aa *Slice[T]) InsertBefore(b T, condition ConditionFn[T]) *Slice[T] {
+	InsertBefore(boxP(aa), b, condition)
+	return aa
+}
+
+// InsertAt inserts an element in aa at the specified index i, shifting the
+// element originally at index i (and all subsequent elements) one position
+// to the right. If i < 0, the element is inserted at index 0. If
+// i >= len(aa), the value is appended to the end of aa.
+func (aa *Slice[T]) InsertAt(a T, i int64) *Slice[T] {
+	InsertAt(boxP(aa), a, i)
+	return aa
+}
+
+// Intersection compares each element of aa to bb using the supplied equal
+// function, and returns a *Slice containing the elements which are common
+// to both aa and bb. Duplicates are removed in this operation.
+func (aa *Slice[T]) Intersection(bb []T, equality EqualityFn[T]) *Slice[T] {
+	return unbox(Intersection(box(*aa), bb, equality))
+}
+
+// IsProperSubset returns true if aa is a proper subset of bb.
+// aa is considered a proper subset if all of its elements exist within bb, but
+// bb also contains some elements that do not exist within aa.
+// Note: This operation does not enforce that each element be unique, thus, it
+// is possible for a subset to be larger than its superset. Use the Distinct
+// operations to enforce uniqueness, if that is necessary.
+func (aa *Slice[T]) IsProperSubset(bb []T, equality EqualityFn[T]) bool {
+	return IsProperSubset(box(*aa), bb, equality)
+}
+
+// IsProperSuperset returns true if aa is a proper superset of bb.
+// aa is considered a proper superset if it contains all of bb's elements, but
+// aa also contains some elements that do not exist within bb.
+// Note: This operation does not enforce that each element be unique, thus, it
+// is possible for a superset to be smaller than its subset. Use the Distinct
+// operations to enforce uniqueness, if that is necessary.
+func (aa *Slice[T]) IsProperSuperset(bb []T, equality EqualityFn[T]) bool {
+	return IsProperSuperset(box(*aa), bb, equality)
+}
+
+// IsSubset returns true if aa is a subset of bb.
+// aa is considered a subset if all of its elements exist within bb.
+// Note: This operation does not enforce that each element be unique, thus, it
+// is possible for a subset to be larger than its superset. Use the Distinct
+// operations to enforce uniqueness, if that is necessary.
+func (aa *Slice[T]) IsSubset(bb []T, equality EqualityFn[T]) bool {
+	return IsSubset(box(*aa), bb, equality)
+}
+
+// IsSuperset returns true if aa is a superset of bb.
+// aa is considered a superset if all of bb's elements exist within aa.
+// Note: This operation does not enforce that each element be unique, thus, it
+// is possible for a superset to be smaller than its subset. Use the Distinct
+// operations to enforce uniqueness, if that is necessary.
+func (aa *Slice[T]) IsSuperset(bb []T, equality EqualityFn[T]) bool {
+	return IsSuperset(box(*aa), bb, equality)
+}
+
+// Item returns a *Slice containing the element at aa[i].
+// If len(aa) == 0, i < 0, or, i >= len(aa), the resulting slice will be empty.
+func (aa *Slice[T]) Item(i int64) *Slice[T] {
+	return unbox(Item(box(*aa), i))
+}
+
+// ItemFuzzy returns a *Slice containing the element at aa[i].
+// If the supplied index is outside of the bounds of ItemFuzzy will attempt
+// to retrieve the head or end element of aa according to the following rules:
+// If len(aa) == 0 an empty *Slice is returned.
+// If i < 0, the head of aa is returned.
+// If i >= len(aa), the end of the aa is returned.
+func (aa *Slice[T]) ItemFuzzy(i int64) *Slice[T] {
+	return unbox(ItemFuzzy(box(*aa), i))
+}
+
+// Last applies a condition function to each element in and returns a *Slice
+// containing the last element for which the condition returned true. If no elements
+// pass the supplied condition, the resulting *Slice will be empty.
+func (aa *Slice[T]) Last(condition ConditionFn[T]) *Slice[T] {
+	return unbox(Last(box(*aa), condition))
+}
+
+// Len returns the length of aa.
+func (aa *Slice[T]) Len() int {
+	return Len(box(*aa))
+}
+
+// Map applies a tranform to each element of the list, emitting a new *Slice.
+// Unlike the Map function, the Map method cannot change the element type,
+// since methods cannot declare type parameters. Use the Map function when the
+// resulting type must differ from T. Also see Apply.
+func (aa *Slice[T]) Map(mapFn func(T) T) *Slice[T] {
+	return unbox(Map(box(*aa), mapFn))
+}
+
+// None applies a condition function to each element in and returns true if
+// the condition function reurns false for all items.
+func (aa *Slice[T]) None(condition ConditionFn[T]) bool {
+	return None(box(*aa), condition)
+}
+
+// Pairwise threads a transform function through passing to the transform
+// successive two-element pairs, aa[i-1] && aa[i]. For the first pairing
+// the supplied init value is supplied as the initial element in the pair.
+func (aa *Slice[T]) Pairwise(init T, xform func(a, b T) T) *Slice[T] {
+	return unbox(Pairwise(box(*aa), init, xform))
+}
+
+// Partition applies a condition function to each element in and returns
+// a [][]T where [][]T[0] contains a []T with all elements for
+// whom the condition function returned true, and where [][]T[1] contains a
+// []T with all elements for whom the condition function returned false.
+//
+// Partition is a special case of the Group function.
+func (aa *Slice[T]) Partition(condition ConditionFn[T]) [][]T {
+	return Partition(box(*aa), condition)
+}
+
+// Permutable returns true if the number of permutations for aa exceeds
+// MaxInt64.
+func (aa *Slice[T]) Permutable() bool {
+	return Permutable(*aa)
+}
+
+// Permutations returns the number of permutations that exist given the current
+// number of items in the aa.
+func (aa *Slice[T]) Permutations() *big.Int {
+	return Permutations(*aa)
+}
+
+// Permute returns a [][]T which contains a []T for each permutation
+// of aa.
+//
+// This function will panic if it determines that the list is not permutable
+// (see Permutable function).
+//
+// Permute makes no assumptions about whether or not the elements in aa are
+// distinct. Permutations are created positionally, and do not involve any
+// equality checks. As such, if it important that Permute operate on a set of
+// distinct elements, pass aa through one of the Distinct transforms before
+// passing it to Permute().
+//
+// Permute is implemented using Heap's algorithm.
+// https://en.wikipedia.org/wiki/Heap%27s_algorithm
+func (aa *Slice[T]) Permute() [][]T {
+	return Permute(*aa)
+}
+
+// Pop returns a *Slice containing the head element from and removes the
+// element from aa. If aa is empty, the returned *Slice will also be empty.
+func (aa *Slice[T]) Pop() *Slice[T] {
+	Pop(boxP(aa))
+	return aa
+}
+
+// Push places a prepends a new element at the head of aa.
+func (aa *Slice[T]) Push(a T) *Slice[T] {
+	Push(boxP(aa), a)
+	return aa
+}
+
+// Reduce applies a reducer function to each element in threading an
+// accumulator through each iteration. The resulting accumulation is returned
+// as an element of a new *Slice. If aa is empty, the resulting *Slice
+// will also be empty.
+func (aa *Slice[T]) Reduce(reducer func(a, acc T) T) *Slice[T] {
+	return unbox(Reduce(box(*aa), reducer))
+}
+
+// Remove applies a condition function to each item in the list, and removes any item
+// for which the condition returns true.
+func (aa *Slice[T]) Remove(condition ConditionFn[T]) *Slice[T] {
+	Remove(boxP(aa), condition)
+	return aa
+}
+
+// RemoveAt removes the item at the specified index from the slice.
+// If len(aa) == 0, aa == nil, i < 0, or i >= len(aa), this function will do
+// nothing.
+func (aa *Slice[T]) RemoveAt(i int64) *Slice[T] {
+	RemoveAt(boxP(aa), i)
+	return aa
+}
+
+// Reverse reverses the order of aa.
+func (aa *Slice[T]) Reverse() *Slice[T] {
+	Reverse(boxP(aa))
+	return aa
+}
+
+// Skip removes the first n elements from aa.
+//
+// Note that Skip(len(aa)) will remove all items from the list, but does not
+// "clear" the slice, meaning that the list remains allocated in memory.
+// To fully de-pointer the slice, and ensure it is available for garbage
+// collection as soon as possible, consider using Clear().
+func (aa *Slice[T]) Skip(n int64) *Slice[T] {
+	Skip(boxP(aa), n)
+	return aa
+}
+
+// SkipWhile scans through aa starting at the head, and removes all
+// elements from aa while the condition function returns true.
+// SkipWhile stops removing any further items from aa after the first condition that
+// returns false.
+func (aa *Slice[T]) SkipWhile(condition ConditionFn[T]) *Slice[T] {
+	SkipWhile(boxP(aa), condition)
+	return aa
+}
+
+// Sort sorts using the supplied less function to determine order.
+// Sort is a convenience wrapper around the stdlib sort.SliceStable
+// function.
+func (aa *Slice[T]) Sort(less func(a, b T) bool) *Slice[T] {
+	Sort(boxP(aa), less)
+	return aa
+}
+
+// SplitAfter finds the first element b for which a condition function returns true,
+// and returns a [][]T where [][]T[0] contains the first half of aa
+// and [][]T[1] contains the second half of aa. Element b will be included
+// in [][]T[0]. If the no element can be found for which the condition returns
+// true, [][]T[0] will contain aa, and [][]T[1] will be empty.
+func (aa *Slice[T]) SplitAfter(condition ConditionFn[T]) [][]T {
+	return SplitAfter(box(*aa), condition)
+}
+
+// SplitAt splits aa at index i, and returns a [][]T which contains the
+// two split halves of aa. aa[i] will be included in [][]T[1].
+// If i < 0, all of aa will be placed in [][]T[0] and [][]T[1] will
+// be empty. Conversly, if i >= len(aa), all of aa will be placed in
+// [][]T[1] and [][]T[0] will be empty. If aa is nil or empty,
+// [][]T will contain two empty slices.
+func (aa *Slice[T]) SplitAt(i int64) [][]T {
+	return SplitAt(box(*aa), i)
+}
+
+// SplitBefore finds the first element b for which a condition function returns true,
+// and returns a [][]T where [][]T[0] contains the first half of aa
+// and [][]T[1] contains the second half of aa. Element b will be included
+// in [][]T[1]
+func (aa *Slice[T]) SplitBefore(condition ConditionFn[T]) [][]T {
+	return SplitBefore(box(*aa), condition)
+}
+
+// String returns a string representation of suitable for use
+// with fmt.Print, or other similar functions. String should be regarded as
+// informational, and should not be relied upon to formally serialize a
+// *Slice.
+func (aa *Slice[T]) String() string {
+	return String(box(*aa))
+}
+
+// SwapIndex swaps the elements at the specified indices. If either i or j is
+// out of the bounds of SwapIndex does nothing.
+func (aa *Slice[T]) SwapIndex(i, j int64) *Slice[T] {
+	SwapIndex(box(*aa), i, j)
+	return aa
+}
+
+// Tail removes the current head element from aa.
+// This equivelant to RemoveAt(0)
+func (aa *Slice[T]) Tail() *Slice[T] {
+	Tail(boxP(aa))
+	return aa
+}
+
+// Take retains the first n elements of and removes all remaining elements
+// from the slice. If n < 0 or n >= len(aa), Take does nothing. If n == 0, all
+// elements are removed from the slice (but the slice is not de-pointered).
+func (aa *Slice[T]) Take(n int64) *Slice[T] {
+	Take(boxP(aa), n)
+	return aa
+}
+
+// TakeWhile applies a condition function to each element in and retains all
+// elements of aa so long as the condition function returns true. As soon as the condition
+// function returns false, take stops evaluating any further, and abandons the
+// rest of the slice.
+func (aa *Slice[T]) TakeWhile(condition ConditionFn[T]) *Slice[T] {
+	TakeWhile(boxP(aa), condition)
+	return aa
+}
+
+// Union appends slice bb to slice aa.
+// Note: This operation does not remove any duplicates from the slice, as a
+// similar operation would when operating on a formal Set.
+func (aa *Slice[T]) Union(bb []T) *Slice[T] {
+	Union(boxP(aa), bb)
+	return aa
+}
+
+// Unzip splits aa into a [][]T, such that [][]T[0] contains all odd
+// indices from aa, and [][]T[1] contains all even indices from aa.
+func (aa *Slice[T]) Unzip() [][]T {
+	return Unzip(box(*aa))
+}
+
+// WindowCentered applies a windowing function across the using a centered
+// window of the specified size.
+func (aa *Slice[T]) WindowCentered(windowSize int64, windowFn func(window []T) T) *Slice[T] {
+	return unbox(WindowCentered(box(*aa), windowSize, windowFn))
+}
+
+// WindowLeft applies a windowing function across using a left-sided window
+// of the specified size.
+func (aa *Slice[T]) WindowLeft(windowSize int64, windowFn func(window []T) T) *Slice[T] {
+	return unbox(WindowLeft(box(*aa), windowSize, windowFn))
+}
+
+// WindowRight applies a windowing function across using a right-sided
+// window of the specified size.
+func (aa *Slice[T]) WindowRight(windowSize int64, windowFn func(window []T) T) *Slice[T] {
+	return unbox(WindowRight(box(*aa), windowSize, windowFn))
+}
+
+// Zip interleaves the contents of aa with bb, and returns the result as a
+// new *Slice. aa[0] is evaluated first. Thus if aa and bb are the same
+// length, slice aa will occupy the odd indices of the result slice, and bb
+// will occupy the even indices of the result slice. If aa and bb are not
+// the same length, Zip will interleave as many values as possible, and will
+// simply append the remaining values for the longer of the two slices to the
+// end of the result slice.
+func (aa *Slice[T]) Zip(bb []T) *Slice[T] {
+	return unbox(Zip(box(*aa), bb))
+}
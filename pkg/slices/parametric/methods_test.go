@@ -0,0 +1,413 @@
+package parametric_test
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/parametric"
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(parametric.Slice[int]){
+		func(aa parametric.Slice[int]) { aa.Clear() },
+		func(aa parametric.Slice[int]) { aa.Clone() },
+		func(aa parametric.Slice[int]) { aa.Dequeue() },
+		func(aa parametric.Slice[int]) { aa.Empty() },
+		func(aa parametric.Slice[int]) { aa.End() },
+		func(aa parametric.Slice[int]) { aa.Head() },
+		func(aa parametric.Slice[int]) { aa.Len() },
+		func(aa parametric.Slice[int]) { aa.Permutable() },
+		func(aa parametric.Slice[int]) { aa.Permutations() },
+		func(aa parametric.Slice[int]) { aa.Permute() },
+		func(aa parametric.Slice[int]) { aa.Pop() },
+		func(aa parametric.Slice[int]) { aa.Reverse() },
+		func(aa parametric.Slice[int]) { _ = aa.String() },
+		func(aa parametric.Slice[int]) { aa.Tail() },
+		func(aa parametric.Slice[int]) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(parametric.Slice[int]{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(parametric.Slice[int]){
+		func(aa parametric.Slice[int]) { aa.Item(0) },
+		func(aa parametric.Slice[int]) { aa.ItemFuzzy(0) },
+		func(aa parametric.Slice[int]) { aa.RemoveAt(0) },
+		func(aa parametric.Slice[int]) { aa.Skip(0) },
+		func(aa parametric.Slice[int]) { aa.SplitAt(0) },
+		func(aa parametric.Slice[int]) { aa.Take(0) },
+		func(aa parametric.Slice[int]) { aa.Union(nil) },
+		func(aa parametric.Slice[int]) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(parametric.Slice[int]{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(parametric.Slice[int], int){
+		func(aa parametric.Slice[int], b int) { aa.Append(b) },
+		func(aa parametric.Slice[int], b int) { aa.Enqueue(b) },
+		func(aa parametric.Slice[int], b int) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(parametric.Slice[int]{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(parametric.Slice[int], parametric.ConditionFn[int]){
+		func(aa parametric.Slice[int], condition parametric.ConditionFn[int]) { aa.All(condition) },
+		func(aa parametric.Slice[int], condition parametric.ConditionFn[int]) { aa.Any(condition) },
+		func(aa parametric.Slice[int], condition parametric.ConditionFn[int]) { aa.Count(condition) },
+		func(aa parametric.Slice[int], condition parametric.ConditionFn[int]) { aa.Filter(condition) },
+		func(aa parametric.Slice[int], condition parametric.ConditionFn[int]) { aa.FindIndex(condition) },
+		func(aa parametric.Slice[int], condition parametric.ConditionFn[int]) { aa.First(condition) },
+		func(aa parametric.Slice[int], condition parametric.ConditionFn[int]) { aa.Last(condition) },
+		func(aa parametric.Slice[int], condition parametric.ConditionFn[int]) { aa.None(condition) },
+		func(aa parametric.Slice[int], condition parametric.ConditionFn[int]) { aa.Partition(condition) },
+		func(aa parametric.Slice[int], condition parametric.ConditionFn[int]) { aa.Remove(condition) },
+		func(aa parametric.Slice[int], condition parametric.ConditionFn[int]) { aa.SkipWhile(condition) },
+		func(aa parametric.Slice[int], condition parametric.ConditionFn[int]) { aa.SplitAfter(condition) },
+		func(aa parametric.Slice[int], condition parametric.ConditionFn[int]) { aa.SplitBefore(condition) },
+		func(aa parametric.Slice[int], condition parametric.ConditionFn[int]) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ int) bool {
+				return true
+			}
+			methodCall(parametric.Slice[int]{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(parametric.Slice[int]){
+		func(aa parametric.Slice[int]) {
+			aa.Distinct(func(a, b int) bool { return true })
+		},
+		func(aa parametric.Slice[int]) {
+			aa.Expand(func(int) []int { return nil })
+		},
+		func(aa parametric.Slice[int]) {
+			aa.ForEach(func(int) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa parametric.Slice[int]) {
+			aa.ForEachR(func(int) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa parametric.Slice[int]) {
+			aa.Group(func(int) string { return "0" })
+		},
+		func(aa parametric.Slice[int]) {
+			aa.GroupI(func(int64, int) string { return "0" })
+		},
+		func(aa parametric.Slice[int]) {
+			aa.Map(func(int) int { return primitiveZero })
+		},
+		func(aa parametric.Slice[int]) {
+			aa.Reduce(func(a, b int) int { return primitiveZero })
+		},
+		func(aa parametric.Slice[int]) {
+			aa.Sort(func(a, b int) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(parametric.Slice[int]{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(parametric.Slice[int], parametric.EqualityFn[int]){
+		func(aa parametric.Slice[int], equality parametric.EqualityFn[int]) {
+			aa.Difference(nil, equality)
+		},
+		func(aa parametric.Slice[int], equality parametric.EqualityFn[int]) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa parametric.Slice[int], equality parametric.EqualityFn[int]) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa parametric.Slice[int], equality parametric.EqualityFn[int]) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa parametric.Slice[int], equality parametric.EqualityFn[int]) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa parametric.Slice[int], equality parametric.EqualityFn[int]) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b int) bool {
+				return false
+			}
+			methodCall(parametric.Slice[int]{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(parametric.Slice[int], int, parametric.ConditionFn[int]){
+		func(aa parametric.Slice[int], b int, condition parametric.ConditionFn[int]) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa parametric.Slice[int], b int, condition parametric.ConditionFn[int]) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(int) bool {
+				return false
+			}
+			methodCall(parametric.Slice[int]{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(parametric.Slice[int]){
+		func(aa parametric.Slice[int]) {
+			aa.ForEachC(0, func(int, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa parametric.Slice[int]) {
+			aa.WindowCentered(0, func([]int) int { return primitiveZero })
+		},
+		func(aa parametric.Slice[int]) {
+			aa.WindowLeft(0, func([]int) int { return primitiveZero })
+		},
+		func(aa parametric.Slice[int]) {
+			aa.WindowRight(0, func([]int) int { return primitiveZero })
+		},
+		func(aa parametric.Slice[int]) {
+			aa.Fold(primitiveZero, func(a, b int) int { return primitiveZero })
+		},
+		func(aa parametric.Slice[int]) {
+			aa.FoldI(primitiveZero, func(i int64, a, b int) int { return primitiveZero })
+		},
+		func(aa parametric.Slice[int]) {
+			aa.Pairwise(primitiveZero, func(a, b int) int { return primitiveZero })
+		},
+		func(aa parametric.Slice[int]) {
+			aa.Collect([]int{}, func(a, b int) int { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(parametric.Slice[int]{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(parametric.Slice[int]){
+		func(aa parametric.Slice[int]) { aa.InsertAt(primitiveZero, 0) },
+		func(aa parametric.Slice[int]) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(parametric.Slice[int]{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
+
+func TestMutatingMethods(t *testing.T) {
+	// The methods covered by this condition are expected to mutate their receiver
+	// value. Core behavior of each method is covered in the function
+	// tests (see functions_test.go), so these tests are fairly cursory in that
+	// they only seek to verify that the value has changed after an operation,
+	// but don't go so far as to check how the value changed.
+
+	var equality = func(a, b int) bool {
+		return a == b
+	}
+
+	var condition = func(a int) bool {
+		return a == 1
+	}
+
+	var sliceForUnionTest = []int{1}
+
+	methodCalls := []func(*parametric.Slice[int]){
+		func(aa *parametric.Slice[int]) { aa.Append(1) },
+		func(aa *parametric.Slice[int]) { aa.Apply(func(a int) int { return a * 2 }) },
+		func(aa *parametric.Slice[int]) { aa.Clear() },
+		func(aa *parametric.Slice[int]) { aa.Dequeue() },
+		func(aa *parametric.Slice[int]) { aa.Distinct(equality) },
+		func(aa *parametric.Slice[int]) { aa.Enqueue(1) },
+		func(aa *parametric.Slice[int]) { aa.Filter(condition) },
+		func(aa *parametric.Slice[int]) { aa.InsertAfter(1, condition) },
+		func(aa *parametric.Slice[int]) { aa.InsertBefore(1, condition) },
+		func(aa *parametric.Slice[int]) { aa.InsertAt(1, 0) },
+		func(aa *parametric.Slice[int]) { aa.Pop() },
+		func(aa *parametric.Slice[int]) { aa.Push(1) },
+		func(aa *parametric.Slice[int]) { aa.Remove(condition) },
+		func(aa *parametric.Slice[int]) { aa.RemoveAt(1) },
+		func(aa *parametric.Slice[int]) { aa.Reverse() },
+		func(aa *parametric.Slice[int]) { aa.Skip(1) },
+		func(aa *parametric.Slice[int]) { aa.SkipWhile(condition) },
+		func(aa *parametric.Slice[int]) { aa.Sort(func(a, b int) bool { return a < b }) },
+		func(aa *parametric.Slice[int]) { aa.SwapIndex(0, 2) },
+		func(aa *parametric.Slice[int]) { aa.Tail() },
+		func(aa *parametric.Slice[int]) { aa.Take(1) },
+		func(aa *parametric.Slice[int]) { aa.TakeWhile(condition) },
+		func(aa *parametric.Slice[int]) { aa.Union(sliceForUnionTest) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			aa := parametric.Slice[int]{1, 1, 2, 1}
+			methodCall(&aa)
+			bb := parametric.Slice[int]{1, 1, 2, 1}
+			if reflect.DeepEqual(aa, bb) {
+				t.Fail()
+			}
+		}
+		t.Run(fmt.Sprintf("Mutation condition %v", i+1), condition)
+	}
+}
+
+func TestNonMutatingMethods(t *testing.T) {
+	// The methods covered by this condition are be expected not to mutate their
+	// receiver value. Core behavior of each method is covered in the function
+	// tests (see functions_test.go), so these tests are fairly cursory in that
+	// they only seek to verify that the receiver value has not changed after
+	// each operation, but do not otherwise verify the outcomes.
+
+	var equality = func(a, b int) bool {
+		return a == b
+	}
+
+	var condition = func(a int) bool {
+		return a > 0
+	}
+
+	var window = func(window []int) int {
+		return window[0]
+	}
+
+	sliceForZipTest := []int{4, 5, 6}
+
+	methodCalls := []func(*parametric.Slice[int]){
+		func(aa *parametric.Slice[int]) { aa.All(condition) },
+		func(aa *parametric.Slice[int]) { aa.Any(condition) },
+		func(aa *parametric.Slice[int]) { aa.Clone() },
+		func(aa *parametric.Slice[int]) {
+			aa.Collect([]int{1, 2}, func(a, b int) int {
+				return a * b
+			})
+		},
+		func(aa *parametric.Slice[int]) { aa.Count(condition) },
+		func(aa *parametric.Slice[int]) { aa.Difference([]int{2, 3}, equality) },
+		func(aa *parametric.Slice[int]) { aa.Empty() },
+		func(aa *parametric.Slice[int]) { aa.End() },
+		func(aa *parametric.Slice[int]) {
+			aa.Expand(func(a int) []int { return []int{1, 2} })
+		},
+		func(aa *parametric.Slice[int]) { aa.FindIndex(condition) },
+		func(aa *parametric.Slice[int]) { aa.First(condition) },
+		func(aa *parametric.Slice[int]) {
+			aa.Fold(2, func(a, acc int) int {
+				return acc * a
+			})
+		},
+		func(aa *parametric.Slice[int]) {
+			aa.FoldI(2, func(_ int64, a, acc int) int {
+				return acc * a
+			})
+		},
+		func(aa *parametric.Slice[int]) {
+			aa.ForEach(func(_ int) shared.Continue { return shared.ContinueYes })
+		},
+		func(aa *parametric.Slice[int]) {
+			aa.ForEachC(1, func(_ int, _ func() bool) shared.Continue { return shared.ContinueYes })
+		},
+		func(aa *parametric.Slice[int]) {
+			aa.ForEachR(func(_ int) shared.Continue { return shared.ContinueYes })
+		},
+		func(aa *parametric.Slice[int]) { aa.Group(func(_ int) string { return "0" }) },
+		func(aa *parametric.Slice[int]) {
+			aa.GroupI(func(i int64, _ int) string { return strconv.Itoa(int(i)) })
+		},
+		func(aa *parametric.Slice[int]) { aa.Head() },
+		func(aa *parametric.Slice[int]) { aa.Intersection([]int{1, 2}, equality) },
+		func(aa *parametric.Slice[int]) { aa.IsProperSubset([]int{1, 2}, equality) },
+		func(aa *parametric.Slice[int]) { aa.IsProperSuperset([]int{1, 2}, equality) },
+		func(aa *parametric.Slice[int]) { aa.IsSubset([]int{1, 2}, equality) },
+		func(aa *parametric.Slice[int]) { aa.IsSuperset([]int{1, 2}, equality) },
+		func(aa *parametric.Slice[int]) { aa.Item(0) },
+		func(aa *parametric.Slice[int]) { aa.ItemFuzzy(0) },
+		func(aa *parametric.Slice[int]) { aa.Last(condition) },
+		func(aa *parametric.Slice[int]) { aa.Len() },
+		func(aa *parametric.Slice[int]) { aa.None(condition) },
+		func(aa *parametric.Slice[int]) {
+			aa.Pairwise(1, func(a, b int) int {
+				return a * b
+			})
+		},
+		func(aa *parametric.Slice[int]) { aa.Partition(condition) },
+		func(aa *parametric.Slice[int]) { aa.Permutable() },
+		func(aa *parametric.Slice[int]) { aa.Permutations() },
+		func(aa *parametric.Slice[int]) { aa.Permute() },
+		func(aa *parametric.Slice[int]) {
+			aa.Reduce(func(a, acc int) int {
+				return a + acc
+			})
+		},
+		func(aa *parametric.Slice[int]) { aa.SplitAfter(condition) },
+		func(aa *parametric.Slice[int]) { aa.SplitAt(1) },
+		func(aa *parametric.Slice[int]) { aa.SplitBefore(condition) },
+		func(aa *parametric.Slice[int]) { _ = aa.String() },
+		func(aa *parametric.Slice[int]) { aa.Unzip() },
+		func(aa *parametric.Slice[int]) { aa.WindowCentered(2, window) },
+		func(aa *parametric.Slice[int]) { aa.WindowLeft(2, window) },
+		func(aa *parametric.Slice[int]) { aa.WindowRight(2, window) },
+		func(aa *parametric.Slice[int]) { aa.Zip(sliceForZipTest) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			aa := parametric.Slice[int]{1, 2, 3}
+			methodCall(&aa)
+			bb := parametric.Slice[int]{1, 2, 3}
+			if !reflect.DeepEqual(aa, bb) {
+				t.Fail()
+			}
+		}
+		t.Run(fmt.Sprintf("Mutation condition %v", i+1), condition)
+	}
+}
@@ -0,0 +1,10 @@
+package parametric
+
+// Slice is a one dimensional slice of T.
+type Slice[T any] []T
+
+// ConditionFn determines whether or not a value meets some condition.
+type ConditionFn[T any] func(T) bool
+
+// EqualityFn determines whether or not two values are equal.
+type EqualityFn[T any] func(a, b T) bool
@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package runeslice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/runeslice"
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(runeslice.RuneSlice){
+		func(aa runeslice.RuneSlice) { aa.Clear() },
+		func(aa runeslice.RuneSlice) { aa.Clone() },
+		func(aa runeslice.RuneSlice) { aa.Dequeue() },
+		func(aa runeslice.RuneSlice) { aa.Empty() },
+		func(aa runeslice.RuneSlice) { aa.End() },
+		func(aa runeslice.RuneSlice) { aa.Head() },
+		func(aa runeslice.RuneSlice) { aa.Len() },
+		func(aa runeslice.RuneSlice) { aa.Permutable() },
+		func(aa runeslice.RuneSlice) { aa.Permutations() },
+		func(aa runeslice.RuneSlice) { aa.Permute() },
+		func(aa runeslice.RuneSlice) { aa.Pop() },
+		func(aa runeslice.RuneSlice) { aa.Reverse() },
+		func(aa runeslice.RuneSlice) { _ = aa.String() },
+		func(aa runeslice.RuneSlice) { aa.Tail() },
+		func(aa runeslice.RuneSlice) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(runeslice.RuneSlice{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(runeslice.RuneSlice){
+		func(aa runeslice.RuneSlice) { aa.Item(0) },
+		func(aa runeslice.RuneSlice) { aa.ItemFuzzy(0) },
+		func(aa runeslice.RuneSlice) { aa.RemoveAt(0) },
+		func(aa runeslice.RuneSlice) { aa.Skip(0) },
+		func(aa runeslice.RuneSlice) { aa.SplitAt(0) },
+		func(aa runeslice.RuneSlice) { aa.Take(0) },
+		func(aa runeslice.RuneSlice) { aa.Union(nil) },
+		func(aa runeslice.RuneSlice) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(runeslice.RuneSlice{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(runeslice.RuneSlice, rune){
+		func(aa runeslice.RuneSlice, b rune) { aa.Append(b) },
+		func(aa runeslice.RuneSlice, b rune) { aa.Enqueue(b) },
+		func(aa runeslice.RuneSlice, b rune) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(runeslice.RuneSlice{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(runeslice.RuneSlice, runeslice.ConditionFn){
+		func(aa runeslice.RuneSlice, condition runeslice.ConditionFn) { aa.All(condition) },
+		func(aa runeslice.RuneSlice, condition runeslice.ConditionFn) { aa.Any(condition) },
+		func(aa runeslice.RuneSlice, condition runeslice.ConditionFn) { aa.Count(condition) },
+		func(aa runeslice.RuneSlice, condition runeslice.ConditionFn) { aa.Filter(condition) },
+		func(aa runeslice.RuneSlice, condition runeslice.ConditionFn) { aa.FindIndex(condition) },
+		func(aa runeslice.RuneSlice, condition runeslice.ConditionFn) { aa.First(condition) },
+		func(aa runeslice.RuneSlice, condition runeslice.ConditionFn) { aa.Last(condition) },
+		func(aa runeslice.RuneSlice, condition runeslice.ConditionFn) { aa.None(condition) },
+		func(aa runeslice.RuneSlice, condition runeslice.ConditionFn) { aa.Partition(condition) },
+		func(aa runeslice.RuneSlice, condition runeslice.ConditionFn) { aa.Remove(condition) },
+		func(aa runeslice.RuneSlice, condition runeslice.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa runeslice.RuneSlice, condition runeslice.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa runeslice.RuneSlice, condition runeslice.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa runeslice.RuneSlice, condition runeslice.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ rune) bool {
+				return true
+			}
+			methodCall(runeslice.RuneSlice{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(runeslice.RuneSlice){
+		func(aa runeslice.RuneSlice) {
+			aa.Distinct(func(a, b rune) bool { return true })
+		},
+		func(aa runeslice.RuneSlice) {
+			aa.Expand(func(rune) []rune { return nil })
+		},
+		func(aa runeslice.RuneSlice) {
+			aa.ForEach(func(rune) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa runeslice.RuneSlice) {
+			aa.ForEachR(func(rune) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa runeslice.RuneSlice) {
+			aa.Group(func(rune) string { return "0" })
+		},
+		func(aa runeslice.RuneSlice) {
+			aa.GroupI(func(int64, rune) string { return "0" })
+		},
+		func(aa runeslice.RuneSlice) {
+			aa.GroupByTrait(func(a, b rune) bool { return true }, func(a, b rune) bool { return true })
+		},
+		func(aa runeslice.RuneSlice) {
+			aa.Map(func(rune) rune { return primitiveZero })
+		},
+		func(aa runeslice.RuneSlice) {
+			aa.Reduce(func(a, b rune) rune { return primitiveZero })
+		},
+		func(aa runeslice.RuneSlice) {
+			aa.Sort(func(a, b rune) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(runeslice.RuneSlice{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(runeslice.RuneSlice, runeslice.EqualityFn){
+		func(aa runeslice.RuneSlice, equality runeslice.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa runeslice.RuneSlice, equality runeslice.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa runeslice.RuneSlice, equality runeslice.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa runeslice.RuneSlice, equality runeslice.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa runeslice.RuneSlice, equality runeslice.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa runeslice.RuneSlice, equality runeslice.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b rune) bool {
+				return false
+			}
+			methodCall(runeslice.RuneSlice{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(runeslice.RuneSlice, rune, runeslice.ConditionFn){
+		func(aa runeslice.RuneSlice, b rune, condition runeslice.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa runeslice.RuneSlice, b rune, condition runeslice.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(rune) bool {
+				return false
+			}
+			methodCall(runeslice.RuneSlice{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(runeslice.RuneSlice){
+		func(aa runeslice.RuneSlice) {
+			aa.ForEachC(0, func(rune, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa runeslice.RuneSlice) {
+			aa.WindowCentered(0, func([]rune) rune { return primitiveZero })
+		},
+		func(aa runeslice.RuneSlice) {
+			aa.WindowLeft(0, func([]rune) rune { return primitiveZero })
+		},
+		func(aa runeslice.RuneSlice) {
+			aa.WindowRight(0, func([]rune) rune { return primitiveZero })
+		},
+		func(aa runeslice.RuneSlice) {
+			aa.Fold(primitiveZero, func(a, b rune) rune { return primitiveZero })
+		},
+		func(aa runeslice.RuneSlice) {
+			aa.FoldI(primitiveZero, func(i int64, a, b rune) rune { return primitiveZero })
+		},
+		func(aa runeslice.RuneSlice) {
+			aa.Pairwise(primitiveZero, func(a, b rune) rune { return primitiveZero })
+		},
+		func(aa runeslice.RuneSlice) {
+			aa.Collect([]rune{}, func(a, b rune) rune { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(runeslice.RuneSlice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(runeslice.RuneSlice){
+		func(aa runeslice.RuneSlice) { aa.InsertAt(primitiveZero, 0) },
+		func(aa runeslice.RuneSlice) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(runeslice.RuneSlice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
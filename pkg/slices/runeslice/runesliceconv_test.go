@@ -0,0 +1,265 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package runeslice_test
+
+import (
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/runeslice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsBoolSlice(t *testing.T) {
+	convertFn := func(a rune) bool {
+		assert.Equal(t, rune(0), a)
+		return false
+	}
+
+	aa := runeslice.RuneSlice{rune(0), rune(0)}
+	bb := aa.AsBoolSlice(convertFn)
+	assert.Equal(t, []bool{false, false}, bb)
+	assert.Equal(t, runeslice.RuneSlice{rune(0), rune(0)}, aa)
+
+	cc := runeslice.AsBoolSlice(nil, convertFn)
+	assert.Equal(t, []bool{}, cc)
+}
+
+func TestAsByteSlice(t *testing.T) {
+	convertFn := func(a rune) byte {
+		assert.Equal(t, rune(0), a)
+		return byte(0)
+	}
+
+	aa := runeslice.RuneSlice{rune(0), rune(0)}
+	bb := aa.AsByteSlice(convertFn)
+	assert.Equal(t, []byte{byte(0), byte(0)}, bb)
+	assert.Equal(t, runeslice.RuneSlice{rune(0), rune(0)}, aa)
+
+	cc := runeslice.AsByteSlice(nil, convertFn)
+	assert.Equal(t, []byte{}, cc)
+}
+
+func TestAsComplex64Slice(t *testing.T) {
+	convertFn := func(a rune) complex64 {
+		assert.Equal(t, rune(0), a)
+		return complex64((0 + 0i))
+	}
+
+	aa := runeslice.RuneSlice{rune(0), rune(0)}
+	bb := aa.AsComplex64Slice(convertFn)
+	assert.Equal(t, []complex64{complex64((0 + 0i)), complex64((0 + 0i))}, bb)
+	assert.Equal(t, runeslice.RuneSlice{rune(0), rune(0)}, aa)
+
+	cc := runeslice.AsComplex64Slice(nil, convertFn)
+	assert.Equal(t, []complex64{}, cc)
+}
+
+func TestAsComplex128Slice(t *testing.T) {
+	convertFn := func(a rune) complex128 {
+		assert.Equal(t, rune(0), a)
+		return complex128((0 + 0i))
+	}
+
+	aa := runeslice.RuneSlice{rune(0), rune(0)}
+	bb := aa.AsComplex128Slice(convertFn)
+	assert.Equal(t, []complex128{complex128((0 + 0i)), complex128((0 + 0i))}, bb)
+	assert.Equal(t, runeslice.RuneSlice{rune(0), rune(0)}, aa)
+
+	cc := runeslice.AsComplex128Slice(nil, convertFn)
+	assert.Equal(t, []complex128{}, cc)
+}
+
+func TestAsFloat32Slice(t *testing.T) {
+	convertFn := func(a rune) float32 {
+		assert.Equal(t, rune(0), a)
+		return float32(0)
+	}
+
+	aa := runeslice.RuneSlice{rune(0), rune(0)}
+	bb := aa.AsFloat32Slice(convertFn)
+	assert.Equal(t, []float32{float32(0), float32(0)}, bb)
+	assert.Equal(t, runeslice.RuneSlice{rune(0), rune(0)}, aa)
+
+	cc := runeslice.AsFloat32Slice(nil, convertFn)
+	assert.Equal(t, []float32{}, cc)
+}
+
+func TestAsFloat64Slice(t *testing.T) {
+	convertFn := func(a rune) float64 {
+		assert.Equal(t, rune(0), a)
+		return float64(0)
+	}
+
+	aa := runeslice.RuneSlice{rune(0), rune(0)}
+	bb := aa.AsFloat64Slice(convertFn)
+	assert.Equal(t, []float64{float64(0), float64(0)}, bb)
+	assert.Equal(t, runeslice.RuneSlice{rune(0), rune(0)}, aa)
+
+	cc := runeslice.AsFloat64Slice(nil, convertFn)
+	assert.Equal(t, []float64{}, cc)
+}
+
+func TestAsIntSlice(t *testing.T) {
+	convertFn := func(a rune) int {
+		assert.Equal(t, rune(0), a)
+		return int(0)
+	}
+
+	aa := runeslice.RuneSlice{rune(0), rune(0)}
+	bb := aa.AsIntSlice(convertFn)
+	assert.Equal(t, []int{int(0), int(0)}, bb)
+	assert.Equal(t, runeslice.RuneSlice{rune(0), rune(0)}, aa)
+
+	cc := runeslice.AsIntSlice(nil, convertFn)
+	assert.Equal(t, []int{}, cc)
+}
+
+func TestAsInt8Slice(t *testing.T) {
+	convertFn := func(a rune) int8 {
+		assert.Equal(t, rune(0), a)
+		return int8(0)
+	}
+
+	aa := runeslice.RuneSlice{rune(0), rune(0)}
+	bb := aa.AsInt8Slice(convertFn)
+	assert.Equal(t, []int8{int8(0), int8(0)}, bb)
+	assert.Equal(t, runeslice.RuneSlice{rune(0), rune(0)}, aa)
+
+	cc := runeslice.AsInt8Slice(nil, convertFn)
+	assert.Equal(t, []int8{}, cc)
+}
+
+func TestAsInt16Slice(t *testing.T) {
+	convertFn := func(a rune) int16 {
+		assert.Equal(t, rune(0), a)
+		return int16(0)
+	}
+
+	aa := runeslice.RuneSlice{rune(0), rune(0)}
+	bb := aa.AsInt16Slice(convertFn)
+	assert.Equal(t, []int16{int16(0), int16(0)}, bb)
+	assert.Equal(t, runeslice.RuneSlice{rune(0), rune(0)}, aa)
+
+	cc := runeslice.AsInt16Slice(nil, convertFn)
+	assert.Equal(t, []int16{}, cc)
+}
+
+func TestAsInt32Slice(t *testing.T) {
+	convertFn := func(a rune) int32 {
+		assert.Equal(t, rune(0), a)
+		return int32(0)
+	}
+
+	aa := runeslice.RuneSlice{rune(0), rune(0)}
+	bb := aa.AsInt32Slice(convertFn)
+	assert.Equal(t, []int32{int32(0), int32(0)}, bb)
+	assert.Equal(t, runeslice.RuneSlice{rune(0), rune(0)}, aa)
+
+	cc := runeslice.AsInt32Slice(nil, convertFn)
+	assert.Equal(t, []int32{}, cc)
+}
+
+func TestAsInt64Slice(t *testing.T) {
+	convertFn := func(a rune) int64 {
+		assert.Equal(t, rune(0), a)
+		return int64(0)
+	}
+
+	aa := runeslice.RuneSlice{rune(0), rune(0)}
+	bb := aa.AsInt64Slice(convertFn)
+	assert.Equal(t, []int64{int64(0), int64(0)}, bb)
+	assert.Equal(t, runeslice.RuneSlice{rune(0), rune(0)}, aa)
+
+	cc := runeslice.AsInt64Slice(nil, convertFn)
+	assert.Equal(t, []int64{}, cc)
+}
+
+func TestAsStringSlice(t *testing.T) {
+	convertFn := func(a rune) string {
+		assert.Equal(t, rune(0), a)
+		return ""
+	}
+
+	aa := runeslice.RuneSlice{rune(0), rune(0)}
+	bb := aa.AsStringSlice(convertFn)
+	assert.Equal(t, []string{"", ""}, bb)
+	assert.Equal(t, runeslice.RuneSlice{rune(0), rune(0)}, aa)
+
+	cc := runeslice.AsStringSlice(nil, convertFn)
+	assert.Equal(t, []string{}, cc)
+}
+
+func TestAsUintSlice(t *testing.T) {
+	convertFn := func(a rune) uint {
+		assert.Equal(t, rune(0), a)
+		return uint(0)
+	}
+
+	aa := runeslice.RuneSlice{rune(0), rune(0)}
+	bb := aa.AsUintSlice(convertFn)
+	assert.Equal(t, []uint{uint(0), uint(0)}, bb)
+	assert.Equal(t, runeslice.RuneSlice{rune(0), rune(0)}, aa)
+
+	cc := runeslice.AsUintSlice(nil, convertFn)
+	assert.Equal(t, []uint{}, cc)
+}
+
+func TestAsUint8Slice(t *testing.T) {
+	convertFn := func(a rune) uint8 {
+		assert.Equal(t, rune(0), a)
+		return uint8(0)
+	}
+
+	aa := runeslice.RuneSlice{rune(0), rune(0)}
+	bb := aa.AsUint8Slice(convertFn)
+	assert.Equal(t, []uint8{uint8(0), uint8(0)}, bb)
+	assert.Equal(t, runeslice.RuneSlice{rune(0), rune(0)}, aa)
+
+	cc := runeslice.AsUint8Slice(nil, convertFn)
+	assert.Equal(t, []uint8{}, cc)
+}
+
+func TestAsUint16Slice(t *testing.T) {
+	convertFn := func(a rune) uint16 {
+		assert.Equal(t, rune(0), a)
+		return uint16(0)
+	}
+
+	aa := runeslice.RuneSlice{rune(0), rune(0)}
+	bb := aa.AsUint16Slice(convertFn)
+	assert.Equal(t, []uint16{uint16(0), uint16(0)}, bb)
+	assert.Equal(t, runeslice.RuneSlice{rune(0), rune(0)}, aa)
+
+	cc := runeslice.AsUint16Slice(nil, convertFn)
+	assert.Equal(t, []uint16{}, cc)
+}
+
+func TestAsUint32Slice(t *testing.T) {
+	convertFn := func(a rune) uint32 {
+		assert.Equal(t, rune(0), a)
+		return uint32(0)
+	}
+
+	aa := runeslice.RuneSlice{rune(0), rune(0)}
+	bb := aa.AsUint32Slice(convertFn)
+	assert.Equal(t, []uint32{uint32(0), uint32(0)}, bb)
+	assert.Equal(t, runeslice.RuneSlice{rune(0), rune(0)}, aa)
+
+	cc := runeslice.AsUint32Slice(nil, convertFn)
+	assert.Equal(t, []uint32{}, cc)
+}
+
+func TestAsUint64Slice(t *testing.T) {
+	convertFn := func(a rune) uint64 {
+		assert.Equal(t, rune(0), a)
+		return uint64(0)
+	}
+
+	aa := runeslice.RuneSlice{rune(0), rune(0)}
+	bb := aa.AsUint64Slice(convertFn)
+	assert.Equal(t, []uint64{uint64(0), uint64(0)}, bb)
+	assert.Equal(t, runeslice.RuneSlice{rune(0), rune(0)}, aa)
+
+	cc := runeslice.AsUint64Slice(nil, convertFn)
+	assert.Equal(t, []uint64{}, cc)
+}
@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package runeslice2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/runeslice2"
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(runeslice2.RuneSlice2){
+		func(aa runeslice2.RuneSlice2) { aa.Clear() },
+		func(aa runeslice2.RuneSlice2) { aa.Clone() },
+		func(aa runeslice2.RuneSlice2) { aa.Dequeue() },
+		func(aa runeslice2.RuneSlice2) { aa.Empty() },
+		func(aa runeslice2.RuneSlice2) { aa.End() },
+		func(aa runeslice2.RuneSlice2) { aa.Head() },
+		func(aa runeslice2.RuneSlice2) { aa.Len() },
+		func(aa runeslice2.RuneSlice2) { aa.Permutable() },
+		func(aa runeslice2.RuneSlice2) { aa.Permutations() },
+		func(aa runeslice2.RuneSlice2) { aa.Permute() },
+		func(aa runeslice2.RuneSlice2) { aa.Pop() },
+		func(aa runeslice2.RuneSlice2) { aa.Reverse() },
+		func(aa runeslice2.RuneSlice2) { _ = aa.String() },
+		func(aa runeslice2.RuneSlice2) { aa.Tail() },
+		func(aa runeslice2.RuneSlice2) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(runeslice2.RuneSlice2{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(runeslice2.RuneSlice2){
+		func(aa runeslice2.RuneSlice2) { aa.Item(0) },
+		func(aa runeslice2.RuneSlice2) { aa.ItemFuzzy(0) },
+		func(aa runeslice2.RuneSlice2) { aa.RemoveAt(0) },
+		func(aa runeslice2.RuneSlice2) { aa.Skip(0) },
+		func(aa runeslice2.RuneSlice2) { aa.SplitAt(0) },
+		func(aa runeslice2.RuneSlice2) { aa.Take(0) },
+		func(aa runeslice2.RuneSlice2) { aa.Union(nil) },
+		func(aa runeslice2.RuneSlice2) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(runeslice2.RuneSlice2{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(runeslice2.RuneSlice2, []rune){
+		func(aa runeslice2.RuneSlice2, b []rune) { aa.Append(b) },
+		func(aa runeslice2.RuneSlice2, b []rune) { aa.Enqueue(b) },
+		func(aa runeslice2.RuneSlice2, b []rune) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(runeslice2.RuneSlice2{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(runeslice2.RuneSlice2, runeslice2.ConditionFn){
+		func(aa runeslice2.RuneSlice2, condition runeslice2.ConditionFn) { aa.All(condition) },
+		func(aa runeslice2.RuneSlice2, condition runeslice2.ConditionFn) { aa.Any(condition) },
+		func(aa runeslice2.RuneSlice2, condition runeslice2.ConditionFn) { aa.Count(condition) },
+		func(aa runeslice2.RuneSlice2, condition runeslice2.ConditionFn) { aa.Filter(condition) },
+		func(aa runeslice2.RuneSlice2, condition runeslice2.ConditionFn) { aa.FindIndex(condition) },
+		func(aa runeslice2.RuneSlice2, condition runeslice2.ConditionFn) { aa.First(condition) },
+		func(aa runeslice2.RuneSlice2, condition runeslice2.ConditionFn) { aa.Last(condition) },
+		func(aa runeslice2.RuneSlice2, condition runeslice2.ConditionFn) { aa.None(condition) },
+		func(aa runeslice2.RuneSlice2, condition runeslice2.ConditionFn) { aa.Partition(condition) },
+		func(aa runeslice2.RuneSlice2, condition runeslice2.ConditionFn) { aa.Remove(condition) },
+		func(aa runeslice2.RuneSlice2, condition runeslice2.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa runeslice2.RuneSlice2, condition runeslice2.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa runeslice2.RuneSlice2, condition runeslice2.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa runeslice2.RuneSlice2, condition runeslice2.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ []rune) bool {
+				return true
+			}
+			methodCall(runeslice2.RuneSlice2{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(runeslice2.RuneSlice2){
+		func(aa runeslice2.RuneSlice2) {
+			aa.Distinct(func(a, b []rune) bool { return true })
+		},
+		func(aa runeslice2.RuneSlice2) {
+			aa.Expand(func([]rune) [][]rune { return nil })
+		},
+		func(aa runeslice2.RuneSlice2) {
+			aa.ForEach(func([]rune) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa runeslice2.RuneSlice2) {
+			aa.ForEachR(func([]rune) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa runeslice2.RuneSlice2) {
+			aa.Group(func([]rune) string { return "0" })
+		},
+		func(aa runeslice2.RuneSlice2) {
+			aa.GroupI(func(int64, []rune) string { return "0" })
+		},
+		func(aa runeslice2.RuneSlice2) {
+			aa.GroupByTrait(func(a, b []rune) bool { return true }, func(a, b []rune) bool { return true })
+		},
+		func(aa runeslice2.RuneSlice2) {
+			aa.Map(func([]rune) []rune { return primitiveZero })
+		},
+		func(aa runeslice2.RuneSlice2) {
+			aa.Reduce(func(a, b []rune) []rune { return primitiveZero })
+		},
+		func(aa runeslice2.RuneSlice2) {
+			aa.Sort(func(a, b []rune) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(runeslice2.RuneSlice2{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(runeslice2.RuneSlice2, runeslice2.EqualityFn){
+		func(aa runeslice2.RuneSlice2, equality runeslice2.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa runeslice2.RuneSlice2, equality runeslice2.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa runeslice2.RuneSlice2, equality runeslice2.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa runeslice2.RuneSlice2, equality runeslice2.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa runeslice2.RuneSlice2, equality runeslice2.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa runeslice2.RuneSlice2, equality runeslice2.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b []rune) bool {
+				return false
+			}
+			methodCall(runeslice2.RuneSlice2{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(runeslice2.RuneSlice2, []rune, runeslice2.ConditionFn){
+		func(aa runeslice2.RuneSlice2, b []rune, condition runeslice2.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa runeslice2.RuneSlice2, b []rune, condition runeslice2.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func([]rune) bool {
+				return false
+			}
+			methodCall(runeslice2.RuneSlice2{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(runeslice2.RuneSlice2){
+		func(aa runeslice2.RuneSlice2) {
+			aa.ForEachC(0, func([]rune, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa runeslice2.RuneSlice2) {
+			aa.WindowCentered(0, func([][]rune) []rune { return primitiveZero })
+		},
+		func(aa runeslice2.RuneSlice2) {
+			aa.WindowLeft(0, func([][]rune) []rune { return primitiveZero })
+		},
+		func(aa runeslice2.RuneSlice2) {
+			aa.WindowRight(0, func([][]rune) []rune { return primitiveZero })
+		},
+		func(aa runeslice2.RuneSlice2) {
+			aa.Fold(primitiveZero, func(a, b []rune) []rune { return primitiveZero })
+		},
+		func(aa runeslice2.RuneSlice2) {
+			aa.FoldI(primitiveZero, func(i int64, a, b []rune) []rune { return primitiveZero })
+		},
+		func(aa runeslice2.RuneSlice2) {
+			aa.Pairwise(primitiveZero, func(a, b []rune) []rune { return primitiveZero })
+		},
+		func(aa runeslice2.RuneSlice2) {
+			aa.Collect([][]rune{}, func(a, b []rune) []rune { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(runeslice2.RuneSlice2{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(runeslice2.RuneSlice2){
+		func(aa runeslice2.RuneSlice2) { aa.InsertAt(primitiveZero, 0) },
+		func(aa runeslice2.RuneSlice2) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(runeslice2.RuneSlice2{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
@@ -0,0 +1,265 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package runeslice2_test
+
+import (
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/runeslice2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsBoolSlice2(t *testing.T) {
+	convertFn := func(a []rune) []bool {
+		assert.Equal(t, []rune{0}, a)
+		return []bool{false}
+	}
+
+	aa := runeslice2.RuneSlice2{[]rune{0}, []rune{0}}
+	bb := aa.AsBoolSlice2(convertFn)
+	assert.Equal(t, [][]bool{[]bool{false}, []bool{false}}, bb)
+	assert.Equal(t, runeslice2.RuneSlice2{[]rune{0}, []rune{0}}, aa)
+
+	cc := runeslice2.AsBoolSlice2(nil, convertFn)
+	assert.Equal(t, [][]bool{}, cc)
+}
+
+func TestAsByteSlice2(t *testing.T) {
+	convertFn := func(a []rune) []byte {
+		assert.Equal(t, []rune{0}, a)
+		return []byte{0}
+	}
+
+	aa := runeslice2.RuneSlice2{[]rune{0}, []rune{0}}
+	bb := aa.AsByteSlice2(convertFn)
+	assert.Equal(t, [][]byte{[]byte{0}, []byte{0}}, bb)
+	assert.Equal(t, runeslice2.RuneSlice2{[]rune{0}, []rune{0}}, aa)
+
+	cc := runeslice2.AsByteSlice2(nil, convertFn)
+	assert.Equal(t, [][]byte{}, cc)
+}
+
+func TestAsComplex64Slice2(t *testing.T) {
+	convertFn := func(a []rune) []complex64 {
+		assert.Equal(t, []rune{0}, a)
+		return []complex64{(0 + 0i)}
+	}
+
+	aa := runeslice2.RuneSlice2{[]rune{0}, []rune{0}}
+	bb := aa.AsComplex64Slice2(convertFn)
+	assert.Equal(t, [][]complex64{[]complex64{(0 + 0i)}, []complex64{(0 + 0i)}}, bb)
+	assert.Equal(t, runeslice2.RuneSlice2{[]rune{0}, []rune{0}}, aa)
+
+	cc := runeslice2.AsComplex64Slice2(nil, convertFn)
+	assert.Equal(t, [][]complex64{}, cc)
+}
+
+func TestAsComplex128Slice2(t *testing.T) {
+	convertFn := func(a []rune) []complex128 {
+		assert.Equal(t, []rune{0}, a)
+		return []complex128{(0 + 0i)}
+	}
+
+	aa := runeslice2.RuneSlice2{[]rune{0}, []rune{0}}
+	bb := aa.AsComplex128Slice2(convertFn)
+	assert.Equal(t, [][]complex128{[]complex128{(0 + 0i)}, []complex128{(0 + 0i)}}, bb)
+	assert.Equal(t, runeslice2.RuneSlice2{[]rune{0}, []rune{0}}, aa)
+
+	cc := runeslice2.AsComplex128Slice2(nil, convertFn)
+	assert.Equal(t, [][]complex128{}, cc)
+}
+
+func TestAsFloat32Slice2(t *testing.T) {
+	convertFn := func(a []rune) []float32 {
+		assert.Equal(t, []rune{0}, a)
+		return []float32{0}
+	}
+
+	aa := runeslice2.RuneSlice2{[]rune{0}, []rune{0}}
+	bb := aa.AsFloat32Slice2(convertFn)
+	assert.Equal(t, [][]float32{[]float32{0}, []float32{0}}, bb)
+	assert.Equal(t, runeslice2.RuneSlice2{[]rune{0}, []rune{0}}, aa)
+
+	cc := runeslice2.AsFloat32Slice2(nil, convertFn)
+	assert.Equal(t, [][]float32{}, cc)
+}
+
+func TestAsFloat64Slice2(t *testing.T) {
+	convertFn := func(a []rune) []float64 {
+		assert.Equal(t, []rune{0}, a)
+		return []float64{0}
+	}
+
+	aa := runeslice2.RuneSlice2{[]rune{0}, []rune{0}}
+	bb := aa.AsFloat64Slice2(convertFn)
+	assert.Equal(t, [][]float64{[]float64{0}, []float64{0}}, bb)
+	assert.Equal(t, runeslice2.RuneSlice2{[]rune{0}, []rune{0}}, aa)
+
+	cc := runeslice2.AsFloat64Slice2(nil, convertFn)
+	assert.Equal(t, [][]float64{}, cc)
+}
+
+func TestAsIntSlice2(t *testing.T) {
+	convertFn := func(a []rune) []int {
+		assert.Equal(t, []rune{0}, a)
+		return []int{0}
+	}
+
+	aa := runeslice2.RuneSlice2{[]rune{0}, []rune{0}}
+	bb := aa.AsIntSlice2(convertFn)
+	assert.Equal(t, [][]int{[]int{0}, []int{0}}, bb)
+	assert.Equal(t, runeslice2.RuneSlice2{[]rune{0}, []rune{0}}, aa)
+
+	cc := runeslice2.AsIntSlice2(nil, convertFn)
+	assert.Equal(t, [][]int{}, cc)
+}
+
+func TestAsInt8Slice2(t *testing.T) {
+	convertFn := func(a []rune) []int8 {
+		assert.Equal(t, []rune{0}, a)
+		return []int8{0}
+	}
+
+	aa := runeslice2.RuneSlice2{[]rune{0}, []rune{0}}
+	bb := aa.AsInt8Slice2(convertFn)
+	assert.Equal(t, [][]int8{[]int8{0}, []int8{0}}, bb)
+	assert.Equal(t, runeslice2.RuneSlice2{[]rune{0}, []rune{0}}, aa)
+
+	cc := runeslice2.AsInt8Slice2(nil, convertFn)
+	assert.Equal(t, [][]int8{}, cc)
+}
+
+func TestAsInt16Slice2(t *testing.T) {
+	convertFn := func(a []rune) []int16 {
+		assert.Equal(t, []rune{0}, a)
+		return []int16{0}
+	}
+
+	aa := runeslice2.RuneSlice2{[]rune{0}, []rune{0}}
+	bb := aa.AsInt16Slice2(convertFn)
+	assert.Equal(t, [][]int16{[]int16{0}, []int16{0}}, bb)
+	assert.Equal(t, runeslice2.RuneSlice2{[]rune{0}, []rune{0}}, aa)
+
+	cc := runeslice2.AsInt16Slice2(nil, convertFn)
+	assert.Equal(t, [][]int16{}, cc)
+}
+
+func TestAsInt32Slice2(t *testing.T) {
+	convertFn := func(a []rune) []int32 {
+		assert.Equal(t, []rune{0}, a)
+		return []int32{0}
+	}
+
+	aa := runeslice2.RuneSlice2{[]rune{0}, []rune{0}}
+	bb := aa.AsInt32Slice2(convertFn)
+	assert.Equal(t, [][]int32{[]int32{0}, []int32{0}}, bb)
+	assert.Equal(t, runeslice2.RuneSlice2{[]rune{0}, []rune{0}}, aa)
+
+	cc := runeslice2.AsInt32Slice2(nil, convertFn)
+	assert.Equal(t, [][]int32{}, cc)
+}
+
+func TestAsInt64Slice2(t *testing.T) {
+	convertFn := func(a []rune) []int64 {
+		assert.Equal(t, []rune{0}, a)
+		return []int64{0}
+	}
+
+	aa := runeslice2.RuneSlice2{[]rune{0}, []rune{0}}
+	bb := aa.AsInt64Slice2(convertFn)
+	assert.Equal(t, [][]int64{[]int64{0}, []int64{0}}, bb)
+	assert.Equal(t, runeslice2.RuneSlice2{[]rune{0}, []rune{0}}, aa)
+
+	cc := runeslice2.AsInt64Slice2(nil, convertFn)
+	assert.Equal(t, [][]int64{}, cc)
+}
+
+func TestAsStringSlice2(t *testing.T) {
+	convertFn := func(a []rune) []string {
+		assert.Equal(t, []rune{0}, a)
+		return []string{""}
+	}
+
+	aa := runeslice2.RuneSlice2{[]rune{0}, []rune{0}}
+	bb := aa.AsStringSlice2(convertFn)
+	assert.Equal(t, [][]string{[]string{""}, []string{""}}, bb)
+	assert.Equal(t, runeslice2.RuneSlice2{[]rune{0}, []rune{0}}, aa)
+
+	cc := runeslice2.AsStringSlice2(nil, convertFn)
+	assert.Equal(t, [][]string{}, cc)
+}
+
+func TestAsUintSlice2(t *testing.T) {
+	convertFn := func(a []rune) []uint {
+		assert.Equal(t, []rune{0}, a)
+		return []uint{0}
+	}
+
+	aa := runeslice2.RuneSlice2{[]rune{0}, []rune{0}}
+	bb := aa.AsUintSlice2(convertFn)
+	assert.Equal(t, [][]uint{[]uint{0}, []uint{0}}, bb)
+	assert.Equal(t, runeslice2.RuneSlice2{[]rune{0}, []rune{0}}, aa)
+
+	cc := runeslice2.AsUintSlice2(nil, convertFn)
+	assert.Equal(t, [][]uint{}, cc)
+}
+
+func TestAsUint8Slice2(t *testing.T) {
+	convertFn := func(a []rune) []uint8 {
+		assert.Equal(t, []rune{0}, a)
+		return []uint8{0}
+	}
+
+	aa := runeslice2.RuneSlice2{[]rune{0}, []rune{0}}
+	bb := aa.AsUint8Slice2(convertFn)
+	assert.Equal(t, [][]uint8{[]uint8{0}, []uint8{0}}, bb)
+	assert.Equal(t, runeslice2.RuneSlice2{[]rune{0}, []rune{0}}, aa)
+
+	cc := runeslice2.AsUint8Slice2(nil, convertFn)
+	assert.Equal(t, [][]uint8{}, cc)
+}
+
+func TestAsUint16Slice2(t *testing.T) {
+	convertFn := func(a []rune) []uint16 {
+		assert.Equal(t, []rune{0}, a)
+		return []uint16{0}
+	}
+
+	aa := runeslice2.RuneSlice2{[]rune{0}, []rune{0}}
+	bb := aa.AsUint16Slice2(convertFn)
+	assert.Equal(t, [][]uint16{[]uint16{0}, []uint16{0}}, bb)
+	assert.Equal(t, runeslice2.RuneSlice2{[]rune{0}, []rune{0}}, aa)
+
+	cc := runeslice2.AsUint16Slice2(nil, convertFn)
+	assert.Equal(t, [][]uint16{}, cc)
+}
+
+func TestAsUint32Slice2(t *testing.T) {
+	convertFn := func(a []rune) []uint32 {
+		assert.Equal(t, []rune{0}, a)
+		return []uint32{0}
+	}
+
+	aa := runeslice2.RuneSlice2{[]rune{0}, []rune{0}}
+	bb := aa.AsUint32Slice2(convertFn)
+	assert.Equal(t, [][]uint32{[]uint32{0}, []uint32{0}}, bb)
+	assert.Equal(t, runeslice2.RuneSlice2{[]rune{0}, []rune{0}}, aa)
+
+	cc := runeslice2.AsUint32Slice2(nil, convertFn)
+	assert.Equal(t, [][]uint32{}, cc)
+}
+
+func TestAsUint64Slice2(t *testing.T) {
+	convertFn := func(a []rune) []uint64 {
+		assert.Equal(t, []rune{0}, a)
+		return []uint64{0}
+	}
+
+	aa := runeslice2.RuneSlice2{[]rune{0}, []rune{0}}
+	bb := aa.AsUint64Slice2(convertFn)
+	assert.Equal(t, [][]uint64{[]uint64{0}, []uint64{0}}, bb)
+	assert.Equal(t, runeslice2.RuneSlice2{[]rune{0}, []rune{0}}, aa)
+
+	cc := runeslice2.AsUint64Slice2(nil, convertFn)
+	assert.Equal(t, [][]uint64{}, cc)
+}
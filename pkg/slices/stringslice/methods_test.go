@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package stringslice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+	"github.com/ideoterra/transforms/pkg/slices/stringslice"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(stringslice.StringSlice){
+		func(aa stringslice.StringSlice) { aa.Clear() },
+		func(aa stringslice.StringSlice) { aa.Clone() },
+		func(aa stringslice.StringSlice) { aa.Dequeue() },
+		func(aa stringslice.StringSlice) { aa.Empty() },
+		func(aa stringslice.StringSlice) { aa.End() },
+		func(aa stringslice.StringSlice) { aa.Head() },
+		func(aa stringslice.StringSlice) { aa.Len() },
+		func(aa stringslice.StringSlice) { aa.Permutable() },
+		func(aa stringslice.StringSlice) { aa.Permutations() },
+		func(aa stringslice.StringSlice) { aa.Permute() },
+		func(aa stringslice.StringSlice) { aa.Pop() },
+		func(aa stringslice.StringSlice) { aa.Reverse() },
+		func(aa stringslice.StringSlice) { _ = aa.String() },
+		func(aa stringslice.StringSlice) { aa.Tail() },
+		func(aa stringslice.StringSlice) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(stringslice.StringSlice{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(stringslice.StringSlice){
+		func(aa stringslice.StringSlice) { aa.Item(0) },
+		func(aa stringslice.StringSlice) { aa.ItemFuzzy(0) },
+		func(aa stringslice.StringSlice) { aa.RemoveAt(0) },
+		func(aa stringslice.StringSlice) { aa.Skip(0) },
+		func(aa stringslice.StringSlice) { aa.SplitAt(0) },
+		func(aa stringslice.StringSlice) { aa.Take(0) },
+		func(aa stringslice.StringSlice) { aa.Union(nil) },
+		func(aa stringslice.StringSlice) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(stringslice.StringSlice{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(stringslice.StringSlice, string){
+		func(aa stringslice.StringSlice, b string) { aa.Append(b) },
+		func(aa stringslice.StringSlice, b string) { aa.Enqueue(b) },
+		func(aa stringslice.StringSlice, b string) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(stringslice.StringSlice{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(stringslice.StringSlice, stringslice.ConditionFn){
+		func(aa stringslice.StringSlice, condition stringslice.ConditionFn) { aa.All(condition) },
+		func(aa stringslice.StringSlice, condition stringslice.ConditionFn) { aa.Any(condition) },
+		func(aa stringslice.StringSlice, condition stringslice.ConditionFn) { aa.Count(condition) },
+		func(aa stringslice.StringSlice, condition stringslice.ConditionFn) { aa.Filter(condition) },
+		func(aa stringslice.StringSlice, condition stringslice.ConditionFn) { aa.FindIndex(condition) },
+		func(aa stringslice.StringSlice, condition stringslice.ConditionFn) { aa.First(condition) },
+		func(aa stringslice.StringSlice, condition stringslice.ConditionFn) { aa.Last(condition) },
+		func(aa stringslice.StringSlice, condition stringslice.ConditionFn) { aa.None(condition) },
+		func(aa stringslice.StringSlice, condition stringslice.ConditionFn) { aa.Partition(condition) },
+		func(aa stringslice.StringSlice, condition stringslice.ConditionFn) { aa.Remove(condition) },
+		func(aa stringslice.StringSlice, condition stringslice.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa stringslice.StringSlice, condition stringslice.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa stringslice.StringSlice, condition stringslice.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa stringslice.StringSlice, condition stringslice.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ string) bool {
+				return true
+			}
+			methodCall(stringslice.StringSlice{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(stringslice.StringSlice){
+		func(aa stringslice.StringSlice) {
+			aa.Distinct(func(a, b string) bool { return true })
+		},
+		func(aa stringslice.StringSlice) {
+			aa.Expand(func(string) []string { return nil })
+		},
+		func(aa stringslice.StringSlice) {
+			aa.ForEach(func(string) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa stringslice.StringSlice) {
+			aa.ForEachR(func(string) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa stringslice.StringSlice) {
+			aa.Group(func(string) string { return "0" })
+		},
+		func(aa stringslice.StringSlice) {
+			aa.GroupI(func(int64, string) string { return "0" })
+		},
+		func(aa stringslice.StringSlice) {
+			aa.GroupByTrait(func(a, b string) bool { return true }, func(a, b string) bool { return true })
+		},
+		func(aa stringslice.StringSlice) {
+			aa.Map(func(string) string { return primitiveZero })
+		},
+		func(aa stringslice.StringSlice) {
+			aa.Reduce(func(a, b string) string { return primitiveZero })
+		},
+		func(aa stringslice.StringSlice) {
+			aa.Sort(func(a, b string) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(stringslice.StringSlice{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(stringslice.StringSlice, stringslice.EqualityFn){
+		func(aa stringslice.StringSlice, equality stringslice.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa stringslice.StringSlice, equality stringslice.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa stringslice.StringSlice, equality stringslice.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa stringslice.StringSlice, equality stringslice.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa stringslice.StringSlice, equality stringslice.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa stringslice.StringSlice, equality stringslice.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b string) bool {
+				return false
+			}
+			methodCall(stringslice.StringSlice{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(stringslice.StringSlice, string, stringslice.ConditionFn){
+		func(aa stringslice.StringSlice, b string, condition stringslice.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa stringslice.StringSlice, b string, condition stringslice.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(string) bool {
+				return false
+			}
+			methodCall(stringslice.StringSlice{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(stringslice.StringSlice){
+		func(aa stringslice.StringSlice) {
+			aa.ForEachC(0, func(string, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa stringslice.StringSlice) {
+			aa.WindowCentered(0, func([]string) string { return primitiveZero })
+		},
+		func(aa stringslice.StringSlice) {
+			aa.WindowLeft(0, func([]string) string { return primitiveZero })
+		},
+		func(aa stringslice.StringSlice) {
+			aa.WindowRight(0, func([]string) string { return primitiveZero })
+		},
+		func(aa stringslice.StringSlice) {
+			aa.Fold(primitiveZero, func(a, b string) string { return primitiveZero })
+		},
+		func(aa stringslice.StringSlice) {
+			aa.FoldI(primitiveZero, func(i int64, a, b string) string { return primitiveZero })
+		},
+		func(aa stringslice.StringSlice) {
+			aa.Pairwise(primitiveZero, func(a, b string) string { return primitiveZero })
+		},
+		func(aa stringslice.StringSlice) {
+			aa.Collect([]string{}, func(a, b string) string { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(stringslice.StringSlice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(stringslice.StringSlice){
+		func(aa stringslice.StringSlice) { aa.InsertAt(primitiveZero, 0) },
+		func(aa stringslice.StringSlice) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(stringslice.StringSlice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
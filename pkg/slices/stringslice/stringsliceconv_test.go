@@ -0,0 +1,265 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package stringslice_test
+
+import (
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/stringslice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsBoolSlice(t *testing.T) {
+	convertFn := func(a string) bool {
+		assert.Equal(t, "", a)
+		return false
+	}
+
+	aa := stringslice.StringSlice{"", ""}
+	bb := aa.AsBoolSlice(convertFn)
+	assert.Equal(t, []bool{false, false}, bb)
+	assert.Equal(t, stringslice.StringSlice{"", ""}, aa)
+
+	cc := stringslice.AsBoolSlice(nil, convertFn)
+	assert.Equal(t, []bool{}, cc)
+}
+
+func TestAsByteSlice(t *testing.T) {
+	convertFn := func(a string) byte {
+		assert.Equal(t, "", a)
+		return byte(0)
+	}
+
+	aa := stringslice.StringSlice{"", ""}
+	bb := aa.AsByteSlice(convertFn)
+	assert.Equal(t, []byte{byte(0), byte(0)}, bb)
+	assert.Equal(t, stringslice.StringSlice{"", ""}, aa)
+
+	cc := stringslice.AsByteSlice(nil, convertFn)
+	assert.Equal(t, []byte{}, cc)
+}
+
+func TestAsComplex64Slice(t *testing.T) {
+	convertFn := func(a string) complex64 {
+		assert.Equal(t, "", a)
+		return complex64((0 + 0i))
+	}
+
+	aa := stringslice.StringSlice{"", ""}
+	bb := aa.AsComplex64Slice(convertFn)
+	assert.Equal(t, []complex64{complex64((0 + 0i)), complex64((0 + 0i))}, bb)
+	assert.Equal(t, stringslice.StringSlice{"", ""}, aa)
+
+	cc := stringslice.AsComplex64Slice(nil, convertFn)
+	assert.Equal(t, []complex64{}, cc)
+}
+
+func TestAsComplex128Slice(t *testing.T) {
+	convertFn := func(a string) complex128 {
+		assert.Equal(t, "", a)
+		return complex128((0 + 0i))
+	}
+
+	aa := stringslice.StringSlice{"", ""}
+	bb := aa.AsComplex128Slice(convertFn)
+	assert.Equal(t, []complex128{complex128((0 + 0i)), complex128((0 + 0i))}, bb)
+	assert.Equal(t, stringslice.StringSlice{"", ""}, aa)
+
+	cc := stringslice.AsComplex128Slice(nil, convertFn)
+	assert.Equal(t, []complex128{}, cc)
+}
+
+func TestAsFloat32Slice(t *testing.T) {
+	convertFn := func(a string) float32 {
+		assert.Equal(t, "", a)
+		return float32(0)
+	}
+
+	aa := stringslice.StringSlice{"", ""}
+	bb := aa.AsFloat32Slice(convertFn)
+	assert.Equal(t, []float32{float32(0), float32(0)}, bb)
+	assert.Equal(t, stringslice.StringSlice{"", ""}, aa)
+
+	cc := stringslice.AsFloat32Slice(nil, convertFn)
+	assert.Equal(t, []float32{}, cc)
+}
+
+func TestAsFloat64Slice(t *testing.T) {
+	convertFn := func(a string) float64 {
+		assert.Equal(t, "", a)
+		return float64(0)
+	}
+
+	aa := stringslice.StringSlice{"", ""}
+	bb := aa.AsFloat64Slice(convertFn)
+	assert.Equal(t, []float64{float64(0), float64(0)}, bb)
+	assert.Equal(t, stringslice.StringSlice{"", ""}, aa)
+
+	cc := stringslice.AsFloat64Slice(nil, convertFn)
+	assert.Equal(t, []float64{}, cc)
+}
+
+func TestAsIntSlice(t *testing.T) {
+	convertFn := func(a string) int {
+		assert.Equal(t, "", a)
+		return int(0)
+	}
+
+	aa := stringslice.StringSlice{"", ""}
+	bb := aa.AsIntSlice(convertFn)
+	assert.Equal(t, []int{int(0), int(0)}, bb)
+	assert.Equal(t, stringslice.StringSlice{"", ""}, aa)
+
+	cc := stringslice.AsIntSlice(nil, convertFn)
+	assert.Equal(t, []int{}, cc)
+}
+
+func TestAsInt8Slice(t *testing.T) {
+	convertFn := func(a string) int8 {
+		assert.Equal(t, "", a)
+		return int8(0)
+	}
+
+	aa := stringslice.StringSlice{"", ""}
+	bb := aa.AsInt8Slice(convertFn)
+	assert.Equal(t, []int8{int8(0), int8(0)}, bb)
+	assert.Equal(t, stringslice.StringSlice{"", ""}, aa)
+
+	cc := stringslice.AsInt8Slice(nil, convertFn)
+	assert.Equal(t, []int8{}, cc)
+}
+
+func TestAsInt16Slice(t *testing.T) {
+	convertFn := func(a string) int16 {
+		assert.Equal(t, "", a)
+		return int16(0)
+	}
+
+	aa := stringslice.StringSlice{"", ""}
+	bb := aa.AsInt16Slice(convertFn)
+	assert.Equal(t, []int16{int16(0), int16(0)}, bb)
+	assert.Equal(t, stringslice.StringSlice{"", ""}, aa)
+
+	cc := stringslice.AsInt16Slice(nil, convertFn)
+	assert.Equal(t, []int16{}, cc)
+}
+
+func TestAsInt32Slice(t *testing.T) {
+	convertFn := func(a string) int32 {
+		assert.Equal(t, "", a)
+		return int32(0)
+	}
+
+	aa := stringslice.StringSlice{"", ""}
+	bb := aa.AsInt32Slice(convertFn)
+	assert.Equal(t, []int32{int32(0), int32(0)}, bb)
+	assert.Equal(t, stringslice.StringSlice{"", ""}, aa)
+
+	cc := stringslice.AsInt32Slice(nil, convertFn)
+	assert.Equal(t, []int32{}, cc)
+}
+
+func TestAsInt64Slice(t *testing.T) {
+	convertFn := func(a string) int64 {
+		assert.Equal(t, "", a)
+		return int64(0)
+	}
+
+	aa := stringslice.StringSlice{"", ""}
+	bb := aa.AsInt64Slice(convertFn)
+	assert.Equal(t, []int64{int64(0), int64(0)}, bb)
+	assert.Equal(t, stringslice.StringSlice{"", ""}, aa)
+
+	cc := stringslice.AsInt64Slice(nil, convertFn)
+	assert.Equal(t, []int64{}, cc)
+}
+
+func TestAsRuneSlice(t *testing.T) {
+	convertFn := func(a string) rune {
+		assert.Equal(t, "", a)
+		return rune(0)
+	}
+
+	aa := stringslice.StringSlice{"", ""}
+	bb := aa.AsRuneSlice(convertFn)
+	assert.Equal(t, []rune{rune(0), rune(0)}, bb)
+	assert.Equal(t, stringslice.StringSlice{"", ""}, aa)
+
+	cc := stringslice.AsRuneSlice(nil, convertFn)
+	assert.Equal(t, []rune{}, cc)
+}
+
+func TestAsUintSlice(t *testing.T) {
+	convertFn := func(a string) uint {
+		assert.Equal(t, "", a)
+		return uint(0)
+	}
+
+	aa := stringslice.StringSlice{"", ""}
+	bb := aa.AsUintSlice(convertFn)
+	assert.Equal(t, []uint{uint(0), uint(0)}, bb)
+	assert.Equal(t, stringslice.StringSlice{"", ""}, aa)
+
+	cc := stringslice.AsUintSlice(nil, convertFn)
+	assert.Equal(t, []uint{}, cc)
+}
+
+func TestAsUint8Slice(t *testing.T) {
+	convertFn := func(a string) uint8 {
+		assert.Equal(t, "", a)
+		return uint8(0)
+	}
+
+	aa := stringslice.StringSlice{"", ""}
+	bb := aa.AsUint8Slice(convertFn)
+	assert.Equal(t, []uint8{uint8(0), uint8(0)}, bb)
+	assert.Equal(t, stringslice.StringSlice{"", ""}, aa)
+
+	cc := stringslice.AsUint8Slice(nil, convertFn)
+	assert.Equal(t, []uint8{}, cc)
+}
+
+func TestAsUint16Slice(t *testing.T) {
+	convertFn := func(a string) uint16 {
+		assert.Equal(t, "", a)
+		return uint16(0)
+	}
+
+	aa := stringslice.StringSlice{"", ""}
+	bb := aa.AsUint16Slice(convertFn)
+	assert.Equal(t, []uint16{uint16(0), uint16(0)}, bb)
+	assert.Equal(t, stringslice.StringSlice{"", ""}, aa)
+
+	cc := stringslice.AsUint16Slice(nil, convertFn)
+	assert.Equal(t, []uint16{}, cc)
+}
+
+func TestAsUint32Slice(t *testing.T) {
+	convertFn := func(a string) uint32 {
+		assert.Equal(t, "", a)
+		return uint32(0)
+	}
+
+	aa := stringslice.StringSlice{"", ""}
+	bb := aa.AsUint32Slice(convertFn)
+	assert.Equal(t, []uint32{uint32(0), uint32(0)}, bb)
+	assert.Equal(t, stringslice.StringSlice{"", ""}, aa)
+
+	cc := stringslice.AsUint32Slice(nil, convertFn)
+	assert.Equal(t, []uint32{}, cc)
+}
+
+func TestAsUint64Slice(t *testing.T) {
+	convertFn := func(a string) uint64 {
+		assert.Equal(t, "", a)
+		return uint64(0)
+	}
+
+	aa := stringslice.StringSlice{"", ""}
+	bb := aa.AsUint64Slice(convertFn)
+	assert.Equal(t, []uint64{uint64(0), uint64(0)}, bb)
+	assert.Equal(t, stringslice.StringSlice{"", ""}, aa)
+
+	cc := stringslice.AsUint64Slice(nil, convertFn)
+	assert.Equal(t, []uint64{}, cc)
+}
@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package stringslice2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+	"github.com/ideoterra/transforms/pkg/slices/stringslice2"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(stringslice2.StringSlice2){
+		func(aa stringslice2.StringSlice2) { aa.Clear() },
+		func(aa stringslice2.StringSlice2) { aa.Clone() },
+		func(aa stringslice2.StringSlice2) { aa.Dequeue() },
+		func(aa stringslice2.StringSlice2) { aa.Empty() },
+		func(aa stringslice2.StringSlice2) { aa.End() },
+		func(aa stringslice2.StringSlice2) { aa.Head() },
+		func(aa stringslice2.StringSlice2) { aa.Len() },
+		func(aa stringslice2.StringSlice2) { aa.Permutable() },
+		func(aa stringslice2.StringSlice2) { aa.Permutations() },
+		func(aa stringslice2.StringSlice2) { aa.Permute() },
+		func(aa stringslice2.StringSlice2) { aa.Pop() },
+		func(aa stringslice2.StringSlice2) { aa.Reverse() },
+		func(aa stringslice2.StringSlice2) { _ = aa.String() },
+		func(aa stringslice2.StringSlice2) { aa.Tail() },
+		func(aa stringslice2.StringSlice2) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(stringslice2.StringSlice2{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(stringslice2.StringSlice2){
+		func(aa stringslice2.StringSlice2) { aa.Item(0) },
+		func(aa stringslice2.StringSlice2) { aa.ItemFuzzy(0) },
+		func(aa stringslice2.StringSlice2) { aa.RemoveAt(0) },
+		func(aa stringslice2.StringSlice2) { aa.Skip(0) },
+		func(aa stringslice2.StringSlice2) { aa.SplitAt(0) },
+		func(aa stringslice2.StringSlice2) { aa.Take(0) },
+		func(aa stringslice2.StringSlice2) { aa.Union(nil) },
+		func(aa stringslice2.StringSlice2) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(stringslice2.StringSlice2{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(stringslice2.StringSlice2, []string){
+		func(aa stringslice2.StringSlice2, b []string) { aa.Append(b) },
+		func(aa stringslice2.StringSlice2, b []string) { aa.Enqueue(b) },
+		func(aa stringslice2.StringSlice2, b []string) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(stringslice2.StringSlice2{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(stringslice2.StringSlice2, stringslice2.ConditionFn){
+		func(aa stringslice2.StringSlice2, condition stringslice2.ConditionFn) { aa.All(condition) },
+		func(aa stringslice2.StringSlice2, condition stringslice2.ConditionFn) { aa.Any(condition) },
+		func(aa stringslice2.StringSlice2, condition stringslice2.ConditionFn) { aa.Count(condition) },
+		func(aa stringslice2.StringSlice2, condition stringslice2.ConditionFn) { aa.Filter(condition) },
+		func(aa stringslice2.StringSlice2, condition stringslice2.ConditionFn) { aa.FindIndex(condition) },
+		func(aa stringslice2.StringSlice2, condition stringslice2.ConditionFn) { aa.First(condition) },
+		func(aa stringslice2.StringSlice2, condition stringslice2.ConditionFn) { aa.Last(condition) },
+		func(aa stringslice2.StringSlice2, condition stringslice2.ConditionFn) { aa.None(condition) },
+		func(aa stringslice2.StringSlice2, condition stringslice2.ConditionFn) { aa.Partition(condition) },
+		func(aa stringslice2.StringSlice2, condition stringslice2.ConditionFn) { aa.Remove(condition) },
+		func(aa stringslice2.StringSlice2, condition stringslice2.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa stringslice2.StringSlice2, condition stringslice2.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa stringslice2.StringSlice2, condition stringslice2.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa stringslice2.StringSlice2, condition stringslice2.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ []string) bool {
+				return true
+			}
+			methodCall(stringslice2.StringSlice2{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(stringslice2.StringSlice2){
+		func(aa stringslice2.StringSlice2) {
+			aa.Distinct(func(a, b []string) bool { return true })
+		},
+		func(aa stringslice2.StringSlice2) {
+			aa.Expand(func([]string) [][]string { return nil })
+		},
+		func(aa stringslice2.StringSlice2) {
+			aa.ForEach(func([]string) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa stringslice2.StringSlice2) {
+			aa.ForEachR(func([]string) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa stringslice2.StringSlice2) {
+			aa.Group(func([]string) string { return "0" })
+		},
+		func(aa stringslice2.StringSlice2) {
+			aa.GroupI(func(int64, []string) string { return "0" })
+		},
+		func(aa stringslice2.StringSlice2) {
+			aa.GroupByTrait(func(a, b []string) bool { return true }, func(a, b []string) bool { return true })
+		},
+		func(aa stringslice2.StringSlice2) {
+			aa.Map(func([]string) []string { return primitiveZero })
+		},
+		func(aa stringslice2.StringSlice2) {
+			aa.Reduce(func(a, b []string) []string { return primitiveZero })
+		},
+		func(aa stringslice2.StringSlice2) {
+			aa.Sort(func(a, b []string) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(stringslice2.StringSlice2{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(stringslice2.StringSlice2, stringslice2.EqualityFn){
+		func(aa stringslice2.StringSlice2, equality stringslice2.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa stringslice2.StringSlice2, equality stringslice2.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa stringslice2.StringSlice2, equality stringslice2.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa stringslice2.StringSlice2, equality stringslice2.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa stringslice2.StringSlice2, equality stringslice2.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa stringslice2.StringSlice2, equality stringslice2.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b []string) bool {
+				return false
+			}
+			methodCall(stringslice2.StringSlice2{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(stringslice2.StringSlice2, []string, stringslice2.ConditionFn){
+		func(aa stringslice2.StringSlice2, b []string, condition stringslice2.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa stringslice2.StringSlice2, b []string, condition stringslice2.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func([]string) bool {
+				return false
+			}
+			methodCall(stringslice2.StringSlice2{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(stringslice2.StringSlice2){
+		func(aa stringslice2.StringSlice2) {
+			aa.ForEachC(0, func([]string, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa stringslice2.StringSlice2) {
+			aa.WindowCentered(0, func([][]string) []string { return primitiveZero })
+		},
+		func(aa stringslice2.StringSlice2) {
+			aa.WindowLeft(0, func([][]string) []string { return primitiveZero })
+		},
+		func(aa stringslice2.StringSlice2) {
+			aa.WindowRight(0, func([][]string) []string { return primitiveZero })
+		},
+		func(aa stringslice2.StringSlice2) {
+			aa.Fold(primitiveZero, func(a, b []string) []string { return primitiveZero })
+		},
+		func(aa stringslice2.StringSlice2) {
+			aa.FoldI(primitiveZero, func(i int64, a, b []string) []string { return primitiveZero })
+		},
+		func(aa stringslice2.StringSlice2) {
+			aa.Pairwise(primitiveZero, func(a, b []string) []string { return primitiveZero })
+		},
+		func(aa stringslice2.StringSlice2) {
+			aa.Collect([][]string{}, func(a, b []string) []string { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(stringslice2.StringSlice2{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(stringslice2.StringSlice2){
+		func(aa stringslice2.StringSlice2) { aa.InsertAt(primitiveZero, 0) },
+		func(aa stringslice2.StringSlice2) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(stringslice2.StringSlice2{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package uint16slice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+	"github.com/ideoterra/transforms/pkg/slices/uint16slice"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uint16slice.Uint16Slice){
+		func(aa uint16slice.Uint16Slice) { aa.Clear() },
+		func(aa uint16slice.Uint16Slice) { aa.Clone() },
+		func(aa uint16slice.Uint16Slice) { aa.Dequeue() },
+		func(aa uint16slice.Uint16Slice) { aa.Empty() },
+		func(aa uint16slice.Uint16Slice) { aa.End() },
+		func(aa uint16slice.Uint16Slice) { aa.Head() },
+		func(aa uint16slice.Uint16Slice) { aa.Len() },
+		func(aa uint16slice.Uint16Slice) { aa.Permutable() },
+		func(aa uint16slice.Uint16Slice) { aa.Permutations() },
+		func(aa uint16slice.Uint16Slice) { aa.Permute() },
+		func(aa uint16slice.Uint16Slice) { aa.Pop() },
+		func(aa uint16slice.Uint16Slice) { aa.Reverse() },
+		func(aa uint16slice.Uint16Slice) { _ = aa.String() },
+		func(aa uint16slice.Uint16Slice) { aa.Tail() },
+		func(aa uint16slice.Uint16Slice) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint16slice.Uint16Slice{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uint16slice.Uint16Slice){
+		func(aa uint16slice.Uint16Slice) { aa.Item(0) },
+		func(aa uint16slice.Uint16Slice) { aa.ItemFuzzy(0) },
+		func(aa uint16slice.Uint16Slice) { aa.RemoveAt(0) },
+		func(aa uint16slice.Uint16Slice) { aa.Skip(0) },
+		func(aa uint16slice.Uint16Slice) { aa.SplitAt(0) },
+		func(aa uint16slice.Uint16Slice) { aa.Take(0) },
+		func(aa uint16slice.Uint16Slice) { aa.Union(nil) },
+		func(aa uint16slice.Uint16Slice) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint16slice.Uint16Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uint16slice.Uint16Slice, uint16){
+		func(aa uint16slice.Uint16Slice, b uint16) { aa.Append(b) },
+		func(aa uint16slice.Uint16Slice, b uint16) { aa.Enqueue(b) },
+		func(aa uint16slice.Uint16Slice, b uint16) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint16slice.Uint16Slice{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uint16slice.Uint16Slice, uint16slice.ConditionFn){
+		func(aa uint16slice.Uint16Slice, condition uint16slice.ConditionFn) { aa.All(condition) },
+		func(aa uint16slice.Uint16Slice, condition uint16slice.ConditionFn) { aa.Any(condition) },
+		func(aa uint16slice.Uint16Slice, condition uint16slice.ConditionFn) { aa.Count(condition) },
+		func(aa uint16slice.Uint16Slice, condition uint16slice.ConditionFn) { aa.Filter(condition) },
+		func(aa uint16slice.Uint16Slice, condition uint16slice.ConditionFn) { aa.FindIndex(condition) },
+		func(aa uint16slice.Uint16Slice, condition uint16slice.ConditionFn) { aa.First(condition) },
+		func(aa uint16slice.Uint16Slice, condition uint16slice.ConditionFn) { aa.Last(condition) },
+		func(aa uint16slice.Uint16Slice, condition uint16slice.ConditionFn) { aa.None(condition) },
+		func(aa uint16slice.Uint16Slice, condition uint16slice.ConditionFn) { aa.Partition(condition) },
+		func(aa uint16slice.Uint16Slice, condition uint16slice.ConditionFn) { aa.Remove(condition) },
+		func(aa uint16slice.Uint16Slice, condition uint16slice.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa uint16slice.Uint16Slice, condition uint16slice.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa uint16slice.Uint16Slice, condition uint16slice.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa uint16slice.Uint16Slice, condition uint16slice.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ uint16) bool {
+				return true
+			}
+			methodCall(uint16slice.Uint16Slice{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(uint16slice.Uint16Slice){
+		func(aa uint16slice.Uint16Slice) {
+			aa.Distinct(func(a, b uint16) bool { return true })
+		},
+		func(aa uint16slice.Uint16Slice) {
+			aa.Expand(func(uint16) []uint16 { return nil })
+		},
+		func(aa uint16slice.Uint16Slice) {
+			aa.ForEach(func(uint16) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa uint16slice.Uint16Slice) {
+			aa.ForEachR(func(uint16) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa uint16slice.Uint16Slice) {
+			aa.Group(func(uint16) string { return "0" })
+		},
+		func(aa uint16slice.Uint16Slice) {
+			aa.GroupI(func(int64, uint16) string { return "0" })
+		},
+		func(aa uint16slice.Uint16Slice) {
+			aa.GroupByTrait(func(a, b uint16) bool { return true }, func(a, b uint16) bool { return true })
+		},
+		func(aa uint16slice.Uint16Slice) {
+			aa.Map(func(uint16) uint16 { return primitiveZero })
+		},
+		func(aa uint16slice.Uint16Slice) {
+			aa.Reduce(func(a, b uint16) uint16 { return primitiveZero })
+		},
+		func(aa uint16slice.Uint16Slice) {
+			aa.Sort(func(a, b uint16) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint16slice.Uint16Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(uint16slice.Uint16Slice, uint16slice.EqualityFn){
+		func(aa uint16slice.Uint16Slice, equality uint16slice.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa uint16slice.Uint16Slice, equality uint16slice.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa uint16slice.Uint16Slice, equality uint16slice.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa uint16slice.Uint16Slice, equality uint16slice.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa uint16slice.Uint16Slice, equality uint16slice.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa uint16slice.Uint16Slice, equality uint16slice.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b uint16) bool {
+				return false
+			}
+			methodCall(uint16slice.Uint16Slice{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(uint16slice.Uint16Slice, uint16, uint16slice.ConditionFn){
+		func(aa uint16slice.Uint16Slice, b uint16, condition uint16slice.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa uint16slice.Uint16Slice, b uint16, condition uint16slice.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(uint16) bool {
+				return false
+			}
+			methodCall(uint16slice.Uint16Slice{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(uint16slice.Uint16Slice){
+		func(aa uint16slice.Uint16Slice) {
+			aa.ForEachC(0, func(uint16, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa uint16slice.Uint16Slice) {
+			aa.WindowCentered(0, func([]uint16) uint16 { return primitiveZero })
+		},
+		func(aa uint16slice.Uint16Slice) {
+			aa.WindowLeft(0, func([]uint16) uint16 { return primitiveZero })
+		},
+		func(aa uint16slice.Uint16Slice) {
+			aa.WindowRight(0, func([]uint16) uint16 { return primitiveZero })
+		},
+		func(aa uint16slice.Uint16Slice) {
+			aa.Fold(primitiveZero, func(a, b uint16) uint16 { return primitiveZero })
+		},
+		func(aa uint16slice.Uint16Slice) {
+			aa.FoldI(primitiveZero, func(i int64, a, b uint16) uint16 { return primitiveZero })
+		},
+		func(aa uint16slice.Uint16Slice) {
+			aa.Pairwise(primitiveZero, func(a, b uint16) uint16 { return primitiveZero })
+		},
+		func(aa uint16slice.Uint16Slice) {
+			aa.Collect([]uint16{}, func(a, b uint16) uint16 { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint16slice.Uint16Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(uint16slice.Uint16Slice){
+		func(aa uint16slice.Uint16Slice) { aa.InsertAt(primitiveZero, 0) },
+		func(aa uint16slice.Uint16Slice) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint16slice.Uint16Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
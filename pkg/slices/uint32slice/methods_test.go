@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package uint32slice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+	"github.com/ideoterra/transforms/pkg/slices/uint32slice"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uint32slice.Uint32Slice){
+		func(aa uint32slice.Uint32Slice) { aa.Clear() },
+		func(aa uint32slice.Uint32Slice) { aa.Clone() },
+		func(aa uint32slice.Uint32Slice) { aa.Dequeue() },
+		func(aa uint32slice.Uint32Slice) { aa.Empty() },
+		func(aa uint32slice.Uint32Slice) { aa.End() },
+		func(aa uint32slice.Uint32Slice) { aa.Head() },
+		func(aa uint32slice.Uint32Slice) { aa.Len() },
+		func(aa uint32slice.Uint32Slice) { aa.Permutable() },
+		func(aa uint32slice.Uint32Slice) { aa.Permutations() },
+		func(aa uint32slice.Uint32Slice) { aa.Permute() },
+		func(aa uint32slice.Uint32Slice) { aa.Pop() },
+		func(aa uint32slice.Uint32Slice) { aa.Reverse() },
+		func(aa uint32slice.Uint32Slice) { _ = aa.String() },
+		func(aa uint32slice.Uint32Slice) { aa.Tail() },
+		func(aa uint32slice.Uint32Slice) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint32slice.Uint32Slice{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uint32slice.Uint32Slice){
+		func(aa uint32slice.Uint32Slice) { aa.Item(0) },
+		func(aa uint32slice.Uint32Slice) { aa.ItemFuzzy(0) },
+		func(aa uint32slice.Uint32Slice) { aa.RemoveAt(0) },
+		func(aa uint32slice.Uint32Slice) { aa.Skip(0) },
+		func(aa uint32slice.Uint32Slice) { aa.SplitAt(0) },
+		func(aa uint32slice.Uint32Slice) { aa.Take(0) },
+		func(aa uint32slice.Uint32Slice) { aa.Union(nil) },
+		func(aa uint32slice.Uint32Slice) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint32slice.Uint32Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uint32slice.Uint32Slice, uint32){
+		func(aa uint32slice.Uint32Slice, b uint32) { aa.Append(b) },
+		func(aa uint32slice.Uint32Slice, b uint32) { aa.Enqueue(b) },
+		func(aa uint32slice.Uint32Slice, b uint32) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint32slice.Uint32Slice{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uint32slice.Uint32Slice, uint32slice.ConditionFn){
+		func(aa uint32slice.Uint32Slice, condition uint32slice.ConditionFn) { aa.All(condition) },
+		func(aa uint32slice.Uint32Slice, condition uint32slice.ConditionFn) { aa.Any(condition) },
+		func(aa uint32slice.Uint32Slice, condition uint32slice.ConditionFn) { aa.Count(condition) },
+		func(aa uint32slice.Uint32Slice, condition uint32slice.ConditionFn) { aa.Filter(condition) },
+		func(aa uint32slice.Uint32Slice, condition uint32slice.ConditionFn) { aa.FindIndex(condition) },
+		func(aa uint32slice.Uint32Slice, condition uint32slice.ConditionFn) { aa.First(condition) },
+		func(aa uint32slice.Uint32Slice, condition uint32slice.ConditionFn) { aa.Last(condition) },
+		func(aa uint32slice.Uint32Slice, condition uint32slice.ConditionFn) { aa.None(condition) },
+		func(aa uint32slice.Uint32Slice, condition uint32slice.ConditionFn) { aa.Partition(condition) },
+		func(aa uint32slice.Uint32Slice, condition uint32slice.ConditionFn) { aa.Remove(condition) },
+		func(aa uint32slice.Uint32Slice, condition uint32slice.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa uint32slice.Uint32Slice, condition uint32slice.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa uint32slice.Uint32Slice, condition uint32slice.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa uint32slice.Uint32Slice, condition uint32slice.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ uint32) bool {
+				return true
+			}
+			methodCall(uint32slice.Uint32Slice{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(uint32slice.Uint32Slice){
+		func(aa uint32slice.Uint32Slice) {
+			aa.Distinct(func(a, b uint32) bool { return true })
+		},
+		func(aa uint32slice.Uint32Slice) {
+			aa.Expand(func(uint32) []uint32 { return nil })
+		},
+		func(aa uint32slice.Uint32Slice) {
+			aa.ForEach(func(uint32) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa uint32slice.Uint32Slice) {
+			aa.ForEachR(func(uint32) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa uint32slice.Uint32Slice) {
+			aa.Group(func(uint32) string { return "0" })
+		},
+		func(aa uint32slice.Uint32Slice) {
+			aa.GroupI(func(int64, uint32) string { return "0" })
+		},
+		func(aa uint32slice.Uint32Slice) {
+			aa.GroupByTrait(func(a, b uint32) bool { return true }, func(a, b uint32) bool { return true })
+		},
+		func(aa uint32slice.Uint32Slice) {
+			aa.Map(func(uint32) uint32 { return primitiveZero })
+		},
+		func(aa uint32slice.Uint32Slice) {
+			aa.Reduce(func(a, b uint32) uint32 { return primitiveZero })
+		},
+		func(aa uint32slice.Uint32Slice) {
+			aa.Sort(func(a, b uint32) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint32slice.Uint32Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(uint32slice.Uint32Slice, uint32slice.EqualityFn){
+		func(aa uint32slice.Uint32Slice, equality uint32slice.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa uint32slice.Uint32Slice, equality uint32slice.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa uint32slice.Uint32Slice, equality uint32slice.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa uint32slice.Uint32Slice, equality uint32slice.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa uint32slice.Uint32Slice, equality uint32slice.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa uint32slice.Uint32Slice, equality uint32slice.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b uint32) bool {
+				return false
+			}
+			methodCall(uint32slice.Uint32Slice{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(uint32slice.Uint32Slice, uint32, uint32slice.ConditionFn){
+		func(aa uint32slice.Uint32Slice, b uint32, condition uint32slice.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa uint32slice.Uint32Slice, b uint32, condition uint32slice.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(uint32) bool {
+				return false
+			}
+			methodCall(uint32slice.Uint32Slice{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(uint32slice.Uint32Slice){
+		func(aa uint32slice.Uint32Slice) {
+			aa.ForEachC(0, func(uint32, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa uint32slice.Uint32Slice) {
+			aa.WindowCentered(0, func([]uint32) uint32 { return primitiveZero })
+		},
+		func(aa uint32slice.Uint32Slice) {
+			aa.WindowLeft(0, func([]uint32) uint32 { return primitiveZero })
+		},
+		func(aa uint32slice.Uint32Slice) {
+			aa.WindowRight(0, func([]uint32) uint32 { return primitiveZero })
+		},
+		func(aa uint32slice.Uint32Slice) {
+			aa.Fold(primitiveZero, func(a, b uint32) uint32 { return primitiveZero })
+		},
+		func(aa uint32slice.Uint32Slice) {
+			aa.FoldI(primitiveZero, func(i int64, a, b uint32) uint32 { return primitiveZero })
+		},
+		func(aa uint32slice.Uint32Slice) {
+			aa.Pairwise(primitiveZero, func(a, b uint32) uint32 { return primitiveZero })
+		},
+		func(aa uint32slice.Uint32Slice) {
+			aa.Collect([]uint32{}, func(a, b uint32) uint32 { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint32slice.Uint32Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(uint32slice.Uint32Slice){
+		func(aa uint32slice.Uint32Slice) { aa.InsertAt(primitiveZero, 0) },
+		func(aa uint32slice.Uint32Slice) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint32slice.Uint32Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
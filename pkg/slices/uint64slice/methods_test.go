@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package uint64slice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+	"github.com/ideoterra/transforms/pkg/slices/uint64slice"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uint64slice.Uint64Slice){
+		func(aa uint64slice.Uint64Slice) { aa.Clear() },
+		func(aa uint64slice.Uint64Slice) { aa.Clone() },
+		func(aa uint64slice.Uint64Slice) { aa.Dequeue() },
+		func(aa uint64slice.Uint64Slice) { aa.Empty() },
+		func(aa uint64slice.Uint64Slice) { aa.End() },
+		func(aa uint64slice.Uint64Slice) { aa.Head() },
+		func(aa uint64slice.Uint64Slice) { aa.Len() },
+		func(aa uint64slice.Uint64Slice) { aa.Permutable() },
+		func(aa uint64slice.Uint64Slice) { aa.Permutations() },
+		func(aa uint64slice.Uint64Slice) { aa.Permute() },
+		func(aa uint64slice.Uint64Slice) { aa.Pop() },
+		func(aa uint64slice.Uint64Slice) { aa.Reverse() },
+		func(aa uint64slice.Uint64Slice) { _ = aa.String() },
+		func(aa uint64slice.Uint64Slice) { aa.Tail() },
+		func(aa uint64slice.Uint64Slice) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint64slice.Uint64Slice{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uint64slice.Uint64Slice){
+		func(aa uint64slice.Uint64Slice) { aa.Item(0) },
+		func(aa uint64slice.Uint64Slice) { aa.ItemFuzzy(0) },
+		func(aa uint64slice.Uint64Slice) { aa.RemoveAt(0) },
+		func(aa uint64slice.Uint64Slice) { aa.Skip(0) },
+		func(aa uint64slice.Uint64Slice) { aa.SplitAt(0) },
+		func(aa uint64slice.Uint64Slice) { aa.Take(0) },
+		func(aa uint64slice.Uint64Slice) { aa.Union(nil) },
+		func(aa uint64slice.Uint64Slice) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint64slice.Uint64Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uint64slice.Uint64Slice, uint64){
+		func(aa uint64slice.Uint64Slice, b uint64) { aa.Append(b) },
+		func(aa uint64slice.Uint64Slice, b uint64) { aa.Enqueue(b) },
+		func(aa uint64slice.Uint64Slice, b uint64) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint64slice.Uint64Slice{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uint64slice.Uint64Slice, uint64slice.ConditionFn){
+		func(aa uint64slice.Uint64Slice, condition uint64slice.ConditionFn) { aa.All(condition) },
+		func(aa uint64slice.Uint64Slice, condition uint64slice.ConditionFn) { aa.Any(condition) },
+		func(aa uint64slice.Uint64Slice, condition uint64slice.ConditionFn) { aa.Count(condition) },
+		func(aa uint64slice.Uint64Slice, condition uint64slice.ConditionFn) { aa.Filter(condition) },
+		func(aa uint64slice.Uint64Slice, condition uint64slice.ConditionFn) { aa.FindIndex(condition) },
+		func(aa uint64slice.Uint64Slice, condition uint64slice.ConditionFn) { aa.First(condition) },
+		func(aa uint64slice.Uint64Slice, condition uint64slice.ConditionFn) { aa.Last(condition) },
+		func(aa uint64slice.Uint64Slice, condition uint64slice.ConditionFn) { aa.None(condition) },
+		func(aa uint64slice.Uint64Slice, condition uint64slice.ConditionFn) { aa.Partition(condition) },
+		func(aa uint64slice.Uint64Slice, condition uint64slice.ConditionFn) { aa.Remove(condition) },
+		func(aa uint64slice.Uint64Slice, condition uint64slice.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa uint64slice.Uint64Slice, condition uint64slice.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa uint64slice.Uint64Slice, condition uint64slice.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa uint64slice.Uint64Slice, condition uint64slice.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ uint64) bool {
+				return true
+			}
+			methodCall(uint64slice.Uint64Slice{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(uint64slice.Uint64Slice){
+		func(aa uint64slice.Uint64Slice) {
+			aa.Distinct(func(a, b uint64) bool { return true })
+		},
+		func(aa uint64slice.Uint64Slice) {
+			aa.Expand(func(uint64) []uint64 { return nil })
+		},
+		func(aa uint64slice.Uint64Slice) {
+			aa.ForEach(func(uint64) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa uint64slice.Uint64Slice) {
+			aa.ForEachR(func(uint64) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa uint64slice.Uint64Slice) {
+			aa.Group(func(uint64) string { return "0" })
+		},
+		func(aa uint64slice.Uint64Slice) {
+			aa.GroupI(func(int64, uint64) string { return "0" })
+		},
+		func(aa uint64slice.Uint64Slice) {
+			aa.GroupByTrait(func(a, b uint64) bool { return true }, func(a, b uint64) bool { return true })
+		},
+		func(aa uint64slice.Uint64Slice) {
+			aa.Map(func(uint64) uint64 { return primitiveZero })
+		},
+		func(aa uint64slice.Uint64Slice) {
+			aa.Reduce(func(a, b uint64) uint64 { return primitiveZero })
+		},
+		func(aa uint64slice.Uint64Slice) {
+			aa.Sort(func(a, b uint64) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint64slice.Uint64Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(uint64slice.Uint64Slice, uint64slice.EqualityFn){
+		func(aa uint64slice.Uint64Slice, equality uint64slice.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa uint64slice.Uint64Slice, equality uint64slice.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa uint64slice.Uint64Slice, equality uint64slice.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa uint64slice.Uint64Slice, equality uint64slice.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa uint64slice.Uint64Slice, equality uint64slice.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa uint64slice.Uint64Slice, equality uint64slice.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b uint64) bool {
+				return false
+			}
+			methodCall(uint64slice.Uint64Slice{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(uint64slice.Uint64Slice, uint64, uint64slice.ConditionFn){
+		func(aa uint64slice.Uint64Slice, b uint64, condition uint64slice.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa uint64slice.Uint64Slice, b uint64, condition uint64slice.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(uint64) bool {
+				return false
+			}
+			methodCall(uint64slice.Uint64Slice{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(uint64slice.Uint64Slice){
+		func(aa uint64slice.Uint64Slice) {
+			aa.ForEachC(0, func(uint64, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa uint64slice.Uint64Slice) {
+			aa.WindowCentered(0, func([]uint64) uint64 { return primitiveZero })
+		},
+		func(aa uint64slice.Uint64Slice) {
+			aa.WindowLeft(0, func([]uint64) uint64 { return primitiveZero })
+		},
+		func(aa uint64slice.Uint64Slice) {
+			aa.WindowRight(0, func([]uint64) uint64 { return primitiveZero })
+		},
+		func(aa uint64slice.Uint64Slice) {
+			aa.Fold(primitiveZero, func(a, b uint64) uint64 { return primitiveZero })
+		},
+		func(aa uint64slice.Uint64Slice) {
+			aa.FoldI(primitiveZero, func(i int64, a, b uint64) uint64 { return primitiveZero })
+		},
+		func(aa uint64slice.Uint64Slice) {
+			aa.Pairwise(primitiveZero, func(a, b uint64) uint64 { return primitiveZero })
+		},
+		func(aa uint64slice.Uint64Slice) {
+			aa.Collect([]uint64{}, func(a, b uint64) uint64 { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint64slice.Uint64Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(uint64slice.Uint64Slice){
+		func(aa uint64slice.Uint64Slice) { aa.InsertAt(primitiveZero, 0) },
+		func(aa uint64slice.Uint64Slice) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint64slice.Uint64Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package uint8slice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+	"github.com/ideoterra/transforms/pkg/slices/uint8slice"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uint8slice.Uint8Slice){
+		func(aa uint8slice.Uint8Slice) { aa.Clear() },
+		func(aa uint8slice.Uint8Slice) { aa.Clone() },
+		func(aa uint8slice.Uint8Slice) { aa.Dequeue() },
+		func(aa uint8slice.Uint8Slice) { aa.Empty() },
+		func(aa uint8slice.Uint8Slice) { aa.End() },
+		func(aa uint8slice.Uint8Slice) { aa.Head() },
+		func(aa uint8slice.Uint8Slice) { aa.Len() },
+		func(aa uint8slice.Uint8Slice) { aa.Permutable() },
+		func(aa uint8slice.Uint8Slice) { aa.Permutations() },
+		func(aa uint8slice.Uint8Slice) { aa.Permute() },
+		func(aa uint8slice.Uint8Slice) { aa.Pop() },
+		func(aa uint8slice.Uint8Slice) { aa.Reverse() },
+		func(aa uint8slice.Uint8Slice) { _ = aa.String() },
+		func(aa uint8slice.Uint8Slice) { aa.Tail() },
+		func(aa uint8slice.Uint8Slice) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint8slice.Uint8Slice{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uint8slice.Uint8Slice){
+		func(aa uint8slice.Uint8Slice) { aa.Item(0) },
+		func(aa uint8slice.Uint8Slice) { aa.ItemFuzzy(0) },
+		func(aa uint8slice.Uint8Slice) { aa.RemoveAt(0) },
+		func(aa uint8slice.Uint8Slice) { aa.Skip(0) },
+		func(aa uint8slice.Uint8Slice) { aa.SplitAt(0) },
+		func(aa uint8slice.Uint8Slice) { aa.Take(0) },
+		func(aa uint8slice.Uint8Slice) { aa.Union(nil) },
+		func(aa uint8slice.Uint8Slice) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint8slice.Uint8Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uint8slice.Uint8Slice, uint8){
+		func(aa uint8slice.Uint8Slice, b uint8) { aa.Append(b) },
+		func(aa uint8slice.Uint8Slice, b uint8) { aa.Enqueue(b) },
+		func(aa uint8slice.Uint8Slice, b uint8) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint8slice.Uint8Slice{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uint8slice.Uint8Slice, uint8slice.ConditionFn){
+		func(aa uint8slice.Uint8Slice, condition uint8slice.ConditionFn) { aa.All(condition) },
+		func(aa uint8slice.Uint8Slice, condition uint8slice.ConditionFn) { aa.Any(condition) },
+		func(aa uint8slice.Uint8Slice, condition uint8slice.ConditionFn) { aa.Count(condition) },
+		func(aa uint8slice.Uint8Slice, condition uint8slice.ConditionFn) { aa.Filter(condition) },
+		func(aa uint8slice.Uint8Slice, condition uint8slice.ConditionFn) { aa.FindIndex(condition) },
+		func(aa uint8slice.Uint8Slice, condition uint8slice.ConditionFn) { aa.First(condition) },
+		func(aa uint8slice.Uint8Slice, condition uint8slice.ConditionFn) { aa.Last(condition) },
+		func(aa uint8slice.Uint8Slice, condition uint8slice.ConditionFn) { aa.None(condition) },
+		func(aa uint8slice.Uint8Slice, condition uint8slice.ConditionFn) { aa.Partition(condition) },
+		func(aa uint8slice.Uint8Slice, condition uint8slice.ConditionFn) { aa.Remove(condition) },
+		func(aa uint8slice.Uint8Slice, condition uint8slice.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa uint8slice.Uint8Slice, condition uint8slice.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa uint8slice.Uint8Slice, condition uint8slice.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa uint8slice.Uint8Slice, condition uint8slice.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ uint8) bool {
+				return true
+			}
+			methodCall(uint8slice.Uint8Slice{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(uint8slice.Uint8Slice){
+		func(aa uint8slice.Uint8Slice) {
+			aa.Distinct(func(a, b uint8) bool { return true })
+		},
+		func(aa uint8slice.Uint8Slice) {
+			aa.Expand(func(uint8) []uint8 { return nil })
+		},
+		func(aa uint8slice.Uint8Slice) {
+			aa.ForEach(func(uint8) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa uint8slice.Uint8Slice) {
+			aa.ForEachR(func(uint8) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa uint8slice.Uint8Slice) {
+			aa.Group(func(uint8) string { return "0" })
+		},
+		func(aa uint8slice.Uint8Slice) {
+			aa.GroupI(func(int64, uint8) string { return "0" })
+		},
+		func(aa uint8slice.Uint8Slice) {
+			aa.GroupByTrait(func(a, b uint8) bool { return true }, func(a, b uint8) bool { return true })
+		},
+		func(aa uint8slice.Uint8Slice) {
+			aa.Map(func(uint8) uint8 { return primitiveZero })
+		},
+		func(aa uint8slice.Uint8Slice) {
+			aa.Reduce(func(a, b uint8) uint8 { return primitiveZero })
+		},
+		func(aa uint8slice.Uint8Slice) {
+			aa.Sort(func(a, b uint8) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint8slice.Uint8Slice{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(uint8slice.Uint8Slice, uint8slice.EqualityFn){
+		func(aa uint8slice.Uint8Slice, equality uint8slice.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa uint8slice.Uint8Slice, equality uint8slice.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa uint8slice.Uint8Slice, equality uint8slice.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa uint8slice.Uint8Slice, equality uint8slice.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa uint8slice.Uint8Slice, equality uint8slice.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa uint8slice.Uint8Slice, equality uint8slice.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b uint8) bool {
+				return false
+			}
+			methodCall(uint8slice.Uint8Slice{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(uint8slice.Uint8Slice, uint8, uint8slice.ConditionFn){
+		func(aa uint8slice.Uint8Slice, b uint8, condition uint8slice.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa uint8slice.Uint8Slice, b uint8, condition uint8slice.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(uint8) bool {
+				return false
+			}
+			methodCall(uint8slice.Uint8Slice{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(uint8slice.Uint8Slice){
+		func(aa uint8slice.Uint8Slice) {
+			aa.ForEachC(0, func(uint8, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa uint8slice.Uint8Slice) {
+			aa.WindowCentered(0, func([]uint8) uint8 { return primitiveZero })
+		},
+		func(aa uint8slice.Uint8Slice) {
+			aa.WindowLeft(0, func([]uint8) uint8 { return primitiveZero })
+		},
+		func(aa uint8slice.Uint8Slice) {
+			aa.WindowRight(0, func([]uint8) uint8 { return primitiveZero })
+		},
+		func(aa uint8slice.Uint8Slice) {
+			aa.Fold(primitiveZero, func(a, b uint8) uint8 { return primitiveZero })
+		},
+		func(aa uint8slice.Uint8Slice) {
+			aa.FoldI(primitiveZero, func(i int64, a, b uint8) uint8 { return primitiveZero })
+		},
+		func(aa uint8slice.Uint8Slice) {
+			aa.Pairwise(primitiveZero, func(a, b uint8) uint8 { return primitiveZero })
+		},
+		func(aa uint8slice.Uint8Slice) {
+			aa.Collect([]uint8{}, func(a, b uint8) uint8 { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint8slice.Uint8Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(uint8slice.Uint8Slice){
+		func(aa uint8slice.Uint8Slice) { aa.InsertAt(primitiveZero, 0) },
+		func(aa uint8slice.Uint8Slice) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint8slice.Uint8Slice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package uint8slice2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+	"github.com/ideoterra/transforms/pkg/slices/uint8slice2"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uint8slice2.Uint8Slice2){
+		func(aa uint8slice2.Uint8Slice2) { aa.Clear() },
+		func(aa uint8slice2.Uint8Slice2) { aa.Clone() },
+		func(aa uint8slice2.Uint8Slice2) { aa.Dequeue() },
+		func(aa uint8slice2.Uint8Slice2) { aa.Empty() },
+		func(aa uint8slice2.Uint8Slice2) { aa.End() },
+		func(aa uint8slice2.Uint8Slice2) { aa.Head() },
+		func(aa uint8slice2.Uint8Slice2) { aa.Len() },
+		func(aa uint8slice2.Uint8Slice2) { aa.Permutable() },
+		func(aa uint8slice2.Uint8Slice2) { aa.Permutations() },
+		func(aa uint8slice2.Uint8Slice2) { aa.Permute() },
+		func(aa uint8slice2.Uint8Slice2) { aa.Pop() },
+		func(aa uint8slice2.Uint8Slice2) { aa.Reverse() },
+		func(aa uint8slice2.Uint8Slice2) { _ = aa.String() },
+		func(aa uint8slice2.Uint8Slice2) { aa.Tail() },
+		func(aa uint8slice2.Uint8Slice2) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint8slice2.Uint8Slice2{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uint8slice2.Uint8Slice2){
+		func(aa uint8slice2.Uint8Slice2) { aa.Item(0) },
+		func(aa uint8slice2.Uint8Slice2) { aa.ItemFuzzy(0) },
+		func(aa uint8slice2.Uint8Slice2) { aa.RemoveAt(0) },
+		func(aa uint8slice2.Uint8Slice2) { aa.Skip(0) },
+		func(aa uint8slice2.Uint8Slice2) { aa.SplitAt(0) },
+		func(aa uint8slice2.Uint8Slice2) { aa.Take(0) },
+		func(aa uint8slice2.Uint8Slice2) { aa.Union(nil) },
+		func(aa uint8slice2.Uint8Slice2) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint8slice2.Uint8Slice2{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uint8slice2.Uint8Slice2, []uint8){
+		func(aa uint8slice2.Uint8Slice2, b []uint8) { aa.Append(b) },
+		func(aa uint8slice2.Uint8Slice2, b []uint8) { aa.Enqueue(b) },
+		func(aa uint8slice2.Uint8Slice2, b []uint8) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint8slice2.Uint8Slice2{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uint8slice2.Uint8Slice2, uint8slice2.ConditionFn){
+		func(aa uint8slice2.Uint8Slice2, condition uint8slice2.ConditionFn) { aa.All(condition) },
+		func(aa uint8slice2.Uint8Slice2, condition uint8slice2.ConditionFn) { aa.Any(condition) },
+		func(aa uint8slice2.Uint8Slice2, condition uint8slice2.ConditionFn) { aa.Count(condition) },
+		func(aa uint8slice2.Uint8Slice2, condition uint8slice2.ConditionFn) { aa.Filter(condition) },
+		func(aa uint8slice2.Uint8Slice2, condition uint8slice2.ConditionFn) { aa.FindIndex(condition) },
+		func(aa uint8slice2.Uint8Slice2, condition uint8slice2.ConditionFn) { aa.First(condition) },
+		func(aa uint8slice2.Uint8Slice2, condition uint8slice2.ConditionFn) { aa.Last(condition) },
+		func(aa uint8slice2.Uint8Slice2, condition uint8slice2.ConditionFn) { aa.None(condition) },
+		func(aa uint8slice2.Uint8Slice2, condition uint8slice2.ConditionFn) { aa.Partition(condition) },
+		func(aa uint8slice2.Uint8Slice2, condition uint8slice2.ConditionFn) { aa.Remove(condition) },
+		func(aa uint8slice2.Uint8Slice2, condition uint8slice2.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa uint8slice2.Uint8Slice2, condition uint8slice2.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa uint8slice2.Uint8Slice2, condition uint8slice2.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa uint8slice2.Uint8Slice2, condition uint8slice2.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ []uint8) bool {
+				return true
+			}
+			methodCall(uint8slice2.Uint8Slice2{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(uint8slice2.Uint8Slice2){
+		func(aa uint8slice2.Uint8Slice2) {
+			aa.Distinct(func(a, b []uint8) bool { return true })
+		},
+		func(aa uint8slice2.Uint8Slice2) {
+			aa.Expand(func([]uint8) [][]uint8 { return nil })
+		},
+		func(aa uint8slice2.Uint8Slice2) {
+			aa.ForEach(func([]uint8) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa uint8slice2.Uint8Slice2) {
+			aa.ForEachR(func([]uint8) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa uint8slice2.Uint8Slice2) {
+			aa.Group(func([]uint8) string { return "0" })
+		},
+		func(aa uint8slice2.Uint8Slice2) {
+			aa.GroupI(func(int64, []uint8) string { return "0" })
+		},
+		func(aa uint8slice2.Uint8Slice2) {
+			aa.GroupByTrait(func(a, b []uint8) bool { return true }, func(a, b []uint8) bool { return true })
+		},
+		func(aa uint8slice2.Uint8Slice2) {
+			aa.Map(func([]uint8) []uint8 { return primitiveZero })
+		},
+		func(aa uint8slice2.Uint8Slice2) {
+			aa.Reduce(func(a, b []uint8) []uint8 { return primitiveZero })
+		},
+		func(aa uint8slice2.Uint8Slice2) {
+			aa.Sort(func(a, b []uint8) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint8slice2.Uint8Slice2{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(uint8slice2.Uint8Slice2, uint8slice2.EqualityFn){
+		func(aa uint8slice2.Uint8Slice2, equality uint8slice2.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa uint8slice2.Uint8Slice2, equality uint8slice2.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa uint8slice2.Uint8Slice2, equality uint8slice2.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa uint8slice2.Uint8Slice2, equality uint8slice2.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa uint8slice2.Uint8Slice2, equality uint8slice2.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa uint8slice2.Uint8Slice2, equality uint8slice2.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b []uint8) bool {
+				return false
+			}
+			methodCall(uint8slice2.Uint8Slice2{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(uint8slice2.Uint8Slice2, []uint8, uint8slice2.ConditionFn){
+		func(aa uint8slice2.Uint8Slice2, b []uint8, condition uint8slice2.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa uint8slice2.Uint8Slice2, b []uint8, condition uint8slice2.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func([]uint8) bool {
+				return false
+			}
+			methodCall(uint8slice2.Uint8Slice2{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(uint8slice2.Uint8Slice2){
+		func(aa uint8slice2.Uint8Slice2) {
+			aa.ForEachC(0, func([]uint8, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa uint8slice2.Uint8Slice2) {
+			aa.WindowCentered(0, func([][]uint8) []uint8 { return primitiveZero })
+		},
+		func(aa uint8slice2.Uint8Slice2) {
+			aa.WindowLeft(0, func([][]uint8) []uint8 { return primitiveZero })
+		},
+		func(aa uint8slice2.Uint8Slice2) {
+			aa.WindowRight(0, func([][]uint8) []uint8 { return primitiveZero })
+		},
+		func(aa uint8slice2.Uint8Slice2) {
+			aa.Fold(primitiveZero, func(a, b []uint8) []uint8 { return primitiveZero })
+		},
+		func(aa uint8slice2.Uint8Slice2) {
+			aa.FoldI(primitiveZero, func(i int64, a, b []uint8) []uint8 { return primitiveZero })
+		},
+		func(aa uint8slice2.Uint8Slice2) {
+			aa.Pairwise(primitiveZero, func(a, b []uint8) []uint8 { return primitiveZero })
+		},
+		func(aa uint8slice2.Uint8Slice2) {
+			aa.Collect([][]uint8{}, func(a, b []uint8) []uint8 { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint8slice2.Uint8Slice2{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(uint8slice2.Uint8Slice2){
+		func(aa uint8slice2.Uint8Slice2) { aa.InsertAt(primitiveZero, 0) },
+		func(aa uint8slice2.Uint8Slice2) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uint8slice2.Uint8Slice2{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package uintslice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+	"github.com/ideoterra/transforms/pkg/slices/uintslice"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uintslice.UintSlice){
+		func(aa uintslice.UintSlice) { aa.Clear() },
+		func(aa uintslice.UintSlice) { aa.Clone() },
+		func(aa uintslice.UintSlice) { aa.Dequeue() },
+		func(aa uintslice.UintSlice) { aa.Empty() },
+		func(aa uintslice.UintSlice) { aa.End() },
+		func(aa uintslice.UintSlice) { aa.Head() },
+		func(aa uintslice.UintSlice) { aa.Len() },
+		func(aa uintslice.UintSlice) { aa.Permutable() },
+		func(aa uintslice.UintSlice) { aa.Permutations() },
+		func(aa uintslice.UintSlice) { aa.Permute() },
+		func(aa uintslice.UintSlice) { aa.Pop() },
+		func(aa uintslice.UintSlice) { aa.Reverse() },
+		func(aa uintslice.UintSlice) { _ = aa.String() },
+		func(aa uintslice.UintSlice) { aa.Tail() },
+		func(aa uintslice.UintSlice) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uintslice.UintSlice{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uintslice.UintSlice){
+		func(aa uintslice.UintSlice) { aa.Item(0) },
+		func(aa uintslice.UintSlice) { aa.ItemFuzzy(0) },
+		func(aa uintslice.UintSlice) { aa.RemoveAt(0) },
+		func(aa uintslice.UintSlice) { aa.Skip(0) },
+		func(aa uintslice.UintSlice) { aa.SplitAt(0) },
+		func(aa uintslice.UintSlice) { aa.Take(0) },
+		func(aa uintslice.UintSlice) { aa.Union(nil) },
+		func(aa uintslice.UintSlice) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uintslice.UintSlice{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uintslice.UintSlice, uint){
+		func(aa uintslice.UintSlice, b uint) { aa.Append(b) },
+		func(aa uintslice.UintSlice, b uint) { aa.Enqueue(b) },
+		func(aa uintslice.UintSlice, b uint) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uintslice.UintSlice{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uintslice.UintSlice, uintslice.ConditionFn){
+		func(aa uintslice.UintSlice, condition uintslice.ConditionFn) { aa.All(condition) },
+		func(aa uintslice.UintSlice, condition uintslice.ConditionFn) { aa.Any(condition) },
+		func(aa uintslice.UintSlice, condition uintslice.ConditionFn) { aa.Count(condition) },
+		func(aa uintslice.UintSlice, condition uintslice.ConditionFn) { aa.Filter(condition) },
+		func(aa uintslice.UintSlice, condition uintslice.ConditionFn) { aa.FindIndex(condition) },
+		func(aa uintslice.UintSlice, condition uintslice.ConditionFn) { aa.First(condition) },
+		func(aa uintslice.UintSlice, condition uintslice.ConditionFn) { aa.Last(condition) },
+		func(aa uintslice.UintSlice, condition uintslice.ConditionFn) { aa.None(condition) },
+		func(aa uintslice.UintSlice, condition uintslice.ConditionFn) { aa.Partition(condition) },
+		func(aa uintslice.UintSlice, condition uintslice.ConditionFn) { aa.Remove(condition) },
+		func(aa uintslice.UintSlice, condition uintslice.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa uintslice.UintSlice, condition uintslice.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa uintslice.UintSlice, condition uintslice.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa uintslice.UintSlice, condition uintslice.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ uint) bool {
+				return true
+			}
+			methodCall(uintslice.UintSlice{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(uintslice.UintSlice){
+		func(aa uintslice.UintSlice) {
+			aa.Distinct(func(a, b uint) bool { return true })
+		},
+		func(aa uintslice.UintSlice) {
+			aa.Expand(func(uint) []uint { return nil })
+		},
+		func(aa uintslice.UintSlice) {
+			aa.ForEach(func(uint) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa uintslice.UintSlice) {
+			aa.ForEachR(func(uint) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa uintslice.UintSlice) {
+			aa.Group(func(uint) string { return "0" })
+		},
+		func(aa uintslice.UintSlice) {
+			aa.GroupI(func(int64, uint) string { return "0" })
+		},
+		func(aa uintslice.UintSlice) {
+			aa.GroupByTrait(func(a, b uint) bool { return true }, func(a, b uint) bool { return true })
+		},
+		func(aa uintslice.UintSlice) {
+			aa.Map(func(uint) uint { return primitiveZero })
+		},
+		func(aa uintslice.UintSlice) {
+			aa.Reduce(func(a, b uint) uint { return primitiveZero })
+		},
+		func(aa uintslice.UintSlice) {
+			aa.Sort(func(a, b uint) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uintslice.UintSlice{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(uintslice.UintSlice, uintslice.EqualityFn){
+		func(aa uintslice.UintSlice, equality uintslice.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa uintslice.UintSlice, equality uintslice.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa uintslice.UintSlice, equality uintslice.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa uintslice.UintSlice, equality uintslice.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa uintslice.UintSlice, equality uintslice.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa uintslice.UintSlice, equality uintslice.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b uint) bool {
+				return false
+			}
+			methodCall(uintslice.UintSlice{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(uintslice.UintSlice, uint, uintslice.ConditionFn){
+		func(aa uintslice.UintSlice, b uint, condition uintslice.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa uintslice.UintSlice, b uint, condition uintslice.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(uint) bool {
+				return false
+			}
+			methodCall(uintslice.UintSlice{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(uintslice.UintSlice){
+		func(aa uintslice.UintSlice) {
+			aa.ForEachC(0, func(uint, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa uintslice.UintSlice) {
+			aa.WindowCentered(0, func([]uint) uint { return primitiveZero })
+		},
+		func(aa uintslice.UintSlice) {
+			aa.WindowLeft(0, func([]uint) uint { return primitiveZero })
+		},
+		func(aa uintslice.UintSlice) {
+			aa.WindowRight(0, func([]uint) uint { return primitiveZero })
+		},
+		func(aa uintslice.UintSlice) {
+			aa.Fold(primitiveZero, func(a, b uint) uint { return primitiveZero })
+		},
+		func(aa uintslice.UintSlice) {
+			aa.FoldI(primitiveZero, func(i int64, a, b uint) uint { return primitiveZero })
+		},
+		func(aa uintslice.UintSlice) {
+			aa.Pairwise(primitiveZero, func(a, b uint) uint { return primitiveZero })
+		},
+		func(aa uintslice.UintSlice) {
+			aa.Collect([]uint{}, func(a, b uint) uint { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uintslice.UintSlice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(uintslice.UintSlice){
+		func(aa uintslice.UintSlice) { aa.InsertAt(primitiveZero, 0) },
+		func(aa uintslice.UintSlice) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uintslice.UintSlice{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}
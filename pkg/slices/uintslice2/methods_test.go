@@ -0,0 +1,250 @@
+// Code generated by github.com/ideoterra/transforms/cmd. DO NOT EDIT.
+
+package uintslice2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ideoterra/transforms/pkg/slices/shared"
+	"github.com/ideoterra/transforms/pkg/slices/uintslice2"
+)
+
+// As a rule, all methods in this package (methods.go) are just wrappers around
+// a set of base functions (see functions.go). We want to keep a high level of
+// condition coverage while minimizing condition-effort, so method sets are tested in bulk
+// where possible for simple happy-path operation.
+//
+// Additional tests are added as necessary, but the bulk of the deeper testing
+// is handled in functions_test.go.
+
+func TestNullaryMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uintslice2.UintSlice2){
+		func(aa uintslice2.UintSlice2) { aa.Clear() },
+		func(aa uintslice2.UintSlice2) { aa.Clone() },
+		func(aa uintslice2.UintSlice2) { aa.Dequeue() },
+		func(aa uintslice2.UintSlice2) { aa.Empty() },
+		func(aa uintslice2.UintSlice2) { aa.End() },
+		func(aa uintslice2.UintSlice2) { aa.Head() },
+		func(aa uintslice2.UintSlice2) { aa.Len() },
+		func(aa uintslice2.UintSlice2) { aa.Permutable() },
+		func(aa uintslice2.UintSlice2) { aa.Permutations() },
+		func(aa uintslice2.UintSlice2) { aa.Permute() },
+		func(aa uintslice2.UintSlice2) { aa.Pop() },
+		func(aa uintslice2.UintSlice2) { aa.Reverse() },
+		func(aa uintslice2.UintSlice2) { _ = aa.String() },
+		func(aa uintslice2.UintSlice2) { aa.Tail() },
+		func(aa uintslice2.UintSlice2) { aa.Unzip() },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uintslice2.UintSlice2{})
+		}
+		t.Run(fmt.Sprintf("Nullary condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryValueMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uintslice2.UintSlice2){
+		func(aa uintslice2.UintSlice2) { aa.Item(0) },
+		func(aa uintslice2.UintSlice2) { aa.ItemFuzzy(0) },
+		func(aa uintslice2.UintSlice2) { aa.RemoveAt(0) },
+		func(aa uintslice2.UintSlice2) { aa.Skip(0) },
+		func(aa uintslice2.UintSlice2) { aa.SplitAt(0) },
+		func(aa uintslice2.UintSlice2) { aa.Take(0) },
+		func(aa uintslice2.UintSlice2) { aa.Union(nil) },
+		func(aa uintslice2.UintSlice2) { aa.Zip(nil) },
+	}
+
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uintslice2.UintSlice2{})
+		}
+		t.Run(fmt.Sprintf("UnaryValue condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryPrimitiveMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uintslice2.UintSlice2, []uint){
+		func(aa uintslice2.UintSlice2, b []uint) { aa.Append(b) },
+		func(aa uintslice2.UintSlice2, b []uint) { aa.Enqueue(b) },
+		func(aa uintslice2.UintSlice2, b []uint) { aa.Push(b) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uintslice2.UintSlice2{}, primitiveZero)
+		}
+		t.Run(fmt.Sprintf("UnaryPrimitive condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryTestMethodHappyPaths(t *testing.T) {
+	methodCalls := []func(uintslice2.UintSlice2, uintslice2.ConditionFn){
+		func(aa uintslice2.UintSlice2, condition uintslice2.ConditionFn) { aa.All(condition) },
+		func(aa uintslice2.UintSlice2, condition uintslice2.ConditionFn) { aa.Any(condition) },
+		func(aa uintslice2.UintSlice2, condition uintslice2.ConditionFn) { aa.Count(condition) },
+		func(aa uintslice2.UintSlice2, condition uintslice2.ConditionFn) { aa.Filter(condition) },
+		func(aa uintslice2.UintSlice2, condition uintslice2.ConditionFn) { aa.FindIndex(condition) },
+		func(aa uintslice2.UintSlice2, condition uintslice2.ConditionFn) { aa.First(condition) },
+		func(aa uintslice2.UintSlice2, condition uintslice2.ConditionFn) { aa.Last(condition) },
+		func(aa uintslice2.UintSlice2, condition uintslice2.ConditionFn) { aa.None(condition) },
+		func(aa uintslice2.UintSlice2, condition uintslice2.ConditionFn) { aa.Partition(condition) },
+		func(aa uintslice2.UintSlice2, condition uintslice2.ConditionFn) { aa.Remove(condition) },
+		func(aa uintslice2.UintSlice2, condition uintslice2.ConditionFn) { aa.SkipWhile(condition) },
+		func(aa uintslice2.UintSlice2, condition uintslice2.ConditionFn) { aa.SplitAfter(condition) },
+		func(aa uintslice2.UintSlice2, condition uintslice2.ConditionFn) { aa.SplitBefore(condition) },
+		func(aa uintslice2.UintSlice2, condition uintslice2.ConditionFn) { aa.TakeWhile(condition) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func(_ []uint) bool {
+				return true
+			}
+			methodCall(uintslice2.UintSlice2{}, testFn)
+		}
+		t.Run(fmt.Sprintf("UnaryTest condition %v", i+1), condition)
+	}
+}
+
+func TestUnaryClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(uintslice2.UintSlice2){
+		func(aa uintslice2.UintSlice2) {
+			aa.Distinct(func(a, b []uint) bool { return true })
+		},
+		func(aa uintslice2.UintSlice2) {
+			aa.Expand(func([]uint) [][]uint { return nil })
+		},
+		func(aa uintslice2.UintSlice2) {
+			aa.ForEach(func([]uint) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa uintslice2.UintSlice2) {
+			aa.ForEachR(func([]uint) shared.Continue { return shared.ContinueNo })
+		},
+		func(aa uintslice2.UintSlice2) {
+			aa.Group(func([]uint) string { return "0" })
+		},
+		func(aa uintslice2.UintSlice2) {
+			aa.GroupI(func(int64, []uint) string { return "0" })
+		},
+		func(aa uintslice2.UintSlice2) {
+			aa.GroupByTrait(func(a, b []uint) bool { return true }, func(a, b []uint) bool { return true })
+		},
+		func(aa uintslice2.UintSlice2) {
+			aa.Map(func([]uint) []uint { return primitiveZero })
+		},
+		func(aa uintslice2.UintSlice2) {
+			aa.Reduce(func(a, b []uint) []uint { return primitiveZero })
+		},
+		func(aa uintslice2.UintSlice2) {
+			aa.Sort(func(a, b []uint) bool { return false })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uintslice2.UintSlice2{})
+		}
+		t.Run(fmt.Sprintf("UnaryClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinarySliceEqualityHappyPaths(t *testing.T) {
+	methodCalls := []func(uintslice2.UintSlice2, uintslice2.EqualityFn){
+		func(aa uintslice2.UintSlice2, equality uintslice2.EqualityFn) {
+			aa.Difference(nil, equality)
+		},
+		func(aa uintslice2.UintSlice2, equality uintslice2.EqualityFn) {
+			aa.Intersection(nil, equality)
+		},
+		func(aa uintslice2.UintSlice2, equality uintslice2.EqualityFn) {
+			aa.IsProperSubset(nil, equality)
+		},
+		func(aa uintslice2.UintSlice2, equality uintslice2.EqualityFn) {
+			aa.IsProperSuperset(nil, equality)
+		},
+		func(aa uintslice2.UintSlice2, equality uintslice2.EqualityFn) {
+			aa.IsSubset(nil, equality)
+		},
+		func(aa uintslice2.UintSlice2, equality uintslice2.EqualityFn) {
+			aa.IsSuperset(nil, equality)
+		}}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			equality := func(a, b []uint) bool {
+				return false
+			}
+			methodCall(uintslice2.UintSlice2{}, equality)
+		}
+		t.Run(fmt.Sprintf("BinarySliceEquality condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryPrimitiveTestHappyPaths(t *testing.T) {
+	methodCalls := []func(uintslice2.UintSlice2, []uint, uintslice2.ConditionFn){
+		func(aa uintslice2.UintSlice2, b []uint, condition uintslice2.ConditionFn) {
+			aa.InsertAfter(b, condition)
+		},
+		func(aa uintslice2.UintSlice2, b []uint, condition uintslice2.ConditionFn) {
+			aa.InsertBefore(b, condition)
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			testFn := func([]uint) bool {
+				return false
+			}
+			methodCall(uintslice2.UintSlice2{}, primitiveZero, testFn)
+		}
+		t.Run(fmt.Sprintf("BinaryPrimitiveTest condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueClosureHappyPaths(t *testing.T) {
+	methodCalls := []func(uintslice2.UintSlice2){
+		func(aa uintslice2.UintSlice2) {
+			aa.ForEachC(0, func([]uint, func() bool) shared.Continue {
+				return shared.ContinueNo
+			})
+		},
+		func(aa uintslice2.UintSlice2) {
+			aa.WindowCentered(0, func([][]uint) []uint { return primitiveZero })
+		},
+		func(aa uintslice2.UintSlice2) {
+			aa.WindowLeft(0, func([][]uint) []uint { return primitiveZero })
+		},
+		func(aa uintslice2.UintSlice2) {
+			aa.WindowRight(0, func([][]uint) []uint { return primitiveZero })
+		},
+		func(aa uintslice2.UintSlice2) {
+			aa.Fold(primitiveZero, func(a, b []uint) []uint { return primitiveZero })
+		},
+		func(aa uintslice2.UintSlice2) {
+			aa.FoldI(primitiveZero, func(i int64, a, b []uint) []uint { return primitiveZero })
+		},
+		func(aa uintslice2.UintSlice2) {
+			aa.Pairwise(primitiveZero, func(a, b []uint) []uint { return primitiveZero })
+		},
+		func(aa uintslice2.UintSlice2) {
+			aa.Collect([][]uint{}, func(a, b []uint) []uint { return primitiveZero })
+		},
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uintslice2.UintSlice2{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueClosure condition %v", i+1), condition)
+	}
+}
+
+func TestBinaryValueValueHappyPaths(t *testing.T) {
+	methodCalls := []func(uintslice2.UintSlice2){
+		func(aa uintslice2.UintSlice2) { aa.InsertAt(primitiveZero, 0) },
+		func(aa uintslice2.UintSlice2) { aa.SwapIndex(0, 0) },
+	}
+	for i, methodCall := range methodCalls {
+		condition := func(t *testing.T) {
+			methodCall(uintslice2.UintSlice2{})
+		}
+		t.Run(fmt.Sprintf("BinaryValueValue condition %v", i+1), condition)
+	}
+}